@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package actions inventories the GitHub Actions workflows of repositories
+// and disables the scheduled ones of repositories nobody works on, which
+// still use Actions minutes and send failure emails.
+package actions
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+// ActionWorkflowsDisabled is the repo_changes action recorded when scheduled
+// workflows are disabled.
+const ActionWorkflowsDisabled = "workflows_disabled"
+
+// stateDisabled is the state GitHub reports for a workflow disabled by hand.
+const stateDisabled = "disabled_manually"
+
+// Client is the GitHub access the inventory needs.
+type Client interface {
+	ListWorkflows(owner, name string) ([]github.ActionsWorkflow, error)
+	FetchFileContent(owner, name, path string) ([]byte, error)
+	FetchLatestWorkflowRun(owner, name string, workflowID int64) (*github.WorkflowRun, error)
+	DisableWorkflow(owner, name string, workflowID int64) error
+}
+
+// Triggers returns the events in the "on" key of a workflow file, e.g.
+// ["push", "schedule"]. The key may hold one event, a list or a map.
+func Triggers(content []byte) ([]string, error) {
+	var file struct {
+		On yaml.Node `yaml:"on"`
+	}
+	if err := yaml.Unmarshal(content, &file); err != nil {
+		return nil, fmt.Errorf("parsing workflow: %w", err)
+	}
+
+	var triggers []string
+	switch file.On.Kind {
+	case yaml.ScalarNode:
+		triggers = append(triggers, file.On.Value)
+	case yaml.SequenceNode:
+		for _, node := range file.On.Content {
+			triggers = append(triggers, node.Value)
+		}
+	case yaml.MappingNode:
+		// Content alternates keys and values
+		for i := 0; i < len(file.On.Content); i += 2 {
+			triggers = append(triggers, file.On.Content[i].Value)
+		}
+	}
+	return triggers, nil
+}
+
+// Scheduled returns the active workflows that run on a schedule.
+func Scheduled(workflows []github.ActionsWorkflow) []github.ActionsWorkflow {
+	var scheduled []github.ActionsWorkflow
+	for _, w := range workflows {
+		if w.IsScheduled() {
+			scheduled = append(scheduled, w)
+		}
+	}
+	return scheduled
+}
+
+// LastRun describes a workflow's latest run, e.g. "failure 2025-03-01", or
+// "never run".
+func LastRun(w github.ActionsWorkflow) string {
+	if w.LastRunAt.IsZero() {
+		return "never run"
+	}
+	return w.LastStatus + " " + w.LastRunAt.Format("2006-01-02")
+}
+
+// Inventory lists the workflows of repositories and keeps the store in step.
+type Inventory struct {
+	client Client
+	store  *store.Store
+}
+
+// New creates an inventory. The store may be nil.
+func New(client Client, s *store.Store) *Inventory {
+	return &Inventory{client: client, store: s}
+}
+
+// Scan lists a repository's workflows with their triggers and latest run,
+// and stores them.
+func (inv *Inventory) Scan(repo github.Repository) ([]github.ActionsWorkflow, error) {
+	workflows, err := inv.client.ListWorkflows(repo.Owner, repo.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range workflows {
+		w := &workflows[i]
+		// Workflows GitHub adds itself, such as default CodeQL setup, have no file
+		if strings.HasPrefix(w.Path, ".github/") {
+			content, err := inv.client.FetchFileContent(repo.Owner, repo.Name, w.Path)
+			if err != nil && !errors.Is(err, github.ErrNotFound) {
+				return nil, err
+			}
+			if w.Triggers, err = Triggers(content); err != nil {
+				slog.Warn("unreadable workflow", "component", "actions", "repo", repo.FullName(), "path", w.Path, "error", err)
+			}
+		}
+
+		run, err := inv.client.FetchLatestWorkflowRun(repo.Owner, repo.Name, w.ID)
+		if err != nil {
+			return nil, err
+		}
+		if run != nil {
+			w.LastRunAt = run.CreatedAt
+			w.LastStatus = run.Conclusion
+			if w.LastStatus == "" {
+				w.LastStatus = run.Status
+			}
+		}
+	}
+
+	if inv.store != nil {
+		if err := inv.store.SaveWorkflows(repo.Owner, repo.Name, workflows); err != nil {
+			return nil, fmt.Errorf("saving workflows of %s: %w", repo.FullName(), err)
+		}
+	}
+	return workflows, nil
+}
+
+// DisableScheduled disables the active scheduled workflows of repo, found by
+// a fresh scan, and records them in the repository's history. It returns
+// the workflows it disabled; none when the repository has no such workflow.
+func (inv *Inventory) DisableScheduled(repo github.Repository) ([]github.ActionsWorkflow, error) {
+	workflows, err := inv.Scan(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var disabled []github.ActionsWorkflow
+	for i, w := range workflows {
+		if !w.IsScheduled() {
+			continue
+		}
+		// Workflows disabled before a failure are still recorded below
+		if err = inv.client.DisableWorkflow(repo.Owner, repo.Name, w.ID); err != nil {
+			break
+		}
+		workflows[i].State = stateDisabled
+		disabled = append(disabled, w)
+	}
+	if len(disabled) > 0 {
+		inv.record(repo, workflows, disabled)
+	}
+	return disabled, err
+}
+
+// workflowState is a workflow as recorded in repo_changes.
+type workflowState struct {
+	Name  string `json:"name"`
+	Path  string `json:"path"`
+	State string `json:"state"`
+}
+
+// record stores the new workflow states and the change. GitHub has already
+// changed, so failures are only logged.
+func (inv *Inventory) record(repo github.Repository, workflows, disabled []github.ActionsWorkflow) {
+	if inv.store == nil {
+		return
+	}
+	if err := inv.store.SaveWorkflows(repo.Owner, repo.Name, workflows); err != nil {
+		slog.Warn("failed to save workflows", "component", "actions", "repo", repo.FullName(), "error", err)
+	}
+
+	var prev, next []workflowState
+	names := make([]string, len(disabled))
+	for i, w := range disabled {
+		prev = append(prev, workflowState{w.Name, w.Path, w.State})
+		next = append(next, workflowState{w.Name, w.Path, stateDisabled})
+		names[i] = w.Name
+	}
+	note := fmt.Sprintf("disabled scheduled workflow%s: %s", plural(len(disabled)), strings.Join(names, ", "))
+	if err := inv.store.RecordRepoChange(repo.Owner, repo.Name, ActionWorkflowsDisabled, "user",
+		map[string][]workflowState{"workflows": prev}, map[string][]workflowState{"workflows": next}, note); err != nil {
+		slog.Warn("failed to record disabled workflows", "component", "actions", "repo", repo.FullName(), "error", err)
+	}
+}
+
+// plural returns "s" unless n is 1.
+func plural(n int) string {
+	if n == 1 {
+		return ""
+	}
+	return "s"
+}
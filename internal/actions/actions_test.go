@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+// fakeClient serves workflows and files from memory.
+type fakeClient struct {
+	workflows []github.ActionsWorkflow
+	files     map[string]string
+	runs      map[int64]*github.WorkflowRun
+	disabled  []int64
+	err       error // returned by DisableWorkflow
+}
+
+func (c *fakeClient) ListWorkflows(owner, name string) ([]github.ActionsWorkflow, error) {
+	// Return copies, as the API would
+	return append([]github.ActionsWorkflow(nil), c.workflows...), nil
+}
+
+func (c *fakeClient) FetchFileContent(owner, name, path string) ([]byte, error) {
+	content, ok := c.files[path]
+	if !ok {
+		return nil, github.ErrNotFound
+	}
+	return []byte(content), nil
+}
+
+func (c *fakeClient) FetchLatestWorkflowRun(owner, name string, workflowID int64) (*github.WorkflowRun, error) {
+	return c.runs[workflowID], nil
+}
+
+func (c *fakeClient) DisableWorkflow(owner, name string, workflowID int64) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.disabled = append(c.disabled, workflowID)
+	for i := range c.workflows {
+		if c.workflows[i].ID == workflowID {
+			c.workflows[i].State = "disabled_manually"
+		}
+	}
+	return nil
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{
+		workflows: []github.ActionsWorkflow{
+			{ID: 1, Name: "CI", Path: ".github/workflows/ci.yml", State: "active"},
+			{ID: 2, Name: "Nightly", Path: ".github/workflows/nightly.yml", State: "active"},
+			{ID: 3, Name: "CodeQL", Path: "dynamic/github-code-scanning/codeql", State: "active"},
+		},
+		files: map[string]string{
+			".github/workflows/ci.yml":      "name: CI\non: [push, pull_request]\njobs: {}\n",
+			".github/workflows/nightly.yml": "name: Nightly\non:\n  schedule:\n    - cron: '0 4 * * *'\n  workflow_dispatch:\njobs: {}\n",
+		},
+		runs: map[int64]*github.WorkflowRun{
+			2: {CreatedAt: time.Date(2025, 3, 1, 4, 0, 0, 0, time.UTC), Status: "completed", Conclusion: "failure"},
+		},
+	}
+}
+
+func TestTriggers(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{"single event", "on: push\n", []string{"push"}},
+		{"list", "on: [push, pull_request]\n", []string{"push", "pull_request"}},
+		{"map", "on:\n  schedule:\n    - cron: '0 0 * * 0'\n  push:\n    branches: [main]\n", []string{"schedule", "push"}},
+		{"no on key", "name: broken\n", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Triggers([]byte(tt.content))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := Triggers([]byte("on: [push\n"))
+	assert.Error(t, err)
+}
+
+func TestScan(t *testing.T) {
+	s := testutil.NewStore(t)
+	repo := github.Repository{Owner: "acme", Name: "widget"}
+	require.NoError(t, s.UpsertRepositories("acme", []github.Repository{repo}))
+
+	workflows, err := New(newFakeClient(), s).Scan(repo)
+	require.NoError(t, err)
+	require.Len(t, workflows, 3)
+	assert.Equal(t, []string{"push", "pull_request"}, workflows[0].Triggers)
+	assert.Equal(t, []string{"schedule", "workflow_dispatch"}, workflows[1].Triggers)
+	assert.Empty(t, workflows[2].Triggers)
+	assert.Equal(t, "failure 2025-03-01", LastRun(workflows[1]))
+	assert.Equal(t, "never run", LastRun(workflows[0]))
+
+	require.Len(t, Scheduled(workflows), 1)
+	stored, err := s.GetRepository("acme", "widget")
+	require.NoError(t, err)
+	assert.Equal(t, 1, stored.CronWorkflows)
+}
+
+func TestDisableScheduled(t *testing.T) {
+	client := newFakeClient()
+	s := testutil.NewStore(t)
+	repo := github.Repository{Owner: "acme", Name: "widget"}
+	require.NoError(t, s.UpsertRepositories("acme", []github.Repository{repo}))
+	inventory := New(client, s)
+
+	disabled, err := inventory.DisableScheduled(repo)
+	require.NoError(t, err)
+	require.Len(t, disabled, 1)
+	assert.Equal(t, "Nightly", disabled[0].Name)
+	assert.Equal(t, []int64{2}, client.disabled)
+
+	stored, err := s.GetRepository("acme", "widget")
+	require.NoError(t, err)
+	assert.Zero(t, stored.CronWorkflows)
+	changes, err := s.GetChangesByAction("acme", ActionWorkflowsDisabled, 10)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "disabled scheduled workflow: Nightly", changes[0].Notes)
+	var next map[string][]workflowState
+	require.NoError(t, json.Unmarshal([]byte(changes[0].NewState), &next))
+	assert.Equal(t, []workflowState{{"Nightly", ".github/workflows/nightly.yml", "disabled_manually"}}, next["workflows"])
+
+	// Nothing is left to disable on a second run
+	disabled, err = inventory.DisableScheduled(repo)
+	require.NoError(t, err)
+	assert.Empty(t, disabled)
+	assert.Len(t, client.disabled, 1)
+}
+
+func TestDisableScheduled_Error(t *testing.T) {
+	client := newFakeClient()
+	client.err = errors.New("HTTP 403")
+	s := testutil.NewStore(t)
+	repo := github.Repository{Owner: "acme", Name: "widget"}
+
+	disabled, err := New(client, s).DisableScheduled(repo)
+	assert.Error(t, err)
+	assert.Empty(t, disabled)
+	changes, err := s.GetChangesByAction("acme", ActionWorkflowsDisabled, 10)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package analyze
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+// RecentCommitLimit is how many recent commits are inspected per repository.
+const RecentCommitLimit = 30
+
+// botOnlyDays is how long a repository may go without a human commit before
+// bot pushes stop counting as activity.
+const botOnlyDays = 365
+
+// DefaultBotPatterns match the commit authors of common automation. A
+// pattern matches a commit's login or author name case-insensitively, and
+// '*' matches any run of characters.
+var DefaultBotPatterns = []string{
+	"*[bot]",
+	"dependabot*",
+	"renovate*",
+	"github-actions*",
+	"greenkeeper*",
+	"snyk-bot",
+	"pre-commit-ci*",
+	"semantic-release-bot",
+	"allcontributors*",
+	"imgbot*",
+}
+
+// BotMatcher decides whether a commit was made by a bot.
+type BotMatcher struct {
+	patterns []string
+}
+
+// NewBotMatcher creates a matcher for DefaultBotPatterns plus extra patterns,
+// such as the accounts of an organization's own release bots.
+func NewBotMatcher(extra []string) *BotMatcher {
+	patterns := make([]string, 0, len(DefaultBotPatterns)+len(extra))
+	for _, p := range append(DefaultBotPatterns, extra...) {
+		if p = strings.ToLower(strings.TrimSpace(p)); p != "" {
+			patterns = append(patterns, p)
+		}
+	}
+	return &BotMatcher{patterns: patterns}
+}
+
+// IsBot reports whether the commit's login or author name matches a pattern.
+func (m *BotMatcher) IsBot(c github.Commit) bool {
+	for _, who := range []string{c.AuthorLogin, c.AuthorName} {
+		who = strings.ToLower(who)
+		if who == "" {
+			continue
+		}
+		for _, p := range m.patterns {
+			if matchWildcard(p, who) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchWildcard reports whether s matches pattern, where '*' matches any run
+// of characters and everything else matches literally. filepath.Match is not
+// used because "[bot]" would be read as a character class.
+func matchWildcard(pattern, s string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == s
+	}
+	if !strings.HasPrefix(s, parts[0]) {
+		return false
+	}
+	s = s[len(parts[0]):]
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(s, part)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(part):]
+	}
+	return len(s) >= len(last) && strings.HasSuffix(s, last)
+}
+
+// HumanActivity returns the dates of the newest human and newest bot commit.
+// When every commit is by a bot, the oldest commit's date is returned as the
+// human date: the last human commit happened no later than that.
+func HumanActivity(commits []github.Commit, m *BotMatcher) (human, bot time.Time) {
+	var oldest time.Time
+	for _, c := range commits {
+		if oldest.IsZero() || c.Date.Before(oldest) {
+			oldest = c.Date
+		}
+		if m.IsBot(c) {
+			if c.Date.After(bot) {
+				bot = c.Date
+			}
+		} else if c.Date.After(human) {
+			human = c.Date
+		}
+	}
+	if human.IsZero() && !bot.IsZero() {
+		human = oldest
+	}
+	return human, bot
+}
+
+// IsBotOnly reports whether a repository has gone a year without a human
+// commit. It is false until commit activity has been checked.
+func IsBotOnly(repo github.Repository) bool {
+	return !repo.LastHumanActivity.IsZero() && repo.DaysSinceHumanActivity() > botOnlyDays
+}
+
+// CommitFetcher fetches the most recent commits of a repository.
+type CommitFetcher interface {
+	FetchRecentCommits(owner, name string, limit int) ([]github.Commit, error)
+}
+
+// ActivityChecker records the last human and bot activity of repositories.
+type ActivityChecker struct {
+	fetcher CommitFetcher
+	store   *store.Store
+	matcher *BotMatcher
+}
+
+// NewActivityChecker creates a checker that classifies commit authors with
+// DefaultBotPatterns plus botPatterns.
+func NewActivityChecker(fetcher CommitFetcher, s *store.Store, botPatterns []string) *ActivityChecker {
+	return &ActivityChecker{fetcher: fetcher, store: s, matcher: NewBotMatcher(botPatterns)}
+}
+
+// Check fetches a repository's recent commits and stores its activity. A
+// repository checked since its last push is skipped unless refresh is set;
+// the returned bool reports whether commits were fetched.
+func (c *ActivityChecker) Check(repo github.Repository, previous *store.RepoActivity, refresh bool) (store.RepoActivity, bool, error) {
+	if previous != nil && !refresh && previous.CheckedAt.After(repo.PushedAt) {
+		return *previous, false, nil
+	}
+
+	commits, err := c.fetcher.FetchRecentCommits(repo.Owner, repo.Name, RecentCommitLimit)
+	if err != nil {
+		return store.RepoActivity{}, false, err
+	}
+
+	human, bot := HumanActivity(commits, c.matcher)
+	activity := store.RepoActivity{
+		Owner:             repo.Owner,
+		RepoName:          repo.Name,
+		LastHumanActivity: human,
+		LastBotActivity:   bot,
+		CheckedAt:         time.Now(),
+	}
+	if err := c.store.SaveRepoActivity(activity); err != nil {
+		return store.RepoActivity{}, false, fmt.Errorf("saving activity for %s: %w", repo.FullName(), err)
+	}
+	return activity, true, nil
+}
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package analyze
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+func TestBotMatcher_IsBot(t *testing.T) {
+	m := NewBotMatcher([]string{" Release-Bot ", "acme-*-ci", ""})
+
+	tests := []struct {
+		name   string
+		commit github.Commit
+		want   bool
+	}{
+		{"app login", github.Commit{AuthorLogin: "dependabot[bot]"}, true},
+		{"any app suffix", github.Commit{AuthorLogin: "my-app[bot]"}, true},
+		{"bot author name without login", github.Commit{AuthorName: "Renovate Bot"}, true},
+		{"github actions", github.Commit{AuthorName: "github-actions"}, true},
+		{"configured account, any case", github.Commit{AuthorLogin: "release-bot"}, true},
+		{"configured wildcard", github.Commit{AuthorLogin: "acme-deploy-ci"}, true},
+		{"human", github.Commit{AuthorLogin: "octocat", AuthorName: "Mona Lisa"}, false},
+		{"brackets are literal", github.Commit{AuthorLogin: "bbot"}, false},
+		{"wildcard must match whole name", github.Commit{AuthorLogin: "acme-ci"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, m.IsBot(tt.commit))
+		})
+	}
+}
+
+func TestHumanActivity(t *testing.T) {
+	m := NewBotMatcher(nil)
+	day := func(d int) time.Time { return time.Date(2025, 1, d, 0, 0, 0, 0, time.UTC) }
+
+	human, bot := HumanActivity([]github.Commit{
+		{AuthorLogin: "dependabot[bot]", Date: day(20)},
+		{AuthorLogin: "octocat", Date: day(10)},
+		{AuthorLogin: "octocat", Date: day(5)},
+	}, m)
+	assert.Equal(t, day(10), human)
+	assert.Equal(t, day(20), bot)
+
+	// With only bot commits, the last human commit is no later than the oldest one
+	human, bot = HumanActivity([]github.Commit{
+		{AuthorLogin: "renovate[bot]", Date: day(20)},
+		{AuthorLogin: "renovate[bot]", Date: day(3)},
+	}, m)
+	assert.Equal(t, day(3), human)
+	assert.Equal(t, day(20), bot)
+
+	human, bot = HumanActivity(nil, m)
+	assert.True(t, human.IsZero())
+	assert.True(t, bot.IsZero())
+}
+
+func TestAssess_BotOnlyActivity(t *testing.T) {
+	repo := testutil.NewTestRepo(testutil.WithDaysInactive(5), testutil.WithStars(3), testutil.WithHumanInactive(400))
+	a := Assess(repo)
+	assert.Equal(t, []string{"bot_only_activity"}, a.Codes())
+	assert.Equal(t, 25, a.Score)
+
+	// Unchecked repos and recent human activity don't count
+	assert.False(t, Assess(testutil.NewTestRepo(testutil.WithDaysInactive(5), testutil.WithStars(3))).Candidate)
+	repo = testutil.NewTestRepo(testutil.WithDaysInactive(5), testutil.WithStars(3), testutil.WithHumanInactive(30))
+	assert.False(t, Assess(repo).Candidate)
+
+	// Long-inactive repos are already flagged by their push date
+	repo = testutil.NewTestRepo(testutil.WithDaysInactive(500), testutil.WithStars(3), testutil.WithHumanInactive(800))
+	assert.Equal(t, []string{"inactive_1y"}, Assess(repo).Codes())
+}
+
+// fakeCommitFetcher returns fixed commits and counts calls.
+type fakeCommitFetcher struct {
+	commits []github.Commit
+	err     error
+	calls   int
+}
+
+func (f *fakeCommitFetcher) FetchRecentCommits(owner, name string, limit int) ([]github.Commit, error) {
+	f.calls++
+	return f.commits, f.err
+}
+
+func TestActivityChecker_Check(t *testing.T) {
+	s := testutil.NewStore(t)
+	human := time.Now().AddDate(-2, 0, 0).Truncate(time.Second).UTC()
+	fetcher := &fakeCommitFetcher{commits: []github.Commit{
+		{AuthorLogin: "dependabot[bot]", Date: time.Now().Truncate(time.Second).UTC()},
+		{AuthorLogin: "octocat", Date: human},
+	}}
+	checker := NewActivityChecker(fetcher, s, nil)
+	repo := testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("widget"), testutil.WithDaysInactive(1))
+
+	activity, fetched, err := checker.Check(repo, nil, false)
+	require.NoError(t, err)
+	assert.True(t, fetched)
+	assert.Equal(t, human, activity.LastHumanActivity)
+
+	stored, err := s.GetRepoActivities("acme")
+	require.NoError(t, err)
+	assert.Equal(t, human, stored["widget"].LastHumanActivity.UTC())
+
+	// Unchanged since the last check: no fetch unless refreshing
+	previous := stored["widget"]
+	_, fetched, err = checker.Check(repo, &previous, false)
+	require.NoError(t, err)
+	assert.False(t, fetched)
+	_, fetched, err = checker.Check(repo, &previous, true)
+	require.NoError(t, err)
+	assert.True(t, fetched)
+	assert.Equal(t, 2, fetcher.calls)
+
+	fetcher.err = errors.New("rate limited")
+	_, _, err = checker.Check(repo, &store.RepoActivity{}, false)
+	assert.Error(t, err)
+}
@@ -0,0 +1,251 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package analyze
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/llbbl/repjan/internal/github"
+)
+
+// AI backend names accepted by NewAnalyzer.
+const (
+	BackendFabric = "fabric"
+	BackendOllama = "ollama"
+	BackendOpenAI = "openai"
+)
+
+// Backends lists the supported AI backends.
+func Backends() []string {
+	return []string{BackendFabric, BackendOllama, BackendOpenAI}
+}
+
+// DefaultAITimeout bounds a single AI request when no timeout is configured.
+const DefaultAITimeout = 60 * time.Second
+
+// systemPrompt instructs HTTP backends; Fabric supplies its own through the
+// analyze-repo pattern.
+const systemPrompt = `You help maintainers triage GitHub repositories for archiving.
+For each repository you are given, recommend archive, keep or
+needs-owner-review and explain the recommendation in one or two short
+sentences based on its activity, engagement, description and README.
+Follow any response format you are asked for exactly.`
+
+// Analyzer produces AI-assisted archive recommendations for repositories.
+type Analyzer interface {
+	// Name returns the backend name, e.g. "fabric" or "ollama".
+	Name() string
+	// AnalyzeRepo analyzes a single repository together with its README.
+	AnalyzeRepo(ctx context.Context, repo github.Repository, readme string) (string, error)
+	// AnalyzeRepos analyzes several repositories, splitting them into as
+	// many requests as the token budget requires.
+	AnalyzeRepos(ctx context.Context, repos []github.Repository) (string, error)
+}
+
+// AIConfig selects and configures an AI backend.
+type AIConfig struct {
+	Backend     string        // fabric, ollama or openai (default: fabric)
+	FabricPath  string        // path to the fabric binary
+	URL         string        // base URL for ollama and openai backends
+	Model       string        // model name for ollama and openai backends
+	APIKey      string        // bearer token for the openai backend
+	Timeout     time.Duration // HTTP client timeout (default: DefaultAITimeout)
+	TokenBudget int           // approximate tokens per prompt (default: DefaultTokenBudget)
+}
+
+// NewAnalyzer creates the analyzer selected by c.
+func NewAnalyzer(c AIConfig) (Analyzer, error) {
+	timeout := c.Timeout
+	if timeout <= 0 {
+		timeout = DefaultAITimeout
+	}
+	httpClient := &http.Client{Timeout: timeout}
+
+	var a interface {
+		Analyzer
+		SetTokenBudget(tokens int)
+	}
+	switch c.Backend {
+	case "", BackendFabric:
+		a = NewFabricAnalyzer(c.FabricPath)
+	case BackendOllama:
+		a = NewOllamaAnalyzer(c.URL, c.Model, httpClient)
+	case BackendOpenAI:
+		if c.Model == "" {
+			return nil, fmt.Errorf("the openai backend requires a model")
+		}
+		a = NewOpenAIAnalyzer(c.URL, c.Model, c.APIKey, httpClient)
+	default:
+		return nil, fmt.Errorf("unknown AI backend %q: must be one of %v", c.Backend, Backends())
+	}
+	a.SetTokenBudget(c.TokenBudget)
+	return a, nil
+}
+
+// promptBudget sizes an analyzer's prompts. It is embedded by every
+// analyzer; the zero value uses DefaultTokenBudget.
+type promptBudget struct {
+	tokens int
+}
+
+// SetTokenBudget sets the approximate number of tokens per prompt. READMEs
+// are condensed and batches split to fit. Zero or less restores the default.
+func (b *promptBudget) SetTokenBudget(tokens int) {
+	b.tokens = max(tokens, 0)
+}
+
+// budget returns the token budget per prompt.
+func (b *promptBudget) budget() int {
+	if b.tokens <= 0 {
+		return DefaultTokenBudget
+	}
+	return b.tokens
+}
+
+// analyzeBatches splits repos into batches that fit budget tokens, sends each
+// batch's prompt and joins the replies.
+func analyzeBatches(ctx context.Context, repos []github.Repository, budget int, send func(context.Context, string) (string, error)) (string, error) {
+	batches := batchRepos(repos, budget)
+	if len(batches) == 1 {
+		return send(ctx, reposPrompt(batches[0]))
+	}
+
+	outputs := make([]string, 0, len(batches))
+	for i, batch := range batches {
+		output, err := send(ctx, reposPrompt(batch))
+		if err != nil {
+			return "", fmt.Errorf("batch %d of %d: %w", i+1, len(batches), err)
+		}
+		outputs = append(outputs, fmt.Sprintf("=== Batch %d of %d ===\n%s", i+1, len(batches), strings.TrimSpace(output)))
+	}
+	return strings.Join(outputs, "\n\n"), nil
+}
+
+// batchRepos splits repos into groups whose prompts fit budget tokens. Every
+// group holds at least one repository.
+func batchRepos(repos []github.Repository, budget int) [][]github.Repository {
+	var batches [][]github.Repository
+	var current []github.Repository
+	used := EstimateTokens(reposPrompt(nil))
+	for _, repo := range repos {
+		tokens := EstimateTokens(repoSummary(len(current)+1, repo))
+		if len(current) > 0 && used+tokens > budget {
+			batches = append(batches, current)
+			current = nil
+			used = EstimateTokens(reposPrompt(nil))
+			tokens = EstimateTokens(repoSummary(1, repo))
+		}
+		current = append(current, repo)
+		used += tokens
+	}
+	if len(current) > 0 {
+		batches = append(batches, current)
+	}
+	return batches
+}
+
+// repoPrompt describes a single repository and its README, condensed so the
+// prompt fits budget tokens, and asks for a structured verdict.
+func repoPrompt(repo github.Repository, readme string, budget int) string {
+	metadata := fmt.Sprintf(`Repository: %s
+Description: %s
+Language: %s
+Stars: %d, Forks: %d
+Last Activity: %d days ago
+
+README:
+`,
+		repo.FullName(),
+		repo.Description,
+		repo.PrimaryLanguage,
+		repo.StargazerCount,
+		repo.ForkCount,
+		repo.DaysSinceActivity,
+	)
+
+	readmeBudget := max(budget-EstimateTokens(metadata+verdictInstructions), minReadmeTokens)
+	return metadata + CondenseReadme(readme, readmeBudget) + "\n\n" + verdictInstructions
+}
+
+// reposPrompt describes several repositories without their READMEs.
+func reposPrompt(repos []github.Repository) string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("Analyzing %d repositories:\n\n", len(repos)))
+
+	for i, repo := range repos {
+		builder.WriteString(repoSummary(i+1, repo))
+	}
+
+	return builder.String()
+}
+
+// repoSummary describes repository number n of a batch prompt.
+func repoSummary(n int, repo github.Repository) string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("--- Repository %d ---\n", n))
+	builder.WriteString(fmt.Sprintf("Name: %s\n", repo.FullName()))
+	builder.WriteString(fmt.Sprintf("Description: %s\n", repo.Description))
+	builder.WriteString(fmt.Sprintf("Language: %s\n", repo.PrimaryLanguage))
+	builder.WriteString(fmt.Sprintf("Stars: %d, Forks: %d\n", repo.StargazerCount, repo.ForkCount))
+	builder.WriteString(fmt.Sprintf("Last Activity: %d days ago\n", repo.DaysSinceActivity))
+	builder.WriteString("\n")
+	return builder.String()
+}
+
+// chatMessage is a role-tagged message in a chat request.
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// chatMessages builds the system and user messages for a prompt.
+func chatMessages(prompt string) []chatMessage {
+	return []chatMessage{
+		{Role: "system", Content: systemPrompt},
+		{Role: "user", Content: prompt},
+	}
+}
+
+// maxErrorBody caps how much of an error response is included in errors.
+const maxErrorBody = 512
+
+// postJSON sends body as JSON to url and decodes a successful response into out.
+func postJSON(ctx context.Context, client *http.Client, url string, headers map[string]string, body, out any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("encoding request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return fmt.Errorf("unexpected status %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+	return nil
+}
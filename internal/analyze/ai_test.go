@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package analyze
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+// chatRequest is the request body shared by the Ollama and OpenAI APIs.
+type chatRequest struct {
+	Model    string        `json:"model"`
+	Messages []chatMessage `json:"messages"`
+	Stream   *bool         `json:"stream"`
+}
+
+func TestNewAnalyzer(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   AIConfig
+		wantName string
+		wantErr  string
+	}{
+		{"default is fabric", AIConfig{}, BackendFabric, ""},
+		{"fabric", AIConfig{Backend: "fabric", FabricPath: "/opt/fabric"}, BackendFabric, ""},
+		{"ollama", AIConfig{Backend: "ollama"}, BackendOllama, ""},
+		{"openai", AIConfig{Backend: "openai", Model: "gpt-4o-mini"}, BackendOpenAI, ""},
+		{"openai without model", AIConfig{Backend: "openai"}, "", "requires a model"},
+		{"unknown backend", AIConfig{Backend: "clippy"}, "", "unknown AI backend"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := NewAnalyzer(tt.config)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantName, a.Name())
+		})
+	}
+}
+
+func TestOllamaAnalyzer_AnalyzeRepo(t *testing.T) {
+	var got chatRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/chat", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		_, _ = w.Write([]byte(`{"message": {"role": "assistant", "content": "ARCHIVE: inactive"}, "done": true}`))
+	}))
+	defer srv.Close()
+
+	a := NewOllamaAnalyzer(srv.URL+"/", "", srv.Client())
+	repo := testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("widget"))
+
+	result, err := a.AnalyzeRepo(context.Background(), repo, "# widget")
+	require.NoError(t, err)
+	assert.Equal(t, "ARCHIVE: inactive", result)
+
+	assert.Equal(t, DefaultOllamaModel, got.Model)
+	require.NotNil(t, got.Stream)
+	assert.False(t, *got.Stream)
+	require.Len(t, got.Messages, 2)
+	assert.Equal(t, "system", got.Messages[0].Role)
+	assert.Contains(t, got.Messages[1].Content, "Repository: acme/widget")
+	assert.Contains(t, got.Messages[1].Content, "# widget")
+}
+
+func TestOllamaAnalyzer_ServerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error": "model \"llama3.2\" not found"}`))
+	}))
+	defer srv.Close()
+
+	a := NewOllamaAnalyzer(srv.URL, "", srv.Client())
+	_, err := a.AnalyzeRepos(context.Background(), []github.Repository{testutil.NewTestRepo()})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ollama batch analysis failed")
+	assert.Contains(t, err.Error(), "not found")
+}
+
+func TestOpenAIAnalyzer_AnalyzeRepos(t *testing.T) {
+	var got chatRequest
+	var auth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/chat/completions", r.URL.Path)
+		auth = r.Header.Get("Authorization")
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		_, _ = w.Write([]byte(`{"choices": [{"index": 0, "message": {"role": "assistant", "content": "KEEP both"}}]}`))
+	}))
+	defer srv.Close()
+
+	a := NewOpenAIAnalyzer(srv.URL+"/v1", "gpt-4o-mini", "secret", srv.Client())
+	repos := []github.Repository{
+		testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("one")),
+		testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("two")),
+	}
+
+	result, err := a.AnalyzeRepos(context.Background(), repos)
+	require.NoError(t, err)
+	assert.Equal(t, "KEEP both", result)
+	assert.Equal(t, "Bearer secret", auth)
+	assert.Equal(t, "gpt-4o-mini", got.Model)
+	assert.Nil(t, got.Stream)
+	assert.Contains(t, got.Messages[1].Content, "Analyzing 2 repositories")
+	assert.Contains(t, got.Messages[1].Content, "Name: acme/two")
+}
+
+func TestOpenAIAnalyzer_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr string
+	}{
+		{"unauthorized", http.StatusUnauthorized, `{"error": {"message": "bad key"}}`, "401"},
+		{"no choices", http.StatusOK, `{"choices": []}`, "no choices"},
+		{"invalid json", http.StatusOK, `not json`, "decoding response"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+
+			a := NewOpenAIAnalyzer(srv.URL, "model", "", srv.Client())
+			_, err := a.AnalyzeRepo(context.Background(), testutil.NewTestRepo(), "")
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "openai analysis failed")
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestHTTPAnalyzers_EmptyBatch(t *testing.T) {
+	for _, a := range []Analyzer{NewOllamaAnalyzer("", "", nil), NewOpenAIAnalyzer("", "model", "", nil)} {
+		_, err := a.AnalyzeRepos(context.Background(), nil)
+		require.Error(t, err, a.Name())
+		assert.Contains(t, err.Error(), "no repositories")
+	}
+}
+
+func TestOllamaAnalyzer_AnalyzeReposInBatches(t *testing.T) {
+	var prompts []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var got chatRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		prompts = append(prompts, got.Messages[1].Content)
+		_, _ = w.Write([]byte(`{"message": {"role": "assistant", "content": "KEEP"}}`))
+	}))
+	defer srv.Close()
+
+	a := NewOllamaAnalyzer(srv.URL, "", srv.Client())
+	a.SetTokenBudget(150) // room for about two repositories per prompt
+	var repos []github.Repository
+	for _, name := range []string{"one", "two", "three", "four", "five"} {
+		repos = append(repos, testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName(name)))
+	}
+
+	result, err := a.AnalyzeRepos(context.Background(), repos)
+	require.NoError(t, err)
+	require.Greater(t, len(prompts), 1)
+	for _, p := range prompts {
+		assert.LessOrEqual(t, EstimateTokens(p), 150)
+	}
+	assert.Contains(t, result, "=== Batch 1 of")
+	assert.Contains(t, prompts[len(prompts)-1], "Name: acme/five")
+}
+
+func TestBatchRepos(t *testing.T) {
+	repos := make([]github.Repository, 10)
+	for i := range repos {
+		repos[i] = testutil.NewTestRepo()
+	}
+
+	assert.Len(t, batchRepos(repos, DefaultTokenBudget), 1)
+	// Every batch holds at least one repo, however small the budget
+	assert.Len(t, batchRepos(repos, 1), 10)
+}
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package analyze
+
+import (
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+// Assessor runs the archive heuristics with the cached README signals and
+// latest AI verdicts of an owner's repositories, so exports, reports and
+// the MCP server agree with the TUI.
+type Assessor struct {
+	readmes  map[string]ReadmeSignals // key: owner/name
+	verdicts map[string]Verdict       // key: owner/name
+}
+
+// LoadAssessor loads the cached README signals and AI verdicts for owner.
+func LoadAssessor(s *store.Store, owner string) (*Assessor, error) {
+	readmes, err := CachedReadmeSignals(s, owner)
+	if err != nil {
+		return nil, err
+	}
+	verdicts, err := LoadVerdicts(s, owner)
+	if err != nil {
+		return nil, err
+	}
+	return &Assessor{readmes: readmes, verdicts: verdicts}, nil
+}
+
+// Assess runs the archive heuristics on repo with its stored signals. A nil
+// Assessor uses repository metadata only.
+func (a *Assessor) Assess(repo github.Repository) Assessment {
+	var signals Signals
+	if a == nil {
+		return AssessWithSignals(repo, signals)
+	}
+	if s, ok := a.readmes[repo.FullName()]; ok {
+		signals.Readme = &s
+	}
+	if v, ok := a.verdicts[repo.FullName()]; ok {
+		signals.Verdict = &v
+	}
+	return AssessWithSignals(repo, signals)
+}
@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package analyze
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+func TestAssessor(t *testing.T) {
+	s := testutil.NewStore(t)
+	widget := testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("widget"), testutil.WithDaysInactive(10), testutil.WithStars(5))
+	gadget := testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("gadget"), testutil.WithDaysInactive(10), testutil.WithStars(5))
+	require.NoError(t, s.SaveReadme("acme", "widget", "# widget\n\nThis project is deprecated.\n"))
+	require.NoError(t, SaveVerdict(s, gadget, BackendOllama, Verdict{Decision: DecisionArchive, Confidence: 1}))
+
+	a, err := LoadAssessor(s, "acme")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"readme_deprecated"}, a.Assess(widget).Codes())
+	assert.Equal(t, []string{"ai_archive"}, a.Assess(gadget).Codes())
+
+	// Without stored signals only repository metadata counts
+	var none *Assessor
+	assert.Empty(t, none.Assess(widget).Codes())
+}
@@ -0,0 +1,398 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package analyze
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+// minClassExamples is how many archived and kept repos training requires.
+const minClassExamples = 3
+
+// Training settings for the logistic regression.
+const (
+	trainIterations   = 2000
+	trainLearningRate = 0.1
+	trainL2           = 0.01
+)
+
+// classifierFeatures names the classifier inputs, in the order repoFeatures
+// returns them.
+var classifierFeatures = []string{
+	"days_inactive",
+	"age_years",
+	"stars",
+	"forks",
+	"no_engagement",
+	"fork",
+	"private",
+	"legacy_language",
+	"no_description",
+}
+
+// featureLabels describes each feature for explanations.
+var featureLabels = map[string]string{
+	"days_inactive":   "days since last push",
+	"age_years":       "age in years",
+	"stars":           "stars",
+	"forks":           "forks",
+	"no_engagement":   "no stars or forks",
+	"fork":            "is a fork",
+	"private":         "is private",
+	"legacy_language": "legacy language",
+	"no_description":  "no description",
+}
+
+// repoFeatures returns the classifier inputs for repo as they were at asOf.
+// Counts are log-scaled; flags are 0 or 1.
+func repoFeatures(repo github.Repository, asOf time.Time) []float64 {
+	days := float64(repo.DaysSinceActivity)
+	if !repo.PushedAt.IsZero() {
+		days = math.Max(asOf.Sub(repo.PushedAt).Hours()/24, 0)
+	}
+	age := 0.0
+	if !repo.CreatedAt.IsZero() {
+		age = math.Max(asOf.Sub(repo.CreatedAt).Hours()/24/365, 0)
+	}
+
+	return []float64{
+		math.Log1p(days),
+		age,
+		math.Log1p(float64(repo.StargazerCount)),
+		math.Log1p(float64(repo.ForkCount)),
+		flag(repo.StargazerCount == 0 && repo.ForkCount == 0),
+		flag(repo.IsFork),
+		flag(repo.IsPrivate),
+		flag(IsLegacyLanguage(repo.PrimaryLanguage)),
+		flag(repo.Description == ""),
+	}
+}
+
+// flag converts a bool to a 0/1 feature.
+func flag(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// Example is a labeled archive decision used for training and evaluation.
+type Example struct {
+	Repo     github.Repository
+	AsOf     time.Time // when the decision was made
+	Archived bool      // true for archive, false for keep
+}
+
+// DecisionActions are the repo_changes actions that record a keep or
+// archive decision.
+var DecisionActions = []string{"archived", "unarchived", "unmarked"}
+
+// TrainingExamples labels repos from their change history. A repo's latest
+// decision wins: "archived" is an archive example and "unarchived" or
+// "unmarked" a keep example, with features as of the decision. Active repos
+// with no decision and no pending mark count as kept today. Repos archived
+// outside repjan and repos still marked are left out.
+func TrainingExamples(repos []github.Repository, changes []store.RepoChange, marked []string, now time.Time) []Example {
+	latest := make(map[string]store.RepoChange)
+	for _, c := range changes {
+		if prev, ok := latest[c.RepoName]; !ok || !c.PerformedAt.Before(prev.PerformedAt) {
+			latest[c.RepoName] = c
+		}
+	}
+	pending := make(map[string]bool, len(marked))
+	for _, name := range marked {
+		pending[name] = true
+	}
+
+	var examples []Example
+	for _, repo := range repos {
+		if c, ok := latest[repo.Name]; ok {
+			examples = append(examples, Example{Repo: repo, AsOf: c.PerformedAt, Archived: c.Action == "archived"})
+			continue
+		}
+		if !repo.IsArchived && !pending[repo.Name] {
+			examples = append(examples, Example{Repo: repo, AsOf: now})
+		}
+	}
+	return examples
+}
+
+// SplitExamples divides examples into training and held-out sets. About
+// holdoutPercent of repos are held out, chosen by a hash of the repo name so
+// a repo stays on the same side across runs.
+func SplitExamples(examples []Example, holdoutPercent int) (train, test []Example) {
+	for _, e := range examples {
+		h := fnv.New32a()
+		h.Write([]byte(e.Repo.FullName()))
+		if int(h.Sum32()%100) < holdoutPercent {
+			test = append(test, e)
+		} else {
+			train = append(train, e)
+		}
+	}
+	return train, test
+}
+
+// Classifier is a logistic regression that predicts the probability a repo
+// will be archived, learned from past decisions.
+type Classifier struct {
+	Features []string  `json:"features"`
+	Weights  []float64 `json:"weights"`
+	Bias     float64   `json:"bias"`
+	Mean     []float64 `json:"mean"`  // feature means used for standardizing
+	Scale    []float64 `json:"scale"` // feature standard deviations
+}
+
+// TrainClassifier fits a classifier to examples. Archived and kept examples
+// are weighted equally overall, so the model ranks well even when one
+// outcome is much rarer.
+func TrainClassifier(examples []Example) (*Classifier, error) {
+	var archived, kept int
+	for _, e := range examples {
+		if e.Archived {
+			archived++
+		} else {
+			kept++
+		}
+	}
+	if archived < minClassExamples || kept < minClassExamples {
+		return nil, fmt.Errorf("need at least %d archived and %d kept repos to train, have %d and %d",
+			minClassExamples, minClassExamples, archived, kept)
+	}
+
+	n := len(classifierFeatures)
+	x := make([][]float64, len(examples))
+	for i, e := range examples {
+		x[i] = repoFeatures(e.Repo, e.AsOf)
+	}
+
+	c := &Classifier{
+		Features: classifierFeatures,
+		Weights:  make([]float64, n),
+		Mean:     make([]float64, n),
+		Scale:    make([]float64, n),
+	}
+	for j := range n {
+		for _, row := range x {
+			c.Mean[j] += row[j]
+		}
+		c.Mean[j] /= float64(len(x))
+		for _, row := range x {
+			c.Scale[j] += (row[j] - c.Mean[j]) * (row[j] - c.Mean[j])
+		}
+		c.Scale[j] = math.Sqrt(c.Scale[j] / float64(len(x)))
+		if c.Scale[j] == 0 {
+			c.Scale[j] = 1
+		}
+	}
+	for _, row := range x {
+		c.standardize(row)
+	}
+
+	// Balance the classes so each contributes half of the loss
+	classWeight := map[bool]float64{
+		true:  float64(len(examples)) / (2 * float64(archived)),
+		false: float64(len(examples)) / (2 * float64(kept)),
+	}
+
+	grad := make([]float64, n)
+	for range trainIterations {
+		clear(grad)
+		gradBias := 0.0
+		for i, row := range x {
+			target := flag(examples[i].Archived)
+			diff := (sigmoid(c.logit(row)) - target) * classWeight[examples[i].Archived]
+			for j, v := range row {
+				grad[j] += diff * v
+			}
+			gradBias += diff
+		}
+		for j := range c.Weights {
+			c.Weights[j] -= trainLearningRate * (grad[j]/float64(len(x)) + trainL2*c.Weights[j])
+		}
+		c.Bias -= trainLearningRate * gradBias / float64(len(x))
+	}
+	return c, nil
+}
+
+// standardize scales raw feature values in place.
+func (c *Classifier) standardize(row []float64) {
+	for j := range row {
+		row[j] = (row[j] - c.Mean[j]) / c.Scale[j]
+	}
+}
+
+// logit returns the log-odds of archiving for standardized features.
+func (c *Classifier) logit(row []float64) float64 {
+	z := c.Bias
+	for j, v := range row {
+		z += c.Weights[j] * v
+	}
+	return z
+}
+
+// sigmoid maps log-odds to a probability.
+func sigmoid(z float64) float64 {
+	return 1 / (1 + math.Exp(-z))
+}
+
+// Probability returns the predicted probability that repo is archived,
+// judged as of asOf.
+func (c *Classifier) Probability(repo github.Repository, asOf time.Time) float64 {
+	row := repoFeatures(repo, asOf)
+	c.standardize(row)
+	return sigmoid(c.logit(row))
+}
+
+// FeatureContribution is one feature's share of a prediction in log-odds;
+// positive values push toward archiving.
+type FeatureContribution struct {
+	Feature      string
+	Label        string
+	Contribution float64
+}
+
+// String formats the contribution for display, e.g. "+1.40 days since last push".
+func (f FeatureContribution) String() string {
+	return fmt.Sprintf("%+.2f %s", f.Contribution, f.Label)
+}
+
+// Explain returns every feature's contribution to repo's prediction,
+// largest effect first.
+func (c *Classifier) Explain(repo github.Repository, asOf time.Time) []FeatureContribution {
+	row := repoFeatures(repo, asOf)
+	c.standardize(row)
+	contributions := make([]FeatureContribution, len(row))
+	for j, v := range row {
+		contributions[j] = c.contribution(j, c.Weights[j]*v)
+	}
+	sortContributions(contributions)
+	return contributions
+}
+
+// Importance returns the learned weight of every feature, largest first.
+// Weights apply to standardized features, so they are directly comparable.
+func (c *Classifier) Importance() []FeatureContribution {
+	contributions := make([]FeatureContribution, len(c.Weights))
+	for j, w := range c.Weights {
+		contributions[j] = c.contribution(j, w)
+	}
+	sortContributions(contributions)
+	return contributions
+}
+
+// contribution describes feature j with the given effect.
+func (c *Classifier) contribution(j int, effect float64) FeatureContribution {
+	name := c.Features[j]
+	label := featureLabels[name]
+	if label == "" {
+		label = name
+	}
+	return FeatureContribution{Feature: name, Label: label, Contribution: effect}
+}
+
+// sortContributions orders contributions by absolute effect, largest first.
+func sortContributions(contributions []FeatureContribution) {
+	sort.SliceStable(contributions, func(i, j int) bool {
+		return math.Abs(contributions[i].Contribution) > math.Abs(contributions[j].Contribution)
+	})
+}
+
+// Evaluation counts a classifier's predictions on labeled examples, treating
+// a probability of 0.5 or more as a prediction to archive.
+type Evaluation struct {
+	TruePositives  int // predicted archive, was archived
+	FalsePositives int // predicted archive, was kept
+	TrueNegatives  int // predicted keep, was kept
+	FalseNegatives int // predicted keep, was archived
+}
+
+// Total returns the number of evaluated examples.
+func (e Evaluation) Total() int {
+	return e.TruePositives + e.FalsePositives + e.TrueNegatives + e.FalseNegatives
+}
+
+// Accuracy returns the fraction of correct predictions.
+func (e Evaluation) Accuracy() float64 {
+	return ratio(e.TruePositives+e.TrueNegatives, e.Total())
+}
+
+// Precision returns the fraction of archive predictions that were archived.
+func (e Evaluation) Precision() float64 {
+	return ratio(e.TruePositives, e.TruePositives+e.FalsePositives)
+}
+
+// Recall returns the fraction of archived repos predicted to be archived.
+func (e Evaluation) Recall() float64 {
+	return ratio(e.TruePositives, e.TruePositives+e.FalseNegatives)
+}
+
+// ratio returns n/d, or 0 when d is 0.
+func ratio(n, d int) float64 {
+	if d == 0 {
+		return 0
+	}
+	return float64(n) / float64(d)
+}
+
+// Evaluate scores the classifier on examples.
+func (c *Classifier) Evaluate(examples []Example) Evaluation {
+	var e Evaluation
+	for _, ex := range examples {
+		predicted := c.Probability(ex.Repo, ex.AsOf) >= 0.5
+		switch {
+		case predicted && ex.Archived:
+			e.TruePositives++
+		case predicted:
+			e.FalsePositives++
+		case ex.Archived:
+			e.FalseNegatives++
+		default:
+			e.TrueNegatives++
+		}
+	}
+	return e
+}
+
+// SaveClassifier stores the classifier for owner along with the number of
+// training examples and the held-out accuracy, if any.
+func SaveClassifier(s *store.Store, owner string, c *Classifier, examples int, accuracy *float64) error {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("encoding classifier: %w", err)
+	}
+	return s.SaveArchiveModel(store.ArchiveModel{
+		Owner:    owner,
+		Model:    string(data),
+		Examples: examples,
+		Accuracy: accuracy,
+	})
+}
+
+// LoadClassifier returns owner's stored classifier and its metadata. It
+// returns store.ErrNotFound when no model has been trained.
+func LoadClassifier(s *store.Store, owner string) (*Classifier, *store.ArchiveModel, error) {
+	m, err := s.GetArchiveModel(owner)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var c Classifier
+	if err := json.Unmarshal([]byte(m.Model), &c); err != nil {
+		return nil, nil, fmt.Errorf("decoding classifier: %w", err)
+	}
+	if !slices.Equal(c.Features, classifierFeatures) || len(c.Weights) != len(c.Features) || len(c.Mean) != len(c.Features) || len(c.Scale) != len(c.Features) {
+		return nil, nil, fmt.Errorf("stored classifier does not match the current features; run 'repjan model train' again")
+	}
+	return &c, m, nil
+}
@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package analyze
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+// syntheticExamples returns archived repos that are old and unloved and
+// kept repos that are recent and starred.
+func syntheticExamples(n int) []Example {
+	now := time.Now()
+	var examples []Example
+	for i := range n {
+		examples = append(examples,
+			Example{
+				Repo: testutil.NewTestRepo(
+					testutil.WithName(fmt.Sprintf("old-%d", i)),
+					testutil.WithDaysInactive(700+i*10),
+					testutil.WithStars(0),
+					testutil.WithForks(0),
+				),
+				AsOf:     now,
+				Archived: true,
+			},
+			Example{
+				Repo: testutil.NewTestRepo(
+					testutil.WithName(fmt.Sprintf("new-%d", i)),
+					testutil.WithDaysInactive(5+i),
+					testutil.WithStars(20+i),
+				),
+				AsOf: now,
+			},
+		)
+	}
+	return examples
+}
+
+func TestTrainingExamples(t *testing.T) {
+	now := time.Now()
+	archivedAt := now.AddDate(0, -6, 0)
+	repos := []github.Repository{
+		testutil.NewTestRepo(testutil.WithName("archived"), testutil.WithArchived(true)),
+		testutil.NewTestRepo(testutil.WithName("restored")),
+		testutil.NewTestRepo(testutil.WithName("active")),
+		testutil.NewTestRepo(testutil.WithName("pending")),
+		testutil.NewTestRepo(testutil.WithName("external"), testutil.WithArchived(true)),
+	}
+	changes := []store.RepoChange{
+		{RepoName: "archived", Action: "archived", PerformedAt: archivedAt},
+		{RepoName: "restored", Action: "archived", PerformedAt: archivedAt},
+		{RepoName: "restored", Action: "unarchived", PerformedAt: archivedAt.Add(time.Hour)},
+	}
+
+	examples := TrainingExamples(repos, changes, []string{"pending"}, now)
+
+	labels := make(map[string]bool)
+	for _, e := range examples {
+		labels[e.Repo.Name] = e.Archived
+		if e.Repo.Name == "archived" {
+			assert.Equal(t, archivedAt, e.AsOf)
+		}
+	}
+	assert.Equal(t, map[string]bool{"archived": true, "restored": false, "active": false}, labels)
+}
+
+func TestTrainClassifier(t *testing.T) {
+	train, test := SplitExamples(syntheticExamples(20), 25)
+	require.NotEmpty(t, test)
+
+	c, err := TrainClassifier(train)
+	require.NoError(t, err)
+
+	eval := c.Evaluate(test)
+	assert.Equal(t, len(test), eval.Total())
+	assert.GreaterOrEqual(t, eval.Accuracy(), 0.9)
+
+	stale := testutil.NewTestRepo(testutil.WithDaysInactive(900), testutil.WithStars(0), testutil.WithForks(0))
+	fresh := testutil.NewTestRepo(testutil.WithDaysInactive(3), testutil.WithStars(40))
+	assert.Greater(t, c.Probability(stale, time.Now()), 0.5)
+	assert.Less(t, c.Probability(fresh, time.Now()), 0.5)
+
+	// Inactivity drives this stale repo's prediction toward archiving
+	explanation := c.Explain(stale, time.Now())
+	require.Len(t, explanation, len(classifierFeatures))
+	var inactivity FeatureContribution
+	for _, f := range explanation {
+		if f.Feature == "days_inactive" {
+			inactivity = f
+		}
+	}
+	assert.Positive(t, inactivity.Contribution)
+	assert.Contains(t, inactivity.String(), "days since last push")
+}
+
+func TestTrainClassifier_NotEnoughHistory(t *testing.T) {
+	_, err := TrainClassifier(syntheticExamples(2))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "need at least 3 archived and 3 kept repos")
+}
+
+func TestSplitExamples_Stable(t *testing.T) {
+	examples := syntheticExamples(50)
+	_, first := SplitExamples(examples, 20)
+	_, second := SplitExamples(examples, 20)
+
+	assert.Equal(t, first, second)
+	assert.InDelta(t, 20, len(first), 12) // roughly 20% of 100
+	_, none := SplitExamples(examples, 0)
+	assert.Empty(t, none)
+}
+
+func TestEvaluation(t *testing.T) {
+	e := Evaluation{TruePositives: 3, FalsePositives: 1, TrueNegatives: 5, FalseNegatives: 1}
+	assert.Equal(t, 10, e.Total())
+	assert.InDelta(t, 0.8, e.Accuracy(), 0.001)
+	assert.InDelta(t, 0.75, e.Precision(), 0.001)
+	assert.InDelta(t, 0.75, e.Recall(), 0.001)
+	assert.Zero(t, Evaluation{}.Accuracy())
+}
+
+func TestClassifierStore(t *testing.T) {
+	s := testutil.NewStore(t)
+
+	_, _, err := LoadClassifier(s, "acme")
+	assert.ErrorIs(t, err, store.ErrNotFound)
+
+	c, err := TrainClassifier(syntheticExamples(5))
+	require.NoError(t, err)
+	accuracy := 0.9
+	require.NoError(t, SaveClassifier(s, "acme", c, 10, &accuracy))
+
+	loaded, meta, err := LoadClassifier(s, "acme")
+	require.NoError(t, err)
+	assert.Equal(t, c, loaded)
+	assert.Equal(t, 10, meta.Examples)
+	require.NotNil(t, meta.Accuracy)
+	assert.InDelta(t, 0.9, *meta.Accuracy, 0.001)
+}
@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package analyze
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// DefaultTokenBudget is the approximate size, in tokens, of a single prompt
+// when no budget is configured.
+const DefaultTokenBudget = 1500
+
+// charsPerToken is the rough number of characters per model token used to
+// estimate prompt sizes without a tokenizer.
+const charsPerToken = 4
+
+// Markers appended to shortened README text.
+const (
+	condensedMarker = "\n... [condensed]" // sections were left out
+	truncatedMarker = "\n... [truncated]" // text was cut off
+)
+
+// minReadmeTokens is the README allowance kept even when repository metadata
+// uses most of the budget.
+const minReadmeTokens = 100
+
+var (
+	codeFence      = regexp.MustCompile("^\\s*(```|~~~)")
+	htmlComment    = regexp.MustCompile(`(?s)<!--.*?-->`)
+	badgeLink      = regexp.MustCompile(`\[!\[[^\]]*\]\([^)]*\)\]\([^)]*\)`)
+	image          = regexp.MustCompile(`!\[[^\]]*\]\([^)]*\)`)
+	htmlTag        = regexp.MustCompile(`</?[a-zA-Z][^>]*>`)
+	headingLine    = regexp.MustCompile(`^#{1,6}\s+\S`)
+	keySectionName = regexp.MustCompile(`(?i)\b(?:status|deprecat\w*|maintenance|maintain\w*|archiv\w*|moved|notice|warning|important|migrat\w*|sunset|end[ -]of[ -]life|eol)\b`)
+)
+
+// EstimateTokens approximates the number of model tokens in s.
+func EstimateTokens(s string) int {
+	return (utf8.RuneCountInString(s) + charsPerToken - 1) / charsPerToken
+}
+
+// readmeSection is a heading and the text under it. The intro before the
+// first heading has an empty heading.
+type readmeSection struct {
+	heading string
+	body    string
+	key     bool // intro or a status, deprecation or migration section
+}
+
+// CondenseReadme shrinks a README to roughly maxTokens tokens while keeping
+// what matters for archive decisions. Code blocks, badges, images and HTML
+// are stripped; every heading is kept as an outline; the intro and sections
+// about status, deprecation or migration are kept before other sections.
+// Sizes are counted in characters, like EstimateTokens.
+func CondenseReadme(content string, maxTokens int) string {
+	limit := maxTokens * charsPerToken
+	sections := splitSections(stripReadmeNoise(content))
+	size := utf8.RuneCountInString
+
+	// Headings are always kept, so budget them first
+	used := size(condensedMarker)
+	for _, s := range sections {
+		used += size(s.heading) + 1
+	}
+
+	// Fill the rest with key sections, then others, in document order. Key
+	// sections are cut to fit; other sections are kept whole or left out.
+	kept := make([]string, len(sections))
+	omitted := false
+	for _, wantKey := range []bool{true, false} {
+		for i, s := range sections {
+			if s.key != wantKey || s.body == "" {
+				continue
+			}
+			remaining := limit - used
+			switch {
+			case size(s.body)+1 <= remaining:
+				kept[i] = s.body
+				used += size(s.body) + 1
+			case s.key && remaining > size(truncatedMarker):
+				kept[i] = truncateReadme(s.body, remaining-size(truncatedMarker))
+				used = limit
+				omitted = true
+			default:
+				omitted = true
+			}
+		}
+	}
+
+	var parts []string
+	for i, s := range sections {
+		if s.heading != "" {
+			parts = append(parts, s.heading)
+		}
+		if kept[i] != "" {
+			parts = append(parts, kept[i])
+		}
+	}
+	condensed := strings.Join(parts, "\n")
+	if omitted {
+		condensed += condensedMarker
+	}
+	return truncateReadme(condensed, limit)
+}
+
+// stripReadmeNoise removes code blocks, HTML comments, badges, images and
+// tags, and collapses runs of blank lines.
+func stripReadmeNoise(content string) string {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	content = htmlComment.ReplaceAllString(content, "")
+
+	var lines []string
+	inFence := false
+	blank := true
+	for _, line := range strings.Split(content, "\n") {
+		if codeFence.MatchString(line) {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		line = badgeLink.ReplaceAllString(line, "")
+		line = image.ReplaceAllString(line, "")
+		line = strings.TrimRight(htmlTag.ReplaceAllString(line, ""), " \t")
+		if strings.TrimSpace(line) == "" {
+			if !blank {
+				lines = append(lines, "")
+			}
+			blank = true
+			continue
+		}
+		lines = append(lines, line)
+		blank = false
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// splitSections splits stripped README content at Markdown headings.
+func splitSections(content string) []readmeSection {
+	var sections []readmeSection
+	current := readmeSection{key: true}
+	var body []string
+
+	flush := func() {
+		current.body = strings.TrimSpace(strings.Join(body, "\n"))
+		if current.heading != "" || current.body != "" {
+			sections = append(sections, current)
+		}
+		body = nil
+	}
+
+	for _, line := range strings.Split(content, "\n") {
+		if headingLine.MatchString(line) {
+			flush()
+			heading := strings.TrimSpace(line)
+			current = readmeSection{heading: heading, key: keySectionName.MatchString(heading)}
+			// A title heading with nothing before it introduces the intro
+			if len(sections) == 0 && strings.HasPrefix(heading, "# ") {
+				current.key = true
+			}
+			continue
+		}
+		body = append(body, line)
+	}
+	flush()
+
+	// Sections whose text says the project is deprecated or moved are key too
+	for i := range sections {
+		if signals := AnalyzeReadme(sections[i].body); signals.Deprecated || signals.Unmaintained || signals.MovedTo != "" {
+			sections[i].key = true
+		}
+	}
+	return sections
+}
+
+// truncateReadme truncates a README to at most maxLen characters.
+func truncateReadme(readme string, maxLen int) string {
+	if utf8.RuneCountInString(readme) <= maxLen {
+		return readme
+	}
+	cut := 0
+	for range max(maxLen, 0) {
+		_, n := utf8.DecodeRuneInString(readme[cut:])
+		cut += n
+	}
+	return readme[:cut] + truncatedMarker
+}
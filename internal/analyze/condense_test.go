@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package analyze
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const longReadme = `# widget
+
+[![Build](https://ci.example.com/badge.svg)](https://ci.example.com)
+![logo](docs/logo.png)
+
+A small library for drawing widgets in the terminal.
+
+<!-- TODO: screenshots -->
+
+## Installation
+
+` + "```sh\ngo install example.com/widget@latest\n```" + `
+
+## Usage
+
+%s
+
+## Project Status
+
+This project is no longer maintained. Use acme/gadget instead.
+
+## License
+
+MIT
+`
+
+func TestCondenseReadme(t *testing.T) {
+	usage := strings.Repeat("Call widget.Draw with a canvas and options to render. ", 40)
+	readme := strings.Replace(longReadme, "%s", usage, 1)
+
+	got := CondenseReadme(readme, 150)
+
+	assert.LessOrEqual(t, len(got), 150*charsPerToken)
+	// Headings, intro and status survive; noise and the long section do not
+	for _, want := range []string{"# widget", "## Installation", "## Usage", "## License", "MIT", "A small library", "no longer maintained", "[condensed]"} {
+		assert.Contains(t, got, want)
+	}
+	for _, unwanted := range []string{"badge.svg", "logo.png", "go install", "TODO", "widget.Draw"} {
+		assert.NotContains(t, got, unwanted)
+	}
+}
+
+func TestCondenseReadme_ShortReadmeKept(t *testing.T) {
+	readme := "# tool\n\nDoes one thing well.\n\n## Usage\n\nRun it."
+	assert.Equal(t, "# tool\nDoes one thing well.\n## Usage\nRun it.", CondenseReadme(readme, DefaultTokenBudget))
+}
+
+func TestCondenseReadme_DeprecationInOrdinarySection(t *testing.T) {
+	readme := "# tool\n\nIntro.\n\n## Notes\n\n" + strings.Repeat("filler text here. ", 50) +
+		"\n\n## Background\n\nThis project is deprecated in favor of acme/tool2."
+
+	got := CondenseReadme(readme, 60)
+	assert.Contains(t, got, "deprecated in favor of acme/tool2")
+	assert.NotContains(t, got, "filler text")
+}
+
+func TestCondenseReadme_CJK(t *testing.T) {
+	// 300 characters but 900 bytes: the budget counts characters
+	intro := strings.Repeat("端末でウィジェットを描画する。", 20)
+	readme := "# widget\n\n" + intro + "\n\n## 使い方\n\n" + strings.Repeat("説明文。", 200)
+
+	got := CondenseReadme(readme, 100)
+	assert.Contains(t, got, intro)
+	assert.NotContains(t, got, "説明文")
+	assert.LessOrEqual(t, EstimateTokens(got), 100)
+}
+
+func TestTruncateReadme_RuneSafe(t *testing.T) {
+	got := truncateReadme("héllo wörld", 2) // "é" spans bytes 1-2
+	assert.True(t, utf8.ValidString(got))
+	assert.Equal(t, "hé"+truncatedMarker, got)
+}
+
+func TestEstimateTokens(t *testing.T) {
+	assert.Equal(t, 0, EstimateTokens(""))
+	assert.Equal(t, 1, EstimateTokens("abcd"))
+	assert.Equal(t, 2, EstimateTokens("日本語です。"))
+}
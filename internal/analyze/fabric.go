@@ -22,88 +22,73 @@ func CheckFabricAvailable(fabricPath string) bool {
 	return err == nil
 }
 
-// AnalyzeRepo runs fabric analysis on a single repository.
-func AnalyzeRepo(repo github.Repository, readme string, fabricPath string) (string, error) {
-	if fabricPath == "" {
-		fabricPath = "fabric"
-	}
+// FabricAnalyzer runs the fabric CLI with the analyze-repo pattern.
+type FabricAnalyzer struct {
+	promptBudget
+	path string
+}
 
-	// Build context
-	repoContext := fmt.Sprintf(`Repository: %s
-Description: %s
-Language: %s
-Stars: %d, Forks: %d
-Last Activity: %d days ago
-
-README:
-%s`,
-		repo.FullName(),
-		repo.Description,
-		repo.PrimaryLanguage,
-		repo.StargazerCount,
-		repo.ForkCount,
-		repo.DaysSinceActivity,
-		truncateReadme(readme, 2000),
-	)
-
-	// Execute fabric with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+// NewFabricAnalyzer creates an analyzer for the fabric binary at path,
+// defaulting to "fabric" on PATH.
+func NewFabricAnalyzer(path string) *FabricAnalyzer {
+	if path == "" {
+		path = "fabric"
+	}
+	return &FabricAnalyzer{path: path}
+}
 
-	cmd := exec.CommandContext(ctx, fabricPath, "--pattern", "analyze-repo")
-	cmd.Stdin = strings.NewReader(repoContext)
+// Name returns "fabric".
+func (f *FabricAnalyzer) Name() string {
+	return BackendFabric
+}
 
-	output, err := cmd.Output()
+// AnalyzeRepo runs fabric analysis on a single repository.
+func (f *FabricAnalyzer) AnalyzeRepo(ctx context.Context, repo github.Repository, readme string) (string, error) {
+	output, err := f.run(ctx, repoPrompt(repo, readme, f.budget()))
 	if err != nil {
 		return "", fmt.Errorf("fabric analysis failed: %w", err)
 	}
-
-	return string(output), nil
+	return output, nil
 }
 
-// AnalyzeRepos runs fabric analysis on multiple repositories.
-func AnalyzeRepos(repos []github.Repository, fabricPath string) (string, error) {
-	if fabricPath == "" {
-		fabricPath = "fabric"
-	}
-
+// AnalyzeRepos runs fabric analysis on multiple repositories, in batches
+// that fit the token budget.
+func (f *FabricAnalyzer) AnalyzeRepos(ctx context.Context, repos []github.Repository) (string, error) {
 	if len(repos) == 0 {
 		return "", fmt.Errorf("no repositories provided for analysis")
 	}
 
-	// Build combined context for all repos
-	var builder strings.Builder
-	builder.WriteString(fmt.Sprintf("Analyzing %d repositories:\n\n", len(repos)))
-
-	for i, repo := range repos {
-		builder.WriteString(fmt.Sprintf("--- Repository %d ---\n", i+1))
-		builder.WriteString(fmt.Sprintf("Name: %s\n", repo.FullName()))
-		builder.WriteString(fmt.Sprintf("Description: %s\n", repo.Description))
-		builder.WriteString(fmt.Sprintf("Language: %s\n", repo.PrimaryLanguage))
-		builder.WriteString(fmt.Sprintf("Stars: %d, Forks: %d\n", repo.StargazerCount, repo.ForkCount))
-		builder.WriteString(fmt.Sprintf("Last Activity: %d days ago\n", repo.DaysSinceActivity))
-		builder.WriteString("\n")
+	output, err := analyzeBatches(ctx, repos, f.budget(), f.run)
+	if err != nil {
+		return "", fmt.Errorf("fabric batch analysis failed: %w", err)
 	}
+	return output, nil
+}
 
-	// Execute fabric with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
-	defer cancel()
-
-	cmd := exec.CommandContext(ctx, fabricPath, "--pattern", "analyze-repo")
-	cmd.Stdin = strings.NewReader(builder.String())
+// run pipes input to the fabric pattern and returns its output.
+func (f *FabricAnalyzer) run(ctx context.Context, input string) (string, error) {
+	cmd := exec.CommandContext(ctx, f.path, "--pattern", "analyze-repo")
+	cmd.Stdin = strings.NewReader(input)
 
 	output, err := cmd.Output()
 	if err != nil {
-		return "", fmt.Errorf("fabric batch analysis failed: %w", err)
+		return "", err
 	}
-
 	return string(output), nil
 }
 
-// truncateReadme truncates a README to the specified maximum length.
-func truncateReadme(readme string, maxLen int) string {
-	if len(readme) <= maxLen {
-		return readme
-	}
-	return readme[:maxLen] + "\n... [truncated]"
+// AnalyzeRepo runs fabric analysis on a single repository.
+func AnalyzeRepo(repo github.Repository, readme string, fabricPath string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	return NewFabricAnalyzer(fabricPath).AnalyzeRepo(ctx, repo, readme)
+}
+
+// AnalyzeRepos runs fabric analysis on multiple repositories.
+func AnalyzeRepos(repos []github.Repository, fabricPath string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	return NewFabricAnalyzer(fabricPath).AnalyzeRepos(ctx, repos)
 }
@@ -5,6 +5,8 @@
 package analyze
 
 import (
+	"fmt"
+	"math"
 	"strings"
 
 	"github.com/llbbl/repjan/internal/github"
@@ -40,37 +42,199 @@ func IsLegacyLanguage(lang string) bool {
 	return legacyLanguages[strings.ToLower(lang)]
 }
 
-// IsArchiveCandidate determines if a repository is a candidate for archiving.
-// Returns (true, reasons) if the repo is a candidate, (false, "") otherwise.
-// Reasons are returned as a semicolon-separated string when multiple criteria match.
-func IsArchiveCandidate(repo github.Repository) (bool, string) {
-	var reasons []string
+// ReasonCode is a stable machine-readable identifier for an archive reason.
+type ReasonCode string
+
+// Reason codes produced by the archive heuristics.
+const (
+	ReasonInactive2Years ReasonCode = "inactive_2y"
+	ReasonInactive1Year  ReasonCode = "inactive_1y"
+	ReasonNoEngagement   ReasonCode = "no_engagement"
+	ReasonStaleFork      ReasonCode = "stale_fork"
+	ReasonLegacyLanguage ReasonCode = "legacy_language"
+	ReasonBotOnly        ReasonCode = "bot_only_activity"
+	ReasonCronWorkflows  ReasonCode = "scheduled_workflows"
+
+	ReasonReadmeDeprecated   ReasonCode = "readme_deprecated"
+	ReasonReadmeUnmaintained ReasonCode = "readme_unmaintained"
+	ReasonReadmeMoved        ReasonCode = "readme_moved"
+	ReasonReadmeMissing      ReasonCode = "readme_missing"
+	ReasonReadmeBoilerplate  ReasonCode = "readme_boilerplate"
+
+	ReasonAIArchive ReasonCode = "ai_archive"
+)
+
+// aiArchiveWeight is the score contribution of a fully confident AI archive verdict.
+const aiArchiveWeight = 30
+
+// issueActiveDays is how recently an issue, pull request or discussion must
+// have been updated for a repository to count as in use.
+const issueActiveDays = 180
+
+// HasRecentIssueActivity reports whether the repository's issues, pull
+// requests or discussions were updated within issueActiveDays.
+func HasRecentIssueActivity(repo github.Repository) bool {
+	if repo.IssueActivity == nil {
+		return false
+	}
+	days := repo.IssueActivity.DaysSinceUpdate()
+	return days >= 0 && days <= issueActiveDays
+}
+
+// workflowQuietDays is how long nobody must have committed to a repository
+// before its scheduled workflows count against it.
+const workflowQuietDays = 180
+
+// readmeQuietDays is how long a repository must be inactive before a
+// missing or boilerplate README counts against it.
+const readmeQuietDays = 180
+
+// Reason describes a single heuristic that matched a repository.
+type Reason struct {
+	Code        ReasonCode
+	Description string
+	Weight      int // contribution to the assessment score
+}
+
+// Assessment is the result of running all archive heuristics on a repository.
+type Assessment struct {
+	Candidate bool
+	Reasons   []Reason
+	Score     int // 0-100, higher means a stronger archive candidate
+}
+
+// maxScore caps the assessment score.
+const maxScore = 100
+
+// Codes returns the reason codes of the assessment in match order.
+func (a Assessment) Codes() []string {
+	codes := make([]string, len(a.Reasons))
+	for i, r := range a.Reasons {
+		codes[i] = string(r.Code)
+	}
+	return codes
+}
+
+// Summary returns the reason descriptions joined with "; ".
+func (a Assessment) Summary() string {
+	descriptions := make([]string, len(a.Reasons))
+	for i, r := range a.Reasons {
+		descriptions[i] = r.Description
+	}
+	return strings.Join(descriptions, "; ")
+}
+
+// Signals are optional inputs to the heuristics beyond repository metadata.
+type Signals struct {
+	Readme  *ReadmeSignals // README analysis, if the README has been fetched
+	Verdict *Verdict       // latest AI verdict, if the repo has been analyzed
+}
+
+// Assess runs the archive heuristics on a repository and returns every
+// matching reason along with a weighted score.
+func Assess(repo github.Repository) Assessment {
+	return AssessWithSignals(repo, Signals{})
+}
+
+// AssessWithSignals runs the archive heuristics including any README
+// signals and AI verdict that are set.
+func AssessWithSignals(repo github.Repository, signals Signals) Assessment {
+	var reasons []Reason
+
+	// Recent issues, PRs or discussions show the repo is in use, so the
+	// inactivity criteria below don't apply
+	inUse := HasRecentIssueActivity(repo)
 
 	// Age-based criteria (check higher threshold first)
-	if repo.DaysSinceActivity > 730 {
-		reasons = append(reasons, "No activity in 2+ years")
-	} else if repo.DaysSinceActivity > 365 {
-		reasons = append(reasons, "No activity in 1+ year")
+	if !inUse {
+		if repo.DaysSinceActivity > 730 {
+			reasons = append(reasons, Reason{ReasonInactive2Years, "No activity in 2+ years", 40})
+		} else if repo.DaysSinceActivity > 365 {
+			reasons = append(reasons, Reason{ReasonInactive1Year, "No activity in 1+ year", 25})
+		}
+	}
+
+	// Recent pushes that are all by bots don't count as maintenance
+	if !inUse && repo.DaysSinceActivity <= 365 && IsBotOnly(repo) {
+		reasons = append(reasons, Reason{ReasonBotOnly, "Only bot activity in 1+ year", 25})
+	}
+
+	// Scheduled workflows keep running, and failing, after everyone has left
+	if !inUse && repo.CronWorkflows > 0 && repo.DaysSinceHumanActivity() > workflowQuietDays {
+		reasons = append(reasons, Reason{ReasonCronWorkflows, "Scheduled workflows still running", 10})
 	}
 
 	// Engagement-based criteria
 	if repo.StargazerCount == 0 && repo.ForkCount == 0 {
-		reasons = append(reasons, "No community engagement")
+		reasons = append(reasons, Reason{ReasonNoEngagement, "No community engagement", 20})
 	}
 
 	// Fork-based criteria
-	if repo.IsFork && repo.DaysSinceActivity > 180 {
-		reasons = append(reasons, "Stale fork")
+	if !inUse && repo.IsFork && repo.DaysSinceActivity > 180 {
+		reasons = append(reasons, Reason{ReasonStaleFork, "Stale fork", 20})
 	}
 
 	// Language-based criteria
-	if IsLegacyLanguage(repo.PrimaryLanguage) && repo.DaysSinceActivity > 365 {
-		reasons = append(reasons, "Legacy language, inactive")
+	if !inUse && IsLegacyLanguage(repo.PrimaryLanguage) && repo.DaysSinceActivity > 365 {
+		reasons = append(reasons, Reason{ReasonLegacyLanguage, "Legacy language, inactive", 15})
 	}
 
-	if len(reasons) == 0 {
-		return false, ""
+	if signals.Readme != nil {
+		reasons = append(reasons, ReadmeReasons(repo, *signals.Readme)...)
 	}
 
-	return true, strings.Join(reasons, "; ")
+	// AI verdicts count in proportion to the model's confidence
+	if v := signals.Verdict; v != nil && v.Decision == DecisionArchive {
+		weight := int(math.Round(aiArchiveWeight * v.Confidence))
+		description := fmt.Sprintf("AI recommends archiving (%d%% confidence)", int(math.Round(v.Confidence*100)))
+		reasons = append(reasons, Reason{ReasonAIArchive, description, weight})
+	}
+
+	score := 0
+	for _, r := range reasons {
+		score += r.Weight
+	}
+
+	return Assessment{
+		Candidate: len(reasons) > 0,
+		Reasons:   reasons,
+		Score:     min(score, maxScore),
+	}
+}
+
+// ReadmeReasons converts README signals into archive reasons for repo.
+func ReadmeReasons(repo github.Repository, readme ReadmeSignals) []Reason {
+	var reasons []Reason
+
+	if readme.Deprecated {
+		reasons = append(reasons, Reason{ReasonReadmeDeprecated, "README marks project deprecated", 40})
+	}
+	if readme.Unmaintained {
+		reasons = append(reasons, Reason{ReasonReadmeUnmaintained, "README says no longer maintained", 35})
+	}
+	if readme.MovedTo != "" {
+		reasons = append(reasons, Reason{ReasonReadmeMoved, "README says moved to " + readme.MovedTo, 40})
+	}
+
+	// A thin README only matters once the repository has gone quiet
+	if repo.DaysSinceActivity > readmeQuietDays {
+		if readme.Missing {
+			reasons = append(reasons, Reason{ReasonReadmeMissing, "No README", 10})
+		} else if readme.Boilerplate {
+			reasons = append(reasons, Reason{ReasonReadmeBoilerplate, "Empty or boilerplate README", 10})
+		}
+	}
+
+	return reasons
+}
+
+// IsArchiveCandidate determines if a repository is a candidate for archiving.
+// Returns (true, reasons) if the repo is a candidate, (false, "") otherwise.
+// Reasons are returned as a semicolon-separated string when multiple criteria match.
+func IsArchiveCandidate(repo github.Repository) (bool, string) {
+	a := Assess(repo)
+	if !a.Candidate {
+		return false, ""
+	}
+	return true, a.Summary()
 }
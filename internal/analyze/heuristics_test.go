@@ -4,8 +4,11 @@
 package analyze
 
 import (
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/llbbl/repjan/internal/github"
 	"github.com/llbbl/repjan/internal/testutil"
 )
 
@@ -360,3 +363,141 @@ func TestIsLegacyLanguage(t *testing.T) {
 		}
 	})
 }
+
+func TestAssess(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      []testutil.RepoOption
+		wantCodes []string
+		wantScore int
+	}{
+		{
+			name:      "active repo has no reasons",
+			opts:      []testutil.RepoOption{testutil.WithDaysInactive(10), testutil.WithStars(3)},
+			wantCodes: []string{},
+			wantScore: 0,
+		},
+		{
+			name: "inactive legacy repo without engagement",
+			opts: []testutil.RepoOption{
+				testutil.WithDaysInactive(800),
+				testutil.WithStars(0),
+				testutil.WithForks(0),
+				testutil.WithLanguage("Perl"),
+			},
+			wantCodes: []string{"inactive_2y", "no_engagement", "legacy_language"},
+			wantScore: 75,
+		},
+		{
+			name: "every heuristic matches",
+			opts: []testutil.RepoOption{
+				testutil.WithDaysInactive(800),
+				testutil.WithStars(0),
+				testutil.WithForks(0),
+				testutil.WithFork(true),
+				testutil.WithLanguage("PHP"),
+			},
+			wantCodes: []string{"inactive_2y", "no_engagement", "stale_fork", "legacy_language"},
+			wantScore: 95,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := Assess(testutil.NewTestRepo(tt.opts...))
+			if got := a.Codes(); strings.Join(got, ",") != strings.Join(tt.wantCodes, ",") {
+				t.Errorf("Codes() = %v, want %v", got, tt.wantCodes)
+			}
+			if a.Score != tt.wantScore {
+				t.Errorf("Score = %d, want %d", a.Score, tt.wantScore)
+			}
+			if a.Candidate != (len(tt.wantCodes) > 0) {
+				t.Errorf("Candidate = %v, want %v", a.Candidate, len(tt.wantCodes) > 0)
+			}
+		})
+	}
+}
+
+func TestAssess_RecentIssueActivity(t *testing.T) {
+	issues := func(days int) testutil.RepoOption {
+		return func(r *github.Repository) {
+			r.IssueActivity = &github.IssueActivity{OpenIssues: 2, LastUpdated: time.Now().AddDate(0, 0, -days)}
+		}
+	}
+
+	tests := []struct {
+		name string
+		repo github.Repository
+		want string
+	}{
+		{
+			name: "active issues suppress inactivity reasons",
+			repo: testutil.NewTestRepo(testutil.WithDaysInactive(800), testutil.WithStars(0), testutil.WithForks(0), testutil.WithLanguage("Perl"), testutil.WithFork(true), issues(30)),
+			want: "no_engagement",
+		},
+		{
+			name: "stale issue activity changes nothing",
+			repo: testutil.NewTestRepo(testutil.WithDaysInactive(800), testutil.WithStars(5), issues(400)),
+			want: "inactive_2y",
+		},
+		{
+			name: "active issues outweigh bot-only pushes",
+			repo: testutil.NewTestRepo(testutil.WithDaysInactive(5), testutil.WithStars(5), testutil.WithHumanInactive(500), issues(10)),
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := strings.Join(Assess(tt.repo).Codes(), ",")
+			if got != tt.want {
+				t.Errorf("Assess() codes = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAssess_ScheduledWorkflows(t *testing.T) {
+	cron := func(n int) testutil.RepoOption {
+		return func(r *github.Repository) { r.CronWorkflows = n }
+	}
+
+	a := Assess(testutil.NewTestRepo(testutil.WithDaysInactive(200), testutil.WithStars(3), cron(2)))
+	if got := strings.Join(a.Codes(), ","); got != "scheduled_workflows" {
+		t.Errorf("Assess() codes = %q, want %q", got, "scheduled_workflows")
+	}
+	if a.Summary() != "Scheduled workflows still running" || a.Score != 10 {
+		t.Errorf("Assess() = %q (score %d), want %q (score 10)", a.Summary(), a.Score, "Scheduled workflows still running")
+	}
+
+	tests := []struct {
+		name string
+		repo github.Repository
+		want string
+	}{
+		{
+			name: "bot pushes, such as the workflows' own, don't count as activity",
+			repo: testutil.NewTestRepo(testutil.WithDaysInactive(2), testutil.WithStars(3), testutil.WithHumanInactive(200), cron(1)),
+			want: "scheduled_workflows",
+		},
+		{
+			name: "active repos may run what they like",
+			repo: testutil.NewTestRepo(testutil.WithDaysInactive(30), testutil.WithStars(3), cron(1)),
+			want: "",
+		},
+		{
+			name: "inactive repos without scheduled workflows",
+			repo: testutil.NewTestRepo(testutil.WithDaysInactive(200), testutil.WithStars(3)),
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := strings.Join(Assess(tt.repo).Codes(), ",")
+			if got != tt.want {
+				t.Errorf("Assess() codes = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package analyze
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+// ImpactCacheTTL is how long a fetched archive impact is reused.
+const ImpactCacheTTL = time.Hour
+
+// Recency thresholds for archive impact items.
+const (
+	recentDeploymentDays = 30  // a deployment this recent blocks archiving
+	recentReleaseDays    = 90  // a release this recent blocks archiving
+	staleDeploymentDays  = 365 // older deployments are not reported
+)
+
+// ImpactSeverity ranks an archive impact item.
+type ImpactSeverity int
+
+const (
+	// ImpactWarning is something archiving affects that is usually acceptable.
+	ImpactWarning ImpactSeverity = iota
+	// ImpactBlocking is a sign the repository is still in use.
+	ImpactBlocking
+)
+
+// ImpactItem is a single consequence of archiving a repository.
+type ImpactItem struct {
+	Severity    ImpactSeverity
+	Description string
+}
+
+// ImpactReport lists the consequences of archiving one repository.
+type ImpactReport struct {
+	Repo  string // owner/name
+	Items []ImpactItem
+	Err   error // set when the impact could not be determined
+}
+
+// Blocking returns the number of blocking items.
+func (r ImpactReport) Blocking() int {
+	n := 0
+	for _, item := range r.Items {
+		if item.Severity == ImpactBlocking {
+			n++
+		}
+	}
+	return n
+}
+
+// Warnings returns the number of warning items.
+func (r ImpactReport) Warnings() int {
+	return len(r.Items) - r.Blocking()
+}
+
+// AssessImpact turns an archive impact into report items, blocking items first.
+func AssessImpact(impact github.ArchiveImpact, now time.Time) []ImpactItem {
+	var blocking, warnings []ImpactItem
+	warn := func(format string, args ...any) {
+		warnings = append(warnings, ImpactItem{ImpactWarning, fmt.Sprintf(format, args...)})
+	}
+	daysAgo := func(t time.Time) int { return int(now.Sub(t).Hours() / 24) }
+
+	if !impact.LastDeploymentAt.IsZero() {
+		days := daysAgo(impact.LastDeploymentAt)
+		env := impact.DeploymentEnvironment
+		if env == "" {
+			env = "an environment"
+		}
+		if days <= recentDeploymentDays {
+			blocking = append(blocking, ImpactItem{ImpactBlocking, fmt.Sprintf("Deployed to %s %d days ago", env, days)})
+		} else if days <= staleDeploymentDays {
+			warn("Last deployed to %s %d days ago", env, days)
+		}
+	}
+	if !impact.LatestReleaseAt.IsZero() && daysAgo(impact.LatestReleaseAt) <= recentReleaseDays {
+		blocking = append(blocking, ImpactItem{ImpactBlocking,
+			fmt.Sprintf("Released %s %d days ago", impact.LatestRelease, daysAgo(impact.LatestReleaseAt))})
+	}
+
+	if impact.OpenPullRequests > 0 {
+		warn("%d open pull request%s will be frozen", impact.OpenPullRequests, plural(impact.OpenPullRequests))
+	}
+	if impact.OpenIssues > 0 {
+		warn("%d open issue%s will be frozen", impact.OpenIssues, plural(impact.OpenIssues))
+	}
+	if impact.PagesURL != "" {
+		warn("Pages site %s stays up but can't be updated", impact.PagesURL)
+	}
+	if impact.ReleaseDownloads > 0 {
+		warn("Release assets downloaded %d times", impact.ReleaseDownloads)
+	}
+	if impact.Webhooks > 0 {
+		warn("%d webhook%s will stop receiving events", impact.Webhooks, plural(impact.Webhooks))
+	}
+	if len(impact.Packages) > 0 {
+		warn("Publishes %s; no new versions can be published", strings.Join(impact.Packages, ", "))
+	}
+
+	return append(blocking, warnings...)
+}
+
+// plural returns "s" unless n is 1.
+func plural(n int) string {
+	if n == 1 {
+		return ""
+	}
+	return "s"
+}
+
+// ImpactFetcher fetches the archive impact of a repository.
+type ImpactFetcher interface {
+	FetchArchiveImpact(owner, name string) (github.ArchiveImpact, error)
+}
+
+// ImpactChecker fetches archive impacts through the store cache.
+type ImpactChecker struct {
+	fetcher ImpactFetcher
+	store   *store.Store
+	ttl     time.Duration
+}
+
+// NewImpactChecker creates a checker. s may be nil to disable caching.
+func NewImpactChecker(fetcher ImpactFetcher, s *store.Store) *ImpactChecker {
+	return &ImpactChecker{fetcher: fetcher, store: s, ttl: ImpactCacheTTL}
+}
+
+// Check reports what archiving repo would affect, using a cached impact
+// younger than ImpactCacheTTL unless refresh is set.
+func (c *ImpactChecker) Check(repo github.Repository, refresh bool) ImpactReport {
+	report := ImpactReport{Repo: repo.FullName()}
+	impact, err := c.impact(repo, refresh)
+	if err != nil {
+		report.Err = err
+		return report
+	}
+	report.Items = AssessImpact(impact, time.Now())
+	return report
+}
+
+// impact returns the cached or freshly fetched impact for repo.
+func (c *ImpactChecker) impact(repo github.Repository, refresh bool) (github.ArchiveImpact, error) {
+	var impact github.ArchiveImpact
+	if c.store != nil && !refresh {
+		cached, err := c.store.GetArchiveImpact(repo.Owner, repo.Name)
+		if err != nil && !errors.Is(err, store.ErrNotFound) {
+			return impact, fmt.Errorf("loading cached impact: %w", err)
+		}
+		if cached != nil && time.Since(cached.FetchedAt) < c.ttl {
+			if err := json.Unmarshal([]byte(cached.Impact), &impact); err == nil {
+				return impact, nil
+			}
+		}
+	}
+
+	impact, err := c.fetcher.FetchArchiveImpact(repo.Owner, repo.Name)
+	if err != nil {
+		return impact, err
+	}
+
+	if c.store != nil {
+		data, err := json.Marshal(impact)
+		if err != nil {
+			return impact, fmt.Errorf("encoding impact: %w", err)
+		}
+		if err := c.store.SaveArchiveImpact(repo.Owner, repo.Name, string(data)); err != nil {
+			slog.Warn("failed to cache archive impact", "component", "analyze", "repo", repo.FullName(), "error", err)
+		}
+	}
+	return impact, nil
+}
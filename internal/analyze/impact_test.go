@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package analyze
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+func TestAssessImpact(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	daysAgo := func(d int) time.Time { return now.AddDate(0, 0, -d) }
+
+	items := AssessImpact(github.ArchiveImpact{
+		OpenIssues:            1,
+		OpenPullRequests:      3,
+		PagesURL:              "https://acme.github.io/widget",
+		LatestRelease:         "v1.2.0",
+		LatestReleaseAt:       daysAgo(20),
+		ReleaseDownloads:      1500,
+		LastDeploymentAt:      daysAgo(2),
+		DeploymentEnvironment: "production",
+		Webhooks:              1,
+		Packages:              []string{"widget"},
+	}, now)
+
+	var got []string
+	for _, item := range items {
+		got = append(got, item.Description)
+	}
+	assert.Equal(t, []string{
+		"Deployed to production 2 days ago",
+		"Released v1.2.0 20 days ago",
+		"3 open pull requests will be frozen",
+		"1 open issue will be frozen",
+		"Pages site https://acme.github.io/widget stays up but can't be updated",
+		"Release assets downloaded 1500 times",
+		"1 webhook will stop receiving events",
+		"Publishes widget; no new versions can be published",
+	}, got)
+
+	report := ImpactReport{Items: items}
+	assert.Equal(t, 2, report.Blocking())
+	assert.Equal(t, 6, report.Warnings())
+
+	// Old releases and deployments, and unknown webhooks, don't block
+	items = AssessImpact(github.ArchiveImpact{
+		LatestRelease:    "v0.1.0",
+		LatestReleaseAt:  daysAgo(400),
+		LastDeploymentAt: daysAgo(100),
+		Webhooks:         -1,
+	}, now)
+	require.Len(t, items, 1)
+	assert.Equal(t, ImpactItem{ImpactWarning, "Last deployed to an environment 100 days ago"}, items[0])
+
+	assert.Empty(t, AssessImpact(github.ArchiveImpact{LastDeploymentAt: daysAgo(500)}, now))
+}
+
+// fakeImpactFetcher returns a fixed impact and counts calls.
+type fakeImpactFetcher struct {
+	impact github.ArchiveImpact
+	err    error
+	calls  int
+}
+
+func (f *fakeImpactFetcher) FetchArchiveImpact(owner, name string) (github.ArchiveImpact, error) {
+	f.calls++
+	return f.impact, f.err
+}
+
+func TestImpactChecker_Caches(t *testing.T) {
+	s := testutil.NewStore(t)
+	fetcher := &fakeImpactFetcher{impact: github.ArchiveImpact{OpenPullRequests: 2}}
+	checker := NewImpactChecker(fetcher, s)
+	repo := testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("widget"))
+
+	report := checker.Check(repo, false)
+	require.NoError(t, report.Err)
+	assert.Equal(t, "acme/widget", report.Repo)
+	assert.Equal(t, 1, report.Warnings())
+
+	// The cached impact is reused until refreshed
+	fetcher.impact.OpenPullRequests = 0
+	assert.Equal(t, 1, checker.Check(repo, false).Warnings())
+	assert.Equal(t, 1, fetcher.calls)
+	assert.Equal(t, 0, checker.Check(repo, true).Warnings())
+	assert.Equal(t, 2, fetcher.calls)
+
+	fetcher.err = errors.New("rate limited")
+	assert.Error(t, checker.Check(repo, true).Err)
+}
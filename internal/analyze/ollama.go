@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package analyze
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/llbbl/repjan/internal/github"
+)
+
+// Ollama defaults used when no URL or model is configured.
+const (
+	DefaultOllamaURL   = "http://localhost:11434"
+	DefaultOllamaModel = "llama3.2"
+)
+
+// OllamaAnalyzer sends prompts to an Ollama-style /api/chat endpoint.
+type OllamaAnalyzer struct {
+	baseURL string
+	model   string
+	promptBudget
+	client *http.Client
+}
+
+// NewOllamaAnalyzer creates an analyzer for the Ollama server at baseURL.
+// Empty values fall back to DefaultOllamaURL and DefaultOllamaModel.
+func NewOllamaAnalyzer(baseURL, model string, client *http.Client) *OllamaAnalyzer {
+	if baseURL == "" {
+		baseURL = DefaultOllamaURL
+	}
+	if model == "" {
+		model = DefaultOllamaModel
+	}
+	if client == nil {
+		client = &http.Client{Timeout: DefaultAITimeout}
+	}
+	return &OllamaAnalyzer{baseURL: strings.TrimRight(baseURL, "/"), model: model, client: client}
+}
+
+// Name returns "ollama".
+func (o *OllamaAnalyzer) Name() string {
+	return BackendOllama
+}
+
+// AnalyzeRepo analyzes a single repository together with its README.
+func (o *OllamaAnalyzer) AnalyzeRepo(ctx context.Context, repo github.Repository, readme string) (string, error) {
+	output, err := o.chat(ctx, repoPrompt(repo, readme, o.budget()))
+	if err != nil {
+		return "", fmt.Errorf("ollama analysis failed: %w", err)
+	}
+	return output, nil
+}
+
+// AnalyzeRepos analyzes several repositories, in batches that fit the
+// token budget.
+func (o *OllamaAnalyzer) AnalyzeRepos(ctx context.Context, repos []github.Repository) (string, error) {
+	if len(repos) == 0 {
+		return "", fmt.Errorf("no repositories provided for analysis")
+	}
+
+	output, err := analyzeBatches(ctx, repos, o.budget(), o.chat)
+	if err != nil {
+		return "", fmt.Errorf("ollama batch analysis failed: %w", err)
+	}
+	return output, nil
+}
+
+// chat sends a non-streaming chat request and returns the reply.
+func (o *OllamaAnalyzer) chat(ctx context.Context, prompt string) (string, error) {
+	body := struct {
+		Model    string        `json:"model"`
+		Messages []chatMessage `json:"messages"`
+		Stream   bool          `json:"stream"`
+	}{Model: o.model, Messages: chatMessages(prompt)}
+
+	var resp struct {
+		Message chatMessage `json:"message"`
+		Error   string      `json:"error"`
+	}
+	if err := postJSON(ctx, o.client, o.baseURL+"/api/chat", nil, body, &resp); err != nil {
+		return "", err
+	}
+	if resp.Error != "" {
+		return "", fmt.Errorf("server error: %s", resp.Error)
+	}
+	return resp.Message.Content, nil
+}
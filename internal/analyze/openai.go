@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package analyze
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/llbbl/repjan/internal/github"
+)
+
+// DefaultOpenAIURL is the base URL used when no OpenAI-compatible URL is configured.
+const DefaultOpenAIURL = "https://api.openai.com/v1"
+
+// OpenAIAnalyzer sends prompts to an OpenAI-compatible /chat/completions endpoint.
+type OpenAIAnalyzer struct {
+	baseURL string
+	model   string
+	apiKey  string
+	promptBudget
+	client *http.Client
+}
+
+// NewOpenAIAnalyzer creates an analyzer for the OpenAI-compatible API at
+// baseURL (default DefaultOpenAIURL). apiKey may be empty for local servers.
+func NewOpenAIAnalyzer(baseURL, model, apiKey string, client *http.Client) *OpenAIAnalyzer {
+	if baseURL == "" {
+		baseURL = DefaultOpenAIURL
+	}
+	if client == nil {
+		client = &http.Client{Timeout: DefaultAITimeout}
+	}
+	return &OpenAIAnalyzer{baseURL: strings.TrimRight(baseURL, "/"), model: model, apiKey: apiKey, client: client}
+}
+
+// Name returns "openai".
+func (o *OpenAIAnalyzer) Name() string {
+	return BackendOpenAI
+}
+
+// AnalyzeRepo analyzes a single repository together with its README.
+func (o *OpenAIAnalyzer) AnalyzeRepo(ctx context.Context, repo github.Repository, readme string) (string, error) {
+	output, err := o.complete(ctx, repoPrompt(repo, readme, o.budget()))
+	if err != nil {
+		return "", fmt.Errorf("openai analysis failed: %w", err)
+	}
+	return output, nil
+}
+
+// AnalyzeRepos analyzes several repositories, in batches that fit the
+// token budget.
+func (o *OpenAIAnalyzer) AnalyzeRepos(ctx context.Context, repos []github.Repository) (string, error) {
+	if len(repos) == 0 {
+		return "", fmt.Errorf("no repositories provided for analysis")
+	}
+
+	output, err := analyzeBatches(ctx, repos, o.budget(), o.complete)
+	if err != nil {
+		return "", fmt.Errorf("openai batch analysis failed: %w", err)
+	}
+	return output, nil
+}
+
+// complete sends a chat completion request and returns the first choice.
+func (o *OpenAIAnalyzer) complete(ctx context.Context, prompt string) (string, error) {
+	body := struct {
+		Model    string        `json:"model"`
+		Messages []chatMessage `json:"messages"`
+	}{Model: o.model, Messages: chatMessages(prompt)}
+
+	var headers map[string]string
+	if o.apiKey != "" {
+		headers = map[string]string{"Authorization": "Bearer " + o.apiKey}
+	}
+
+	var resp struct {
+		Choices []struct {
+			Message chatMessage `json:"message"`
+		} `json:"choices"`
+	}
+	if err := postJSON(ctx, o.client, o.baseURL+"/chat/completions", headers, body, &resp); err != nil {
+		return "", err
+	}
+	if len(resp.Choices) == 0 {
+		return "", fmt.Errorf("response contained no choices")
+	}
+	return resp.Choices[0].Message.Content, nil
+}
@@ -0,0 +1,215 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package analyze
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+// ReadmeCacheTTL is how long a cached README is reused before it is fetched again.
+const ReadmeCacheTTL = 7 * 24 * time.Hour
+
+// minReadmeWords is the prose word count below which a README counts as boilerplate.
+const minReadmeWords = 15
+
+// ReadmeSignals summarizes archive-relevant findings in a README.
+type ReadmeSignals struct {
+	Missing      bool     // no README exists
+	Boilerplate  bool     // README is empty, a bare title, or an unedited template
+	Deprecated   bool     // README declares the project deprecated
+	Unmaintained bool     // README says the project is no longer maintained
+	MovedTo      string   // destination URL when the README says the project moved
+	Phrases      []string // detected phrases, as written in the README
+}
+
+// Any reports whether any signal was detected.
+func (s ReadmeSignals) Any() bool {
+	return s.Missing || s.Boilerplate || s.Deprecated || s.Unmaintained || s.MovedTo != ""
+}
+
+var (
+	deprecatedPatterns = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)\b(?:this|the) (?:project|repo|repository|library|package|module|tool|plugin|app|extension) (?:is|has been) (?:now )?(?:deprecated|obsolete|superseded)\b`),
+		regexp.MustCompile(`(?im)^[ \t>]*(?:#{1,2}[ \t]+)?[*_]*deprecated\b[^\n]{0,60}`),
+		regexp.MustCompile(`(?i)\bdeprecation notice\b`),
+	}
+
+	unmaintainedPatterns = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)\bno longer (?:actively )?(?:maintained|supported|developed|under (?:active )?development)\b`),
+		regexp.MustCompile(`(?i)\b(?:unmaintained|not (?:actively )?maintained)\b`),
+		regexp.MustCompile(`(?i)\b(?:is|has been) abandoned\b`),
+		regexp.MustCompile(`(?i)\blooking for (?:a )?(?:new )?maintainers?\b`),
+	}
+
+	// movedPattern captures the destination of a "moved to <url>" notice,
+	// including Markdown links and bare host/path references.
+	movedPattern = regexp.MustCompile(`(?i)\b(?:moved|migrated|relocated)(?: permanently)? (?:to|over to)\s*:?\s*(?:\[[^\]]*\]\()?<?((?:https?://|(?:github|gitlab|codeberg|bitbucket)\.(?:com|org)/)[^\s)>\]]+)`)
+
+	boilerplatePatterns = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)\bthis project was bootstrapped with \[?create react app\b`),
+		regexp.MustCompile(`(?i)\bgetting started with create react app\b`),
+		regexp.MustCompile(`(?i)\ba new flutter project\b`),
+		regexp.MustCompile(`(?i)\btodo:? (?:write|add|fill in) (?:a |the )?(?:readme|description)\b`),
+		regexp.MustCompile(`(?i)\bedit a file, create a new file, and clone from bitbucket\b`),
+	}
+
+	markdownNoise = regexp.MustCompile(`!\[[^\]]*\]\([^)]*\)|\[!\[.*?\]\(.*?\)\]\(.*?\)|<[^>]+>|https?://\S+`)
+	wordPattern   = regexp.MustCompile(`[\p{L}\p{N}]+`)
+)
+
+// AnalyzeReadme extracts archive signals from README content. An empty
+// content string means the repository has no README.
+func AnalyzeReadme(content string) ReadmeSignals {
+	var s ReadmeSignals
+	if strings.TrimSpace(content) == "" {
+		s.Missing = true
+		return s
+	}
+
+	for _, p := range deprecatedPatterns {
+		for _, match := range p.FindAllString(content, -1) {
+			s.Deprecated = true
+			s.addPhrase(match)
+		}
+	}
+	for _, p := range unmaintainedPatterns {
+		for _, match := range p.FindAllString(content, -1) {
+			s.Unmaintained = true
+			s.addPhrase(match)
+		}
+	}
+	if m := movedPattern.FindStringSubmatch(content); m != nil {
+		s.MovedTo = strings.TrimRight(m[1], ".,;:")
+		s.addPhrase(m[0])
+	}
+	for _, p := range boilerplatePatterns {
+		if match := p.FindString(content); match != "" {
+			s.Boilerplate = true
+			s.addPhrase(match)
+		}
+	}
+	if !s.Boilerplate && proseWords(content) < minReadmeWords {
+		s.Boilerplate = true
+	}
+
+	return s
+}
+
+// addPhrase records a cleaned-up phrase once.
+func (s *ReadmeSignals) addPhrase(match string) {
+	phrase := strings.NewReplacer("*", "", "`", "").Replace(match)
+	phrase = strings.Trim(strings.Join(strings.Fields(phrase), " "), "#>_-: ")
+	if phrase == "" {
+		return
+	}
+	for _, p := range s.Phrases {
+		if strings.EqualFold(p, phrase) {
+			return
+		}
+	}
+	s.Phrases = append(s.Phrases, phrase)
+}
+
+// proseWords counts words outside headings, badges, links and HTML.
+func proseWords(content string) int {
+	count := 0
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "```") {
+			continue
+		}
+		line = markdownNoise.ReplaceAllString(line, " ")
+		count += len(wordPattern.FindAllString(line, -1))
+	}
+	return count
+}
+
+// ReadmeFetcher fetches the README for a repository, returning an empty
+// string when there is none.
+type ReadmeFetcher interface {
+	FetchReadme(owner, name string) (string, error)
+}
+
+// ReadmeAnalyzer fetches READMEs through the store cache and extracts signals.
+type ReadmeAnalyzer struct {
+	fetcher ReadmeFetcher
+	store   *store.Store
+	ttl     time.Duration
+}
+
+// NewReadmeAnalyzer creates an analyzer. Either argument may be nil: without
+// a fetcher only cached READMEs are analyzed, without a store nothing is cached.
+func NewReadmeAnalyzer(fetcher ReadmeFetcher, s *store.Store) *ReadmeAnalyzer {
+	return &ReadmeAnalyzer{fetcher: fetcher, store: s, ttl: ReadmeCacheTTL}
+}
+
+// Analyze returns README signals for a repository. See Content for how the
+// README is obtained.
+func (a *ReadmeAnalyzer) Analyze(repo github.Repository, refresh bool) (ReadmeSignals, bool, error) {
+	content, found, err := a.Content(repo, refresh)
+	if err != nil || !found {
+		return ReadmeSignals{}, found, err
+	}
+	return AnalyzeReadme(content), true, nil
+}
+
+// Content returns a repository's README, using a fresh cached copy when
+// available and fetching otherwise. refresh forces a fetch. The returned
+// bool is false when no README content could be obtained.
+func (a *ReadmeAnalyzer) Content(repo github.Repository, refresh bool) (string, bool, error) {
+	var cached *store.CachedReadme
+	if a.store != nil {
+		c, err := a.store.GetReadme(repo.Owner, repo.Name)
+		if err != nil && !errors.Is(err, store.ErrNotFound) {
+			return "", false, fmt.Errorf("loading cached readme: %w", err)
+		}
+		cached = c
+	}
+
+	if cached != nil && !refresh && (a.fetcher == nil || time.Since(cached.FetchedAt) < a.ttl) {
+		return cached.Content, true, nil
+	}
+	if a.fetcher == nil {
+		return "", false, nil
+	}
+
+	content, err := a.fetcher.FetchReadme(repo.Owner, repo.Name)
+	if err != nil {
+		if cached != nil {
+			slog.Warn("using stale cached readme", "component", "analyze", "repo", repo.FullName(), "error", err)
+			return cached.Content, true, nil
+		}
+		return "", false, err
+	}
+
+	if a.store != nil {
+		if err := a.store.SaveReadme(repo.Owner, repo.Name, content); err != nil {
+			slog.Warn("failed to cache readme", "component", "analyze", "repo", repo.FullName(), "error", err)
+		}
+	}
+	return content, true, nil
+}
+
+// CachedReadmeSignals analyzes every cached README for owner, keyed by
+// repository full name.
+func CachedReadmeSignals(s *store.Store, owner string) (map[string]ReadmeSignals, error) {
+	readmes, err := s.GetReadmes(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	signals := make(map[string]ReadmeSignals, len(readmes))
+	for _, r := range readmes {
+		signals[r.Owner+"/"+r.RepoName] = AnalyzeReadme(r.Content)
+	}
+	return signals, nil
+}
@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package analyze
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+const healthyReadme = `# widget
+
+Widget renders configurable dashboards from YAML files. It supports
+plugins, live reload and exporting panels as PNG images for reports.
+
+## Install
+
+    go install example.com/widget@latest
+`
+
+func TestAnalyzeReadme(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		want        ReadmeSignals
+		wantPhrases []string
+	}{
+		{
+			name:    "missing README",
+			content: "",
+			want:    ReadmeSignals{Missing: true},
+		},
+		{
+			name:    "whitespace only is missing",
+			content: "  \n\t\n",
+			want:    ReadmeSignals{Missing: true},
+		},
+		{
+			name:    "healthy README has no signals",
+			content: healthyReadme,
+			want:    ReadmeSignals{},
+		},
+		{
+			name:    "bare title is boilerplate",
+			content: "# my-repo\n\n[![Build](https://ci.example.com/badge.svg)](https://ci.example.com)\n",
+			want:    ReadmeSignals{Boilerplate: true},
+		},
+		{
+			name:        "create react app template is boilerplate",
+			content:     "# Getting Started with Create React App\n\n" + healthyReadme,
+			want:        ReadmeSignals{Boilerplate: true},
+			wantPhrases: []string{"Getting Started with Create React App"},
+		},
+		{
+			name:        "deprecation sentence",
+			content:     healthyReadme + "\nThis project is deprecated in favour of gadget.\n",
+			want:        ReadmeSignals{Deprecated: true},
+			wantPhrases: []string{"This project is deprecated"},
+		},
+		{
+			name:        "deprecation banner",
+			content:     "> **DEPRECATED**: use gadget instead\n\n" + healthyReadme,
+			want:        ReadmeSignals{Deprecated: true},
+			wantPhrases: []string{"DEPRECATED: use gadget instead"},
+		},
+		{
+			name:    "changelog heading is not a deprecation",
+			content: healthyReadme + "\n### Deprecated\n\n- old flag\n",
+			want:    ReadmeSignals{},
+		},
+		{
+			name:        "no longer maintained",
+			content:     healthyReadme + "\nThis library is no longer maintained.\n",
+			want:        ReadmeSignals{Unmaintained: true},
+			wantPhrases: []string{"no longer maintained"},
+		},
+		{
+			name:        "moved to url",
+			content:     healthyReadme + "\nThis repository has moved to https://gitlab.com/acme/widget.\n",
+			want:        ReadmeSignals{MovedTo: "https://gitlab.com/acme/widget"},
+			wantPhrases: []string{"moved to https://gitlab.com/acme/widget."},
+		},
+		{
+			name:        "moved to markdown link",
+			content:     healthyReadme + "\nDevelopment migrated to [acme/widget2](https://github.com/acme/widget2).\n",
+			want:        ReadmeSignals{MovedTo: "https://github.com/acme/widget2"},
+			wantPhrases: []string{"migrated to [acme/widget2](https://github.com/acme/widget2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AnalyzeReadme(tt.content)
+			assert.Equal(t, tt.wantPhrases, got.Phrases)
+			got.Phrases = nil
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAssessWithSignals_Readme(t *testing.T) {
+	active := testutil.NewTestRepo(testutil.WithDaysInactive(10), testutil.WithStars(5))
+	quiet := testutil.NewTestRepo(testutil.WithDaysInactive(200), testutil.WithStars(5))
+
+	tests := []struct {
+		name      string
+		repo      github.Repository
+		signals   ReadmeSignals
+		wantCodes []string
+		wantScore int
+	}{
+		{"no signals", active, ReadmeSignals{}, []string{}, 0},
+		{"deprecated active repo", active, ReadmeSignals{Deprecated: true}, []string{"readme_deprecated"}, 40},
+		{"moved and unmaintained", active, ReadmeSignals{Unmaintained: true, MovedTo: "https://x.test"}, []string{"readme_unmaintained", "readme_moved"}, 75},
+		{"missing README on active repo is ignored", active, ReadmeSignals{Missing: true}, []string{}, 0},
+		{"missing README on quiet repo", quiet, ReadmeSignals{Missing: true}, []string{"readme_missing"}, 10},
+		{"boilerplate README on quiet repo", quiet, ReadmeSignals{Boilerplate: true}, []string{"readme_boilerplate"}, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := AssessWithSignals(tt.repo, Signals{Readme: &tt.signals})
+			assert.Equal(t, tt.wantCodes, a.Codes())
+			assert.Equal(t, tt.wantScore, a.Score)
+			assert.Equal(t, len(tt.wantCodes) > 0, a.Candidate)
+		})
+	}
+}
+
+// fakeFetcher returns canned README content and counts calls.
+type fakeFetcher struct {
+	content string
+	err     error
+	calls   int
+}
+
+func (f *fakeFetcher) FetchReadme(owner, name string) (string, error) {
+	f.calls++
+	return f.content, f.err
+}
+
+func TestReadmeAnalyzer_CachesFetchedReadme(t *testing.T) {
+	s := testutil.NewStore(t)
+	fetcher := &fakeFetcher{content: "This project is no longer maintained."}
+	analyzer := NewReadmeAnalyzer(fetcher, s)
+	repo := testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("widget"))
+
+	signals, found, err := analyzer.Analyze(repo, false)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.True(t, signals.Unmaintained)
+
+	// Second call is served from the cache
+	_, _, err = analyzer.Analyze(repo, false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, fetcher.calls)
+
+	// Refresh forces a fetch
+	_, _, err = analyzer.Analyze(repo, true)
+	require.NoError(t, err)
+	assert.Equal(t, 2, fetcher.calls)
+
+	cached, err := CachedReadmeSignals(s, "acme")
+	require.NoError(t, err)
+	assert.True(t, cached["acme/widget"].Unmaintained)
+}
+
+func TestReadmeAnalyzer_FallsBackToStaleCache(t *testing.T) {
+	s := testutil.NewStore(t)
+	require.NoError(t, s.SaveReadme("acme", "widget", "This project is deprecated."))
+	fetcher := &fakeFetcher{err: errors.New("rate limited")}
+	analyzer := NewReadmeAnalyzer(fetcher, s)
+	repo := testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("widget"))
+
+	signals, found, err := analyzer.Analyze(repo, true)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.True(t, signals.Deprecated)
+}
+
+func TestReadmeAnalyzer_FetchError(t *testing.T) {
+	fetcher := &fakeFetcher{err: errors.New("rate limited")}
+	analyzer := NewReadmeAnalyzer(fetcher, nil)
+
+	_, found, err := analyzer.Analyze(testutil.NewTestRepo(), false)
+	assert.Error(t, err)
+	assert.False(t, found)
+}
+
+func TestReadmeAnalyzer_CacheOnly(t *testing.T) {
+	s := testutil.NewStore(t)
+	analyzer := NewReadmeAnalyzer(nil, s)
+
+	_, found, err := analyzer.Analyze(testutil.NewTestRepo(), false)
+	require.NoError(t, err)
+	assert.False(t, found)
+}
@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package analyze
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+// Decision is the recommendation in an AI verdict.
+type Decision string
+
+// Decisions an AI backend may return.
+const (
+	DecisionArchive Decision = "archive"
+	DecisionKeep    Decision = "keep"
+	DecisionReview  Decision = "needs-owner-review"
+)
+
+// Decisions lists the valid verdict decisions.
+func Decisions() []Decision {
+	return []Decision{DecisionArchive, DecisionKeep, DecisionReview}
+}
+
+// Verdict is a structured AI recommendation for a repository.
+type Verdict struct {
+	Decision    Decision `json:"verdict"`
+	Confidence  float64  `json:"confidence"`            // 0-1
+	Rationale   string   `json:"rationale"`             // short explanation
+	Replacement string   `json:"replacement,omitempty"` // suggested successor repo, if any
+}
+
+// verdictInstructions asks the backend for a verdict ParseVerdict understands.
+const verdictInstructions = `Respond with only a JSON object, no other text:
+{"verdict": "archive" | "keep" | "needs-owner-review", "confidence": <0.0-1.0>, "rationale": "<one or two sentences>", "replacement": "<owner/repo or URL of a successor, or empty>"}`
+
+// ParseVerdict extracts a verdict from backend output. The JSON object may be
+// surrounded by prose or a Markdown code fence. An error means the output
+// should be shown as raw text instead.
+func ParseVerdict(output string) (Verdict, error) {
+	start := strings.Index(output, "{")
+	end := strings.LastIndex(output, "}")
+	if start < 0 || end < start {
+		return Verdict{}, fmt.Errorf("no JSON object in output")
+	}
+
+	var raw struct {
+		Verdict     string   `json:"verdict"`
+		Confidence  *float64 `json:"confidence"`
+		Rationale   string   `json:"rationale"`
+		Replacement string   `json:"replacement"`
+	}
+	if err := json.Unmarshal([]byte(output[start:end+1]), &raw); err != nil {
+		return Verdict{}, fmt.Errorf("decoding verdict: %w", err)
+	}
+
+	decision, err := ParseDecision(raw.Verdict)
+	if err != nil {
+		return Verdict{}, err
+	}
+	if raw.Confidence == nil {
+		return Verdict{}, fmt.Errorf("verdict has no confidence")
+	}
+
+	// Accept whole percentages as well as fractions; 1.5 is neither
+	confidence := *raw.Confidence
+	if confidence > 1 && confidence <= 100 && confidence == math.Trunc(confidence) {
+		confidence /= 100
+	}
+	if confidence < 0 || confidence > 1 {
+		return Verdict{}, fmt.Errorf("confidence %v out of range", *raw.Confidence)
+	}
+
+	return Verdict{
+		Decision:    decision,
+		Confidence:  confidence,
+		Rationale:   strings.TrimSpace(raw.Rationale),
+		Replacement: strings.TrimSpace(raw.Replacement),
+	}, nil
+}
+
+// ParseDecision returns the decision for a name such as "Archive", "keep" or
+// "needs_owner_review"; "review" is accepted for needs-owner-review.
+func ParseDecision(s string) (Decision, error) {
+	normalized := strings.ToLower(strings.TrimSpace(s))
+	normalized = strings.NewReplacer("_", "-", " ", "-").Replace(normalized)
+	switch normalized {
+	case "archive":
+		return DecisionArchive, nil
+	case "keep":
+		return DecisionKeep, nil
+	case "needs-owner-review", "review", "owner-review":
+		return DecisionReview, nil
+	}
+	return "", fmt.Errorf("unknown verdict %q", s)
+}
+
+// Short returns a compact label for table columns.
+func (d Decision) Short() string {
+	if d == DecisionReview {
+		return "review"
+	}
+	return string(d)
+}
+
+// SaveVerdict stores the latest verdict for repo from backend.
+func SaveVerdict(s *store.Store, repo github.Repository, backend string, v Verdict) error {
+	return s.SaveVerdict(store.RepoVerdict{
+		Owner:       repo.Owner,
+		RepoName:    repo.Name,
+		Verdict:     string(v.Decision),
+		Confidence:  v.Confidence,
+		Rationale:   v.Rationale,
+		Replacement: v.Replacement,
+		Backend:     backend,
+	})
+}
+
+// LoadVerdicts returns the stored verdicts for owner keyed by repository
+// full name. Stored rows with an unknown decision are skipped.
+func LoadVerdicts(s *store.Store, owner string) (map[string]Verdict, error) {
+	rows, err := s.GetVerdicts(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	verdicts := make(map[string]Verdict, len(rows))
+	for _, r := range rows {
+		decision, err := ParseDecision(r.Verdict)
+		if err != nil {
+			continue
+		}
+		verdicts[r.Owner+"/"+r.RepoName] = Verdict{
+			Decision:    decision,
+			Confidence:  r.Confidence,
+			Rationale:   r.Rationale,
+			Replacement: r.Replacement,
+		}
+	}
+	return verdicts, nil
+}
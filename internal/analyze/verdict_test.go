@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package analyze
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+func TestParseVerdict(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  string
+		want    Verdict
+		wantErr bool
+	}{
+		{
+			name:   "bare JSON",
+			output: `{"verdict": "archive", "confidence": 0.85, "rationale": "No activity in years.", "replacement": ""}`,
+			want:   Verdict{Decision: DecisionArchive, Confidence: 0.85, Rationale: "No activity in years."},
+		},
+		{
+			name:   "fenced JSON with surrounding prose",
+			output: "Here is my assessment:\n```json\n{\"verdict\": \"Keep\", \"confidence\": 0.6, \"rationale\": \"Still used.\"}\n```\nThanks!",
+			want:   Verdict{Decision: DecisionKeep, Confidence: 0.6, Rationale: "Still used."},
+		},
+		{
+			name:   "confidence of exactly one",
+			output: `{"verdict": "keep", "confidence": 1}`,
+			want:   Verdict{Decision: DecisionKeep, Confidence: 1},
+		},
+		{
+			name:   "percentage confidence and replacement",
+			output: `{"verdict": "needs_owner_review", "confidence": 70, "rationale": "Unclear.", "replacement": "acme/widget-v2"}`,
+			want:   Verdict{Decision: DecisionReview, Confidence: 0.7, Rationale: "Unclear.", Replacement: "acme/widget-v2"},
+		},
+		{
+			name:    "free text",
+			output:  "ARCHIVE: nobody has touched this in years.",
+			wantErr: true,
+		},
+		{
+			name:    "malformed JSON",
+			output:  `{"verdict": "archive", "confidence": }`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown decision",
+			output:  `{"verdict": "delete", "confidence": 0.9}`,
+			wantErr: true,
+		},
+		{
+			name:    "missing confidence",
+			output:  `{"verdict": "keep"}`,
+			wantErr: true,
+		},
+		{
+			name:    "confidence out of range",
+			output:  `{"verdict": "keep", "confidence": 250}`,
+			wantErr: true,
+		},
+		{
+			name:    "fractional confidence above one",
+			output:  `{"verdict": "archive", "confidence": 1.5}`,
+			wantErr: true,
+		},
+		{
+			name:    "negative confidence",
+			output:  `{"verdict": "archive", "confidence": -0.2}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseVerdict(tt.output)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.Decision, got.Decision)
+			assert.InDelta(t, tt.want.Confidence, got.Confidence, 0.001)
+			assert.Equal(t, tt.want.Rationale, got.Rationale)
+			assert.Equal(t, tt.want.Replacement, got.Replacement)
+		})
+	}
+}
+
+func TestAssessWithSignals_Verdict(t *testing.T) {
+	repo := testutil.NewTestRepo(testutil.WithDaysInactive(10), testutil.WithStars(3))
+
+	a := AssessWithSignals(repo, Signals{Verdict: &Verdict{Decision: DecisionArchive, Confidence: 0.8}})
+	assert.Equal(t, []string{"ai_archive"}, a.Codes())
+	assert.Equal(t, 24, a.Score)
+	assert.True(t, a.Candidate)
+	assert.Contains(t, a.Summary(), "80% confidence")
+
+	// Only archive verdicts count
+	a = AssessWithSignals(repo, Signals{Verdict: &Verdict{Decision: DecisionKeep, Confidence: 1}})
+	assert.False(t, a.Candidate)
+}
+
+func TestVerdictStore(t *testing.T) {
+	s := testutil.NewStore(t)
+	repo := testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("widget"))
+
+	v := Verdict{Decision: DecisionReview, Confidence: 0.5, Rationale: "Ask the owner.", Replacement: "acme/gadget"}
+	require.NoError(t, SaveVerdict(s, repo, BackendOllama, v))
+
+	verdicts, err := LoadVerdicts(s, "acme")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]Verdict{"acme/widget": v}, verdicts)
+
+	// The latest verdict replaces earlier ones
+	v.Decision = DecisionKeep
+	require.NoError(t, SaveVerdict(s, repo, BackendOllama, v))
+	verdicts, err = LoadVerdicts(s, "acme")
+	require.NoError(t, err)
+	assert.Equal(t, DecisionKeep, verdicts["acme/widget"].Decision)
+}
@@ -0,0 +1,426 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package backup keeps local git copies of repositories, with metadata such
+// as their issues, so they can be restored after being deleted on GitHub.
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/llbbl/repjan/internal/github"
+)
+
+// Backup formats.
+const (
+	FormatMirror = "mirror" // bare repository made with 'git clone --mirror'
+	FormatBundle = "bundle" // single file made with 'git bundle create --all'
+)
+
+// gitTimeout bounds a single git command; mirroring large repositories is slow.
+const gitTimeout = 30 * time.Minute
+
+// Metadata describes a backup. It is kept as JSON next to the backup.
+type Metadata struct {
+	Owner       string        `json:"owner"`
+	Name        string        `json:"name"`
+	Format      string        `json:"format"`
+	File        string        `json:"file"` // mirror directory or bundle file, empty for an empty repository
+	Refs        int           `json:"refs"`
+	CreatedAt   time.Time     `json:"createdAt"`
+	Description string        `json:"description,omitempty"`
+	PushedAt    time.Time     `json:"pushedAt"`
+	Issues      IssuesSummary `json:"issues"`
+
+	Path string `json:"-"` // absolute location of File, set when saved or loaded
+}
+
+// IssuesSummary counts a repository's issues and lists them.
+type IssuesSummary struct {
+	Open   int                   `json:"open"`
+	Closed int                   `json:"closed"`
+	Items  []github.IssueSummary `json:"items,omitempty"`
+}
+
+// Client is the GitHub access backups need for their metadata.
+type Client interface {
+	ListIssues(owner, name string) ([]github.IssueSummary, error)
+}
+
+// Backer backs up repositories into a directory, one subdirectory per owner.
+type Backer struct {
+	client    Client
+	dir       string
+	format    string
+	remoteURL func(owner, name string) string
+}
+
+// NewBacker creates a backer writing format backups under dir. An empty
+// format means FormatMirror.
+func NewBacker(client Client, dir, format string) *Backer {
+	if format == "" {
+		format = FormatMirror
+	}
+	return &Backer{client: client, dir: dir, format: format, remoteURL: RemoteURL}
+}
+
+// Dir returns the backup directory.
+func (b *Backer) Dir() string {
+	return b.dir
+}
+
+// SetRemoteURL changes where repositories are cloned from, e.g. a GitHub
+// Enterprise host or, in tests, local bare repositories.
+func (b *Backer) SetRemoteURL(remoteURL func(owner, name string) string) {
+	b.remoteURL = remoteURL
+}
+
+// Backup copies repo and its issue list into the backup directory, verifies
+// the copy against GitHub and saves its metadata. An existing backup of the
+// repository is refreshed.
+func (b *Backer) Backup(repo github.Repository) (Metadata, error) {
+	issues, err := b.client.ListIssues(repo.Owner, repo.Name)
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	url := b.remoteURL(repo.Owner, repo.Name)
+	var copied snapshot
+	if b.format == FormatBundle {
+		copied, err = bundle(url, filepath.Join(b.dir, repo.Owner, repo.Name+".bundle"))
+	} else {
+		copied, err = mirror(url, filepath.Join(b.dir, repo.Owner, repo.Name+".git"))
+	}
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	meta := Metadata{
+		Owner:       repo.Owner,
+		Name:        repo.Name,
+		Format:      b.format,
+		Refs:        copied.refs,
+		CreatedAt:   time.Now().UTC(),
+		Description: repo.Description,
+		PushedAt:    repo.PushedAt,
+		Issues:      summarize(issues),
+		Path:        copied.path,
+	}
+	if copied.path != "" {
+		meta.File = filepath.Base(copied.path)
+	}
+	if err := save(metadataPath(b.dir, repo.Owner, repo.Name), meta); err != nil {
+		return Metadata{}, err
+	}
+	return meta, nil
+}
+
+// summarize counts issues by state.
+func summarize(issues []github.IssueSummary) IssuesSummary {
+	s := IssuesSummary{Items: issues}
+	for _, issue := range issues {
+		if strings.EqualFold(issue.State, "open") {
+			s.Open++
+		} else {
+			s.Closed++
+		}
+	}
+	return s
+}
+
+// DefaultDir returns the default backup directory, ~/.repjan/backups.
+func DefaultDir() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("getting home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".repjan", "backups"), nil
+}
+
+// RemoteURL returns the clone URL of a GitHub repository. Private
+// repositories need git credentials, e.g. from 'gh auth setup-git'.
+func RemoteURL(owner, name string) string {
+	return "https://github.com/" + owner + "/" + name + ".git"
+}
+
+// metadataPath returns where the metadata of owner/name is kept under dir.
+func metadataPath(dir, owner, name string) string {
+	return filepath.Join(dir, owner, name+".json")
+}
+
+// save writes meta to path, replacing any earlier metadata only once the
+// new one is complete.
+func save(path string, meta Metadata) error {
+	data, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding backup metadata: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("writing backup metadata: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("writing backup metadata: %w", err)
+	}
+	return nil
+}
+
+// Load reads the metadata of the backup of owner/name under dir. The error
+// wraps os.ErrNotExist when there is no backup.
+func Load(dir, owner, name string) (Metadata, error) {
+	return load(metadataPath(dir, owner, name))
+}
+
+// load reads the metadata at path.
+func load(path string) (Metadata, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Metadata{}, fmt.Errorf("reading backup metadata: %w", err)
+	}
+	var meta Metadata
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return Metadata{}, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	if meta.File != "" {
+		meta.Path = filepath.Join(filepath.Dir(path), meta.File)
+	}
+	return meta, nil
+}
+
+// List returns the metadata of every backup under dir, by owner and name.
+func List(dir string) ([]Metadata, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*", "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("listing backups: %w", err)
+	}
+	sort.Strings(paths)
+
+	backups := make([]Metadata, 0, len(paths))
+	for _, path := range paths {
+		meta, err := load(path)
+		if err != nil {
+			return nil, err
+		}
+		backups = append(backups, meta)
+	}
+	return backups, nil
+}
+
+// Verify checks that the backup described by meta is intact and holds as
+// many refs as when it was made.
+func Verify(meta Metadata) error {
+	if meta.Path == "" {
+		if meta.Refs != 0 {
+			return errors.New("backup file is missing")
+		}
+		return nil
+	}
+
+	var copied snapshot
+	var err error
+	if meta.Format == FormatBundle {
+		copied, err = verifyBundle(meta.Path)
+	} else {
+		copied, err = verifyMirror(meta.Path)
+	}
+	if err != nil {
+		return err
+	}
+	if copied.refs != meta.Refs {
+		return fmt.Errorf("verifying %s: %d refs, expected %d", meta.Path, copied.refs, meta.Refs)
+	}
+	return nil
+}
+
+// snapshot is a verified local copy of a repository.
+type snapshot struct {
+	path string // empty when the repository has no refs to copy
+	refs int
+}
+
+// mirror creates or refreshes a bare mirror of url at path and verifies that
+// it is intact and holds every ref the remote has.
+func mirror(url, path string) (snapshot, error) {
+	slog.Debug("mirroring repository", "component", "backup", "url", url, "path", path)
+
+	if _, err := os.Stat(path); err == nil {
+		if _, err := git(path, "remote", "update", "--prune"); err != nil {
+			return snapshot{}, fmt.Errorf("updating mirror: %w", err)
+		}
+	} else {
+		if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+			return snapshot{}, fmt.Errorf("creating backup directory: %w", err)
+		}
+		if _, err := git("", "clone", "--mirror", "--quiet", url, path); err != nil {
+			return snapshot{}, fmt.Errorf("cloning mirror: %w", err)
+		}
+	}
+
+	c, err := verifyMirror(path)
+	if err != nil {
+		return snapshot{}, err
+	}
+	if err := matchRemote(url, path); err != nil {
+		return snapshot{}, err
+	}
+	return c, nil
+}
+
+// bundle writes all refs of url to a bundle file at path, by way of a
+// temporary mirror, and verifies it. A repository without refs gets no file.
+func bundle(url, path string) (snapshot, error) {
+	slog.Debug("bundling repository", "component", "backup", "url", url, "path", path)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return snapshot{}, fmt.Errorf("creating backup directory: %w", err)
+	}
+	tmp, err := os.MkdirTemp(filepath.Dir(path), ".bundle-")
+	if err != nil {
+		return snapshot{}, fmt.Errorf("creating temporary mirror: %w", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	m, err := mirror(url, filepath.Join(tmp, "repo.git"))
+	if err != nil {
+		return snapshot{}, err
+	}
+	if m.refs == 0 {
+		return snapshot{}, nil
+	}
+
+	partial := filepath.Join(tmp, "repo.bundle")
+	if _, err := git(m.path, "bundle", "create", "--quiet", partial, "--all"); err != nil {
+		return snapshot{}, fmt.Errorf("creating bundle: %w", err)
+	}
+	if err := os.Rename(partial, path); err != nil {
+		return snapshot{}, fmt.Errorf("saving bundle: %w", err)
+	}
+
+	c, err := verifyBundle(path)
+	if err != nil {
+		return snapshot{}, err
+	}
+	if c.refs != m.refs {
+		return snapshot{}, fmt.Errorf("verifying %s: %d refs, expected %d", path, c.refs, m.refs)
+	}
+	return c, nil
+}
+
+// verifyMirror checks that path is an intact bare repository.
+func verifyMirror(path string) (snapshot, error) {
+	out, err := git(path, "rev-parse", "--is-bare-repository")
+	if err != nil {
+		return snapshot{}, fmt.Errorf("verifying %s: %w", path, err)
+	}
+	if strings.TrimSpace(string(out)) != "true" {
+		return snapshot{}, fmt.Errorf("verifying %s: not a bare repository", path)
+	}
+	if _, err := git(path, "fsck", "--no-progress", "--no-dangling"); err != nil {
+		return snapshot{}, fmt.Errorf("verifying %s: %w", path, err)
+	}
+
+	refs, err := localRefs(path)
+	if err != nil {
+		return snapshot{}, err
+	}
+	return snapshot{path: path, refs: len(refs)}, nil
+}
+
+// verifyBundle checks a bundle by restoring it into a temporary mirror,
+// since git only verifies bundles inside a repository.
+func verifyBundle(path string) (snapshot, error) {
+	tmp, err := os.MkdirTemp("", "repjan-verify-")
+	if err != nil {
+		return snapshot{}, fmt.Errorf("creating temporary mirror: %w", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	restored := filepath.Join(tmp, "repo.git")
+	if _, err := git("", "clone", "--mirror", "--quiet", path, restored); err != nil {
+		return snapshot{}, fmt.Errorf("verifying %s: %w", path, err)
+	}
+	c, err := verifyMirror(restored)
+	if err != nil {
+		return snapshot{}, fmt.Errorf("verifying %s: %w", path, err)
+	}
+	return snapshot{path: path, refs: c.refs}, nil
+}
+
+// matchRemote checks that the mirror at path has every ref of url at the
+// same commit.
+func matchRemote(url, path string) error {
+	out, err := git("", "ls-remote", url)
+	if err != nil {
+		return fmt.Errorf("listing remote refs: %w", err)
+	}
+	local, err := localRefs(path)
+	if err != nil {
+		return err
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		sha, ref, ok := strings.Cut(scanner.Text(), "\t")
+		if !ok || ref == "HEAD" || strings.HasSuffix(ref, "^{}") {
+			continue
+		}
+		if local[ref] != sha {
+			return fmt.Errorf("verifying %s: %s does not match the remote", path, ref)
+		}
+	}
+	return scanner.Err()
+}
+
+// localRefs returns the refs of the repository at path by name.
+func localRefs(path string) (map[string]string, error) {
+	out, err := git(path, "for-each-ref", "--format=%(objectname) %(refname)")
+	if err != nil {
+		return nil, fmt.Errorf("listing refs of %s: %w", path, err)
+	}
+	refs := make(map[string]string)
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		if sha, ref, ok := strings.Cut(line, " "); ok {
+			refs[ref] = sha
+		}
+	}
+	return refs, nil
+}
+
+// git runs a git command, in dir unless it is empty. Prompts for credentials
+// are disabled so a missing login fails instead of hanging.
+func git(dir string, args ...string) ([]byte, error) {
+	sub := args[0]
+	if dir != "" {
+		args = append([]string{"-C", dir}, args...)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), gitTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("git %s: %s", sub, msg)
+		}
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, fmt.Errorf("git %s: timed out", sub)
+		}
+		return nil, fmt.Errorf("git %s: %w", sub, err)
+	}
+	return out, nil
+}
@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package backup
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+// fakeClient returns the same issues for every repository.
+type fakeClient struct {
+	issues []github.IssueSummary
+}
+
+func (c fakeClient) ListIssues(owner, name string) ([]github.IssueSummary, error) {
+	return c.issues, nil
+}
+
+// newEmptyRemote creates a bare repository without commits.
+func newEmptyRemote(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	remote := filepath.Join(t.TempDir(), "remote.git")
+	out, err := exec.Command("git", "init", "--quiet", "--bare", remote).CombinedOutput()
+	require.NoError(t, err, string(out))
+	return remote
+}
+
+// newBacker returns a backer that clones every repository from remote.
+func newBacker(t *testing.T, remote, format string) *Backer {
+	t.Helper()
+	issues := []github.IssueSummary{
+		{Number: 2, Title: "Crash on start", State: "OPEN"},
+		{Number: 1, Title: "Typo", State: "CLOSED"},
+	}
+	b := NewBacker(fakeClient{issues: issues}, t.TempDir(), format)
+	b.SetRemoteURL(func(owner, name string) string { return remote })
+	return b
+}
+
+func TestBackup_Mirror(t *testing.T) {
+	b := newBacker(t, testutil.NewGitRemote(t), "")
+	repo := github.Repository{Owner: "acme", Name: "widget", Description: "A widget"}
+
+	meta, err := b.Backup(repo)
+	require.NoError(t, err)
+	assert.Equal(t, FormatMirror, meta.Format)
+	assert.Equal(t, "widget.git", meta.File)
+	assert.Equal(t, filepath.Join(b.Dir(), "acme", "widget.git"), meta.Path)
+	assert.Equal(t, 2, meta.Refs)
+	assert.Equal(t, 1, meta.Issues.Open)
+	assert.Equal(t, 1, meta.Issues.Closed)
+
+	// Backing up again refreshes the existing mirror
+	_, err = b.Backup(repo)
+	require.NoError(t, err)
+
+	loaded, err := Load(b.Dir(), "acme", "widget")
+	require.NoError(t, err)
+	assert.Equal(t, meta.Path, loaded.Path)
+	assert.Equal(t, "A widget", loaded.Description)
+	assert.Len(t, loaded.Issues.Items, 2)
+	assert.NoError(t, Verify(loaded))
+
+	_, err = Load(b.Dir(), "acme", "gadget")
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestBackup_Bundle(t *testing.T) {
+	b := newBacker(t, testutil.NewGitRemote(t), FormatBundle)
+
+	meta, err := b.Backup(github.Repository{Owner: "acme", Name: "widget"})
+	require.NoError(t, err)
+	assert.Equal(t, "widget.bundle", meta.File)
+	assert.FileExists(t, meta.Path)
+	assert.Equal(t, 2, meta.Refs)
+	assert.NoError(t, Verify(meta))
+
+	// No temporary mirrors are left behind
+	entries, err := os.ReadDir(filepath.Join(b.Dir(), "acme"))
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	// A damaged bundle fails verification
+	require.NoError(t, os.WriteFile(meta.Path, []byte("# v2 git bundle\n"), 0600))
+	assert.Error(t, Verify(meta))
+}
+
+func TestBackup_EmptyRepository(t *testing.T) {
+	for _, format := range []string{FormatMirror, FormatBundle} {
+		b := newBacker(t, newEmptyRemote(t), format)
+		meta, err := b.Backup(github.Repository{Owner: "acme", Name: "empty"})
+		require.NoError(t, err, format)
+		assert.Zero(t, meta.Refs, format)
+		assert.NoError(t, Verify(meta), format)
+	}
+}
+
+func TestBackup_UnreachableRemote(t *testing.T) {
+	b := newBacker(t, filepath.Join(t.TempDir(), "missing.git"), "")
+
+	_, err := b.Backup(github.Repository{Owner: "acme", Name: "widget"})
+	assert.ErrorContains(t, err, "cloning mirror")
+	_, err = Load(b.Dir(), "acme", "widget")
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestList(t *testing.T) {
+	b := newBacker(t, testutil.NewGitRemote(t), "")
+	for _, name := range []string{"widget", "gadget"} {
+		_, err := b.Backup(github.Repository{Owner: "acme", Name: name})
+		require.NoError(t, err)
+	}
+
+	backups, err := List(b.Dir())
+	require.NoError(t, err)
+	require.Len(t, backups, 2)
+	assert.Equal(t, "gadget", backups[0].Name)
+
+	// A mirror that lost refs no longer verifies
+	out, err := exec.Command("git", "-C", backups[1].Path, "tag", "-d", "v1.0.0").CombinedOutput()
+	require.NoError(t, err, string(out))
+	assert.ErrorContains(t, Verify(backups[1]), "1 refs, expected 2")
+
+	backups, err = List(filepath.Join(t.TempDir(), "none"))
+	require.NoError(t, err)
+	assert.Empty(t, backups)
+}
@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/llbbl/repjan/internal/actions"
+	"github.com/llbbl/repjan/internal/analyze"
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+var (
+	actionsArchived bool
+	actionsFlagged  bool
+	actionsDryRun   bool
+)
+
+var actionsCmd = &cobra.Command{
+	Use:   "actions [repo...]",
+	Short: "Inventory GitHub Actions workflows",
+	Long: `List the GitHub Actions workflows of every stored repository, or of the
+named ones, with their triggers, latest run and its status.
+
+Repositories nobody has committed to in six months that still have active
+scheduled workflows are listed at the end; they burn Actions minutes and send
+failure emails. The TUI and archive analysis flag them with the
+scheduled_workflows reason, and 'repjan actions disable' turns those
+workflows off without archiving. Run 'repjan sync' first to populate the
+database.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client := github.NewDefaultClient()
+		targetOwner, err := resolveOwner(client)
+		if err != nil {
+			return err
+		}
+
+		repoStore, closeStore, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer closeStore()
+
+		repos, err := actionsRepos(repoStore, targetOwner, args, nil)
+		if err != nil {
+			return err
+		}
+		assessor, err := analyze.LoadAssessor(repoStore, targetOwner)
+		if err != nil {
+			return fmt.Errorf("loading archive signals: %w", err)
+		}
+
+		inventory := actions.New(client, repoStore)
+		var scanned, total, failed int
+		var flagged []string
+		for _, repo := range repos {
+			if repo.IsArchived && !actionsArchived && len(args) == 0 {
+				continue
+			}
+			workflows, err := inventory.Scan(repo)
+			if err != nil {
+				slog.Warn("failed to list workflows", "component", "cmd", "repo", repo.FullName(), "error", err)
+				failed++
+				continue
+			}
+			scanned++
+			total += len(workflows)
+			for _, w := range workflows {
+				fmt.Printf("%s\t%s\t%s\t%s\t%s\n", repo.FullName(), w.Name, strings.Join(w.Triggers, ","), actions.LastRun(w), w.State)
+			}
+
+			repo.CronWorkflows = len(actions.Scheduled(workflows))
+			if hasCronReason(assessor, repo) {
+				flagged = append(flagged, repo.FullName())
+			}
+		}
+
+		if len(flagged) > 0 {
+			fmt.Println("\nInactive repositories with scheduled workflows:")
+			for _, name := range flagged {
+				fmt.Println("  " + name)
+			}
+		}
+		fmt.Printf("Scanned %d repo(s): %d workflows, %d inactive with scheduled workflows, %d failed\n", scanned, total, len(flagged), failed)
+		return nil
+	},
+}
+
+var actionsDisableCmd = &cobra.Command{
+	Use:   "disable [repo...]",
+	Short: "Disable the scheduled workflows of repositories",
+	Long: `Disable the active scheduled workflows of repositories without archiving
+them. Without arguments the marked repositories are used, or with --flagged
+every inventoried repository flagged as inactive with scheduled workflows.
+
+Each repository's workflows are listed afresh before disabling, and the
+disabled workflows are recorded in its history. Other workflows keep running
+and disabled workflows can be enabled again on GitHub.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client := github.NewDefaultClient()
+		targetOwner, err := resolveOwner(client)
+		if err != nil {
+			return err
+		}
+
+		repoStore, closeStore, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer closeStore()
+
+		var repos []github.Repository
+		switch {
+		case len(args) > 0:
+			repos, err = actionsRepos(repoStore, targetOwner, args, nil)
+		case actionsFlagged:
+			var assessor *analyze.Assessor
+			if assessor, err = analyze.LoadAssessor(repoStore, targetOwner); err != nil {
+				return fmt.Errorf("loading archive signals: %w", err)
+			}
+			repos, err = actionsRepos(repoStore, targetOwner, nil, func(repo github.Repository) bool {
+				return hasCronReason(assessor, repo)
+			})
+		default:
+			repos, err = selectReposForScope(repoStore, targetOwner, scopeMarked)
+		}
+		if err != nil {
+			return err
+		}
+		if len(repos) == 0 {
+			fmt.Printf("No repositories to disable workflows of for %s\n", targetOwner)
+			return nil
+		}
+
+		if actionsDryRun {
+			stored, err := repoStore.GetWorkflows(targetOwner)
+			if err != nil {
+				return fmt.Errorf("loading workflows: %w", err)
+			}
+			for _, repo := range repos {
+				for _, w := range actions.Scheduled(stored[repo.Name]) {
+					fmt.Printf("would disable\t%s\t%s\t%s\n", repo.FullName(), w.Name, actions.LastRun(w))
+				}
+			}
+			fmt.Printf("Dry run: would disable the scheduled workflows of %d repositories (as of the last 'repjan actions')\n", len(repos))
+			return nil
+		}
+
+		inventory := actions.New(client, repoStore)
+		var disabledCount, failed int
+		for _, repo := range repos {
+			disabled, err := inventory.DisableScheduled(repo)
+			for _, w := range disabled {
+				disabledCount++
+				fmt.Printf("disabled\t%s\t%s\n", repo.FullName(), w.Name)
+			}
+			if err != nil {
+				failed++
+				fmt.Printf("failed\t%s\t%v\n", repo.FullName(), err)
+			}
+		}
+		fmt.Printf("%d workflows disabled, %d repositories failed\n", disabledCount, failed)
+		if failed > 0 {
+			return fmt.Errorf("disabling workflows failed for %d repositories", failed)
+		}
+		return nil
+	},
+}
+
+func init() {
+	actionsCmd.Flags().BoolVar(&actionsArchived, "archived", false, "Include archived repositories")
+	actionsDisableCmd.Flags().BoolVar(&actionsFlagged, "flagged", false, "Use every inactive repository with scheduled workflows")
+	actionsDisableCmd.Flags().BoolVar(&actionsDryRun, "dry-run", false, "Show what would be disabled without changing anything")
+	actionsCmd.AddCommand(actionsDisableCmd)
+}
+
+// actionsRepos returns the named repos, or every stored repo accepted by
+// filter (all when nil) when none are named.
+func actionsRepos(s *store.Store, owner string, names []string, filter func(github.Repository) bool) ([]github.Repository, error) {
+	if len(names) == 0 {
+		repos, err := s.GetRepositories(owner)
+		if err != nil {
+			return nil, fmt.Errorf("loading repositories: %w", err)
+		}
+		if filter == nil {
+			return repos, nil
+		}
+		var matched []github.Repository
+		for _, repo := range repos {
+			if filter(repo) {
+				matched = append(matched, repo)
+			}
+		}
+		return matched, nil
+	}
+
+	var repos []github.Repository
+	for _, name := range names {
+		name = strings.TrimPrefix(name, owner+"/")
+		repo, err := s.GetRepository(owner, name)
+		if err != nil {
+			return nil, fmt.Errorf("loading %s/%s: %w", owner, name, err)
+		}
+		repos = append(repos, *repo)
+	}
+	return repos, nil
+}
+
+// hasCronReason reports whether repo is flagged as inactive with scheduled
+// workflows.
+func hasCronReason(assessor *analyze.Assessor, repo github.Repository) bool {
+	if repo.IsArchived {
+		return false
+	}
+	for _, reason := range assessor.Assess(repo).Reasons {
+		if reason.Code == analyze.ReasonCronWorkflows {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/spf13/cobra"
+
+	"github.com/llbbl/repjan/internal/analyze"
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+var (
+	activityRefresh  bool
+	activityArchived bool
+)
+
+var activityCmd = &cobra.Command{
+	Use:   "activity",
+	Short: "Find human activity, ignoring bot commits",
+	Long: `Check the recent commit authors of every stored repository and record
+when a human last committed.
+
+A repository where only Dependabot, Renovate or a release bot pushes looks
+active by its push date alone. Commits whose author login or name matches a
+bot pattern ("*[bot]", dependabot, renovate, github-actions, ... plus any in
+REPJAN_BOT_PATTERNS) are ignored, and repositories with no human commit in
+over a year are listed. The TUI uses the result for its bot-only filter and
+archive analysis.
+
+Repositories already checked since their last push are skipped unless
+--refresh is given. Run 'repjan sync' first to populate the database.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client := github.NewDefaultClient()
+		targetOwner, err := resolveOwner(client)
+		if err != nil {
+			return err
+		}
+
+		repoStore, closeStore, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer closeStore()
+
+		repos, err := repoStore.GetRepositories(targetOwner)
+		if err != nil {
+			return fmt.Errorf("loading repositories: %w", err)
+		}
+		previous, err := repoStore.GetRepoActivities(targetOwner)
+		if err != nil {
+			return fmt.Errorf("loading repo activity: %w", err)
+		}
+
+		checker := analyze.NewActivityChecker(client, repoStore, botPatterns())
+		checked, botOnly, failed := 0, 0, 0
+		for _, repo := range repos {
+			if repo.IsArchived && !activityArchived {
+				continue
+			}
+
+			var prev *store.RepoActivity
+			if a, ok := previous[repo.Name]; ok {
+				prev = &a
+			}
+			activity, _, err := checker.Check(repo, prev, activityRefresh)
+			if err != nil {
+				slog.Warn("failed to check activity", "component", "cmd", "repo", repo.FullName(), "error", err)
+				failed++
+				continue
+			}
+			checked++
+
+			repo.LastHumanActivity = activity.LastHumanActivity
+			if analyze.IsBotOnly(repo) {
+				botOnly++
+				fmt.Println(activityLine(repo, activity))
+			}
+		}
+
+		fmt.Printf("Checked %d repo(s): %d with only bot activity in over a year, %d failed\n", checked, botOnly, failed)
+		return nil
+	},
+}
+
+func init() {
+	activityCmd.Flags().BoolVar(&activityRefresh, "refresh", false, "Re-check repositories even when unchanged since the last check")
+	activityCmd.Flags().BoolVar(&activityArchived, "archived", false, "Include archived repositories")
+}
+
+// botPatterns returns the extra bot patterns from REPJAN_BOT_PATTERNS.
+func botPatterns() []string {
+	if cfg == nil {
+		return nil
+	}
+	return cfg.BotPatterns
+}
+
+// activityLine formats a repository's human and bot activity as one line.
+func activityLine(repo github.Repository, a store.RepoActivity) string {
+	line := fmt.Sprintf("%s\tlast human %s (%d days)", repo.FullName(),
+		a.LastHumanActivity.Format("2006-01-02"), repo.DaysSinceHumanActivity())
+	if !a.LastBotActivity.IsZero() {
+		line += "\tlast bot " + a.LastBotActivity.Format("2006-01-02")
+	}
+	return line
+}
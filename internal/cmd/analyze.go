@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/llbbl/repjan/internal/analyze"
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+var analyzeCmd = &cobra.Command{
+	Use:   "analyze <repo>...",
+	Short: "Get an AI archive recommendation for repositories",
+	Long: `Ask the configured AI backend whether repositories should be archived.
+
+A single repository is analyzed together with its README (taken from the
+README cache when fresh); several repositories are analyzed together in
+one request from their metadata.
+
+The backend is chosen with --ai-backend or REPJAN_AI_BACKEND:
+  fabric  the fabric CLI with the analyze-repo pattern (--fabric-path)
+  ollama  an Ollama-style /api/chat endpoint (REPJAN_AI_URL, REPJAN_AI_MODEL)
+  openai  any OpenAI-compatible /chat/completions endpoint
+          (REPJAN_AI_URL, REPJAN_AI_MODEL, REPJAN_AI_API_KEY)
+
+Run 'repjan sync' first to populate the database.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		analyzer, err := newAnalyzer()
+		if err != nil {
+			return err
+		}
+
+		client := github.NewDefaultClient()
+		targetOwner, err := resolveOwner(client)
+		if err != nil {
+			return err
+		}
+
+		repoStore, closeStore, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer closeStore()
+
+		repos := make([]github.Repository, 0, len(args))
+		for _, arg := range args {
+			repoOwner, name := splitRepoRef(arg)
+			if repoOwner == "" {
+				repoOwner = targetOwner
+			}
+			repo, err := repoStore.GetRepository(repoOwner, name)
+			if err != nil {
+				if errors.Is(err, store.ErrNotFound) {
+					return fmt.Errorf("repository %s/%s not found in database; run 'repjan sync' first", repoOwner, name)
+				}
+				return fmt.Errorf("loading repository: %w", err)
+			}
+			repos = append(repos, *repo)
+		}
+
+		ctx := context.Background()
+		var result string
+		if len(repos) == 1 {
+			readme, _, err := analyze.NewReadmeAnalyzer(client, repoStore).Content(repos[0], false)
+			if err != nil {
+				return err
+			}
+			result, err = analyzer.AnalyzeRepo(ctx, repos[0], readme)
+			if err != nil {
+				return err
+			}
+		} else {
+			result, err = analyzer.AnalyzeRepos(ctx, repos)
+			if err != nil {
+				return err
+			}
+		}
+
+		fmt.Println(result)
+		return nil
+	},
+}
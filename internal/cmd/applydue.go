@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/schedule"
+)
+
+var applyDueDryRun bool
+
+var applyDueCmd = &cobra.Command{
+	Use:   "apply-due",
+	Short: "Archive scheduled repositories whose grace period has passed",
+	Long: `Archive the repositories scheduled for archiving (press S in the TUI)
+whose archive date has passed.
+
+A due repository is skipped, and its notice issue closed, when anyone other
+than the account that opened the notice commented on it with anything more
+than an approval such as "+1" or "LGTM", or when it was pushed to after the
+announcement. Comments by bots are ignored. Repositories not yet due are
+listed with their date. With REPJAN_BACKUP_ON_ARCHIVE=true each repository
+is backed up first, and one whose backup fails is not archived.
+
+Run it daily, e.g. from cron, and use --dry-run to preview. It exits with
+an error when any scheduled archive failed, so cron reports the failure.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client := github.NewDefaultClient()
+		targetOwner, err := resolveOwner(client)
+		if err != nil {
+			return err
+		}
+
+		repoStore, closeStore, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer closeStore()
+
+		scheduler := schedule.New(client, repoStore, botPatterns())
+		if cfg != nil && cfg.BackupOnArchive {
+			backer, err := newBacker(client)
+			if err != nil {
+				return err
+			}
+			scheduler.SetBackup(backer)
+		}
+		decisions, err := scheduler.ApplyDue(targetOwner, time.Now(), applyDueDryRun)
+		if err != nil {
+			return fmt.Errorf("applying scheduled archives: %w", err)
+		}
+		if len(decisions) == 0 {
+			fmt.Println("No archives scheduled")
+			return nil
+		}
+		printDecisions(os.Stdout, decisions, applyDueDryRun)
+		if n := countFailed(decisions); n > 0 {
+			return fmt.Errorf("%d scheduled archives failed", n)
+		}
+		return nil
+	},
+}
+
+func init() {
+	applyDueCmd.Flags().BoolVar(&applyDueDryRun, "dry-run", false, "Show what would be archived without changing anything")
+}
+
+// countFailed returns the number of decisions that failed.
+func countFailed(decisions []schedule.Decision) int {
+	n := 0
+	for _, d := range decisions {
+		if d.Outcome == schedule.OutcomeFailed {
+			n++
+		}
+	}
+	return n
+}
+
+// printDecisions writes one line per scheduled archive and a summary.
+func printDecisions(w io.Writer, decisions []schedule.Decision, dryRun bool) {
+	counts := make(map[schedule.Outcome]int)
+	for _, d := range decisions {
+		counts[d.Outcome]++
+		name := d.Schedule.Owner + "/" + d.Schedule.RepoName
+		switch d.Outcome {
+		case schedule.OutcomeArchived:
+			fmt.Fprintf(w, "archived\t%s\n", name)
+		case schedule.OutcomeDue:
+			fmt.Fprintf(w, "would archive\t%s\n", name)
+		case schedule.OutcomeSkipped:
+			fmt.Fprintf(w, "skipped\t%s\t%s\n", name, d.Reason)
+		case schedule.OutcomeFailed:
+			fmt.Fprintf(w, "failed\t%s\t%v\n", name, d.Err)
+		case schedule.OutcomePending:
+			fmt.Fprintf(w, "pending\t%s\t%s\n", name, d.Reason)
+		}
+		if d.Outcome == schedule.OutcomeSkipped && d.Err != nil {
+			fmt.Fprintf(w, "\tcould not close notice: %v\n", d.Err)
+		}
+	}
+
+	summary := fmt.Sprintf("%d archived", counts[schedule.OutcomeArchived])
+	if dryRun {
+		summary = fmt.Sprintf("Dry run: %d would be archived", counts[schedule.OutcomeDue])
+	}
+	fmt.Fprintf(w, "%s, %d skipped, %d failed, %d pending\n",
+		summary, counts[schedule.OutcomeSkipped], counts[schedule.OutcomeFailed], counts[schedule.OutcomePending])
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/llbbl/repjan/internal/backup"
+)
+
+var backupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Inspect local repository backups",
+	Long: `Inspect the local backups made before archiving (REPJAN_BACKUP_ON_ARCHIVE)
+and deleting repositories.
+
+Backups live in REPJAN_BACKUP_DIR (default ~/.repjan/backups), one directory
+per owner, as a bare mirror (<name>.git) or a git bundle (<name>.bundle)
+with a <name>.json file describing it and summarizing the repository's
+issues.`,
+}
+
+var backupVerifyCmd = &cobra.Command{
+	Use:   "verify [owner/name...]",
+	Short: "Check that backups are intact",
+	Long: `Check every backup, or the named ones, with 'git fsck' and compare its
+refs with those recorded when it was made. Bundles are checked by restoring
+them into a temporary mirror. Exits with an error if any backup fails.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir, err := resolveBackupDir()
+		if err != nil {
+			return err
+		}
+
+		var backups []backup.Metadata
+		if len(args) == 0 {
+			backups, err = backup.List(dir)
+			if err != nil {
+				return err
+			}
+		}
+		for _, arg := range args {
+			owner, name, ok := strings.Cut(arg, "/")
+			if !ok {
+				return fmt.Errorf("invalid repository %q: use owner/name", arg)
+			}
+			meta, err := backup.Load(dir, owner, name)
+			if err != nil {
+				return fmt.Errorf("%s: %w", arg, err)
+			}
+			backups = append(backups, meta)
+		}
+		if len(backups) == 0 {
+			fmt.Printf("No backups in %s\n", dir)
+			return nil
+		}
+
+		if failed := verifyBackups(os.Stdout, backups); failed > 0 {
+			return fmt.Errorf("%d backups failed verification", failed)
+		}
+		return nil
+	},
+}
+
+func init() {
+	backupCmd.AddCommand(backupVerifyCmd)
+}
+
+// verifyBackups verifies each backup, writing one line per backup and a
+// summary, and returns the number that failed.
+func verifyBackups(w io.Writer, backups []backup.Metadata) int {
+	var failed int
+	for _, meta := range backups {
+		name := meta.Owner + "/" + meta.Name
+		if err := backup.Verify(meta); err != nil {
+			failed++
+			fmt.Fprintf(w, "failed\t%s\t%v\n", name, err)
+			continue
+		}
+		fmt.Fprintf(w, "ok\t%s\t%s, %d refs, %d open issues, %s\n",
+			name, meta.Format, meta.Refs, meta.Issues.Open, meta.CreatedAt.Format("2006-01-02"))
+	}
+	fmt.Fprintf(w, "%d verified, %d failed\n", len(backups)-failed, failed)
+	return failed
+}
@@ -5,6 +5,13 @@ package cmd
 
 import (
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/schedule"
+	"github.com/llbbl/repjan/internal/testutil"
 )
 
 func TestGetOwner_DefaultEmpty(t *testing.T) {
@@ -52,3 +59,48 @@ func TestVersionVariable(t *testing.T) {
 		t.Error("Version should have a default value")
 	}
 }
+
+func TestSelectReposForScope(t *testing.T) {
+	s := testutil.NewStore(t)
+	repos := []github.Repository{
+		testutil.NewTestRepo(testutil.WithName("active"), testutil.WithDaysInactive(5)),
+		testutil.NewTestRepo(testutil.WithName("stale"), testutil.WithDaysInactive(800)),
+		testutil.NewTestRepo(testutil.WithName("gone"), testutil.WithDaysInactive(800), testutil.WithArchived(true)),
+	}
+	require.NoError(t, s.UpsertRepositories("testowner", repos))
+	require.NoError(t, s.SaveMarkedRepos("testowner", []string{"active"}))
+
+	names := func(repos []github.Repository) []string {
+		var out []string
+		for _, r := range repos {
+			out = append(out, r.Name)
+		}
+		return out
+	}
+
+	all, err := selectReposForScope(s, "testowner", scopeAll)
+	require.NoError(t, err)
+	assert.Len(t, all, 3)
+
+	candidates, err := selectReposForScope(s, "testowner", scopeCandidates)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"stale"}, names(candidates))
+
+	marked, err := selectReposForScope(s, "testowner", scopeMarked)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"active"}, names(marked))
+
+	_, err = selectReposForScope(s, "testowner", "bogus")
+	assert.Error(t, err)
+}
+
+func TestCountFailed(t *testing.T) {
+	decisions := []schedule.Decision{
+		{Outcome: schedule.OutcomeArchived},
+		{Outcome: schedule.OutcomeFailed},
+		{Outcome: schedule.OutcomeSkipped},
+		{Outcome: schedule.OutcomeFailed},
+	}
+	assert.Equal(t, 2, countFailed(decisions))
+	assert.Zero(t, countFailed(decisions[:1]))
+}
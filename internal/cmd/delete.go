@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/llbbl/repjan/internal/deletion"
+	"github.com/llbbl/repjan/internal/github"
+)
+
+var (
+	deleteAllow   bool
+	deleteConfirm string
+	deleteDryRun  bool
+)
+
+var deleteCmd = &cobra.Command{
+	Use:   "delete [repo...]",
+	Short: "Permanently delete repositories after backing them up",
+	Long: `Permanently delete repositories, by default the marked ones. This is
+meant for truly disposable repositories such as empty forks.
+
+Deleting is disabled unless REPJAN_ALLOW_DELETE=true or --allow-delete is
+given, and must be confirmed by typing the number of repositories (or
+passing it with --confirm). Each repository is first backed up into
+REPJAN_BACKUP_DIR (default ~/.repjan/backups) as a mirror or bundle
+(REPJAN_BACKUP_FORMAT), the backup is checked against GitHub and its
+location is recorded in the repository's history. Only then is the
+repository deleted. A repository whose backup fails is left alone.
+
+Deleting needs the delete_repo scope ('gh auth refresh -s delete_repo'),
+and backing up private repositories needs git credentials
+('gh auth setup-git').`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !deleteAllow && (cfg == nil || !cfg.AllowDelete) {
+			return errors.New("deleting is disabled: set REPJAN_ALLOW_DELETE=true or pass --allow-delete")
+		}
+		client := github.NewDefaultClient()
+		backer, err := newBacker(client)
+		if err != nil {
+			return err
+		}
+		targetOwner, err := resolveOwner(client)
+		if err != nil {
+			return err
+		}
+
+		repoStore, closeStore, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer closeStore()
+
+		var repos []github.Repository
+		if len(args) == 0 {
+			repos, err = selectReposForScope(repoStore, targetOwner, scopeMarked)
+			if err != nil {
+				return err
+			}
+		}
+		for _, name := range args {
+			name = strings.TrimPrefix(name, targetOwner+"/")
+			repo, err := repoStore.GetRepository(targetOwner, name)
+			if err != nil {
+				return fmt.Errorf("loading %s/%s: %w", targetOwner, name, err)
+			}
+			repos = append(repos, *repo)
+		}
+		if len(repos) == 0 {
+			fmt.Printf("No repositories to delete for %s\n", targetOwner)
+			return nil
+		}
+
+		for _, repo := range repos {
+			fmt.Printf("delete\t%s\n", repo.FullName())
+		}
+		if deleteDryRun {
+			fmt.Printf("Dry run: would back up to %s and delete %d repositories\n", backer.Dir(), len(repos))
+			return nil
+		}
+
+		typed := deleteConfirm
+		if typed == "" {
+			fmt.Printf("This cannot be undone. Type %d to delete these repositories: ", len(repos))
+			typed, _ = bufio.NewReader(os.Stdin).ReadString('\n')
+		}
+		if err := deletion.CheckConfirmation(typed, len(repos)); err != nil {
+			return err
+		}
+
+		deleter := deletion.New(client, repoStore, backer)
+		var failed int
+		for _, repo := range repos {
+			b, err := deleter.Delete(repo)
+			if err != nil {
+				failed++
+				fmt.Printf("failed\t%s\t%v\n", repo.FullName(), err)
+				continue
+			}
+			fmt.Printf("deleted\t%s\t(backup: %s, %d refs)\n", repo.FullName(), b.Path, b.Refs)
+		}
+		fmt.Printf("%d deleted, %d failed\n", len(repos)-failed, failed)
+		if failed > 0 {
+			return fmt.Errorf("%d deletions failed", failed)
+		}
+		return nil
+	},
+}
+
+func init() {
+	deleteCmd.Flags().BoolVar(&deleteAllow, "allow-delete", false, "Enable deleting (default REPJAN_ALLOW_DELETE)")
+	deleteCmd.Flags().StringVar(&deleteConfirm, "confirm", "", "Number of repositories to delete, instead of typing it")
+	deleteCmd.Flags().BoolVar(&deleteDryRun, "dry-run", false, "List what would be deleted without changing anything")
+}
@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/llbbl/repjan/internal/edit"
+	"github.com/llbbl/repjan/internal/github"
+)
+
+var editDryRun bool
+
+var topicCmd = &cobra.Command{
+	Use:   "topic <add|remove> <topic> [repo...]",
+	Short: "Add or remove a topic on repositories",
+	Long: `Add a topic to, or remove it from, repositories. Without repository
+arguments the marked repositories are changed.
+
+Repositories that already have the result are skipped, so an interrupted run
+can be repeated. Every change is recorded in the repository's history with
+the topics before and after.`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var kind edit.Kind
+		switch args[0] {
+		case "add":
+			kind = edit.AddTopic
+		case "remove":
+			kind = edit.RemoveTopic
+		default:
+			return fmt.Errorf("invalid action %q: must be add or remove", args[0])
+		}
+		return runEdit(edit.Edit{Kind: kind, Value: args[1]}, args[2:])
+	},
+}
+
+var descriptionCmd = &cobra.Command{
+	Use:   "description <set|prefix> <text> [repo...]",
+	Short: "Set or prefix the description of repositories",
+	Long: `Replace the description of repositories, or put text in front of it, for
+example "[DEPRECATED] use gizmo instead". Without repository arguments the
+marked repositories are changed.
+
+A description that already starts with the prefix is left alone, so an
+interrupted run can be repeated. Every change is recorded in the repository's
+history with the description before and after.`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var kind edit.Kind
+		switch args[0] {
+		case "set":
+			kind = edit.SetDescription
+		case "prefix":
+			kind = edit.PrefixDescription
+		default:
+			return fmt.Errorf("invalid action %q: must be set or prefix", args[0])
+		}
+		return runEdit(edit.Edit{Kind: kind, Value: args[1]}, args[2:])
+	},
+}
+
+// runEdit applies e to the named repos, or to the marked repos when none are
+// named.
+func runEdit(e edit.Edit, names []string) error {
+	if err := e.Validate(); err != nil {
+		return err
+	}
+
+	client := github.NewDefaultClient()
+	targetOwner, err := resolveOwner(client)
+	if err != nil {
+		return err
+	}
+
+	repoStore, closeStore, err := openStore()
+	if err != nil {
+		return err
+	}
+	defer closeStore()
+
+	var repos []github.Repository
+	if len(names) == 0 {
+		repos, err = selectReposForScope(repoStore, targetOwner, scopeMarked)
+		if err != nil {
+			return err
+		}
+	}
+	for _, name := range names {
+		name = strings.TrimPrefix(name, targetOwner+"/")
+		repo, err := repoStore.GetRepository(targetOwner, name)
+		if err != nil {
+			return fmt.Errorf("loading %s/%s: %w", targetOwner, name, err)
+		}
+		repos = append(repos, *repo)
+	}
+	if len(repos) == 0 {
+		fmt.Printf("No repositories to edit for %s\n", targetOwner)
+		return nil
+	}
+
+	if editDryRun {
+		for _, repo := range repos {
+			fmt.Printf("would %s\t%s\n", e, repo.FullName())
+		}
+		fmt.Printf("Dry run: would %s on %d repositories\n", e, len(repos))
+		return nil
+	}
+
+	editor := edit.New(client, repoStore)
+	var changed, failed int
+	for _, repo := range repos {
+		ok, err := editor.Apply(repo, e)
+		switch {
+		case err != nil:
+			failed++
+			fmt.Printf("failed\t%s\t%v\n", repo.FullName(), err)
+		case ok:
+			changed++
+			fmt.Printf("changed\t%s\n", repo.FullName())
+		default:
+			fmt.Printf("unchanged\t%s\n", repo.FullName())
+		}
+	}
+	fmt.Printf("%d changed, %d unchanged, %d failed\n", changed, len(repos)-changed-failed, failed)
+	if failed > 0 {
+		return fmt.Errorf("%d edits failed", failed)
+	}
+	return nil
+}
+
+func init() {
+	topicCmd.Flags().BoolVar(&editDryRun, "dry-run", false, "Show what would change without changing anything")
+	descriptionCmd.Flags().BoolVar(&editDryRun, "dry-run", false, "Show what would change without changing anything")
+}
@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/llbbl/repjan/internal/analyze"
+	"github.com/llbbl/repjan/internal/export"
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+// Export scopes select which stored repositories are exported.
+const (
+	scopeMarked     = "marked"
+	scopeCandidates = "candidates"
+	scopeAll        = "all"
+)
+
+var (
+	exportFormat   string
+	exportOutput   string
+	exportScope    string
+	exportTemplate string
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export repositories from the database",
+	Long: `Export repositories from the local database in JSON, CSV, Markdown, HTML or YAML.
+
+Use --output - to write to stdout. Without --output a timestamped file is
+written to the current directory. Run 'repjan sync' first to populate the database.
+
+Use --template to render a Go text/template instead of a built-in format. The
+value is either a template file or one of the built-in templates:
+change-request, email, jira. See the README for the template data model.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var exporter export.Exporter
+		var tmplExporter *export.TemplateExporter
+		var err error
+		if exportTemplate != "" {
+			tmplExporter, err = export.ResolveTemplate(exportTemplate)
+			exporter = tmplExporter
+		} else {
+			exporter, err = export.NewExporter(exportFormat)
+		}
+		if err != nil {
+			return err
+		}
+
+		targetOwner, err := resolveOwner(github.NewDefaultClient())
+		if err != nil {
+			return err
+		}
+
+		repoStore, closeStore, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer closeStore()
+
+		repos, err := selectReposForScope(repoStore, targetOwner, exportScope)
+		if err != nil {
+			return err
+		}
+
+		if tmplExporter != nil {
+			tmplExporter.History, err = export.LoadHistory(repoStore, targetOwner, repos)
+			if err != nil {
+				return err
+			}
+		}
+
+		assessor, err := analyze.LoadAssessor(repoStore, targetOwner)
+		if err != nil {
+			return fmt.Errorf("loading archive signals: %w", err)
+		}
+
+		slog.Debug("exporting repositories", "component", "cmd", "owner", targetOwner, "scope", exportScope, "format", exporter.Format(), "count", len(repos))
+		written, err := export.WriteData(exporter, export.BuildExportData(repos, targetOwner, assessor), exportOutput)
+		if err != nil {
+			return err
+		}
+
+		if written != export.Stdout {
+			fmt.Printf("Exported %d repositories to %s\n", len(repos), written)
+		}
+		return nil
+	},
+}
+
+func init() {
+	exportCmd.Flags().StringVar(&exportFormat, "format", "json", "Export format: "+strings.Join(export.Formats(), ", "))
+	exportCmd.Flags().StringVar(&exportOutput, "output", "", "Output file path, or - for stdout (default: timestamped file)")
+	exportCmd.Flags().StringVar(&exportScope, "scope", scopeMarked, "Repositories to export: marked, candidates, all")
+	exportCmd.Flags().StringVar(&exportTemplate, "template", "", "Template file or built-in template ("+strings.Join(export.BuiltinTemplates(), ", ")+"); overrides --format")
+}
+
+// selectReposForScope loads the stored repositories for owner that fall within scope.
+func selectReposForScope(s *store.Store, owner, scope string) ([]github.Repository, error) {
+	repos, err := s.GetRepositories(owner)
+	if err != nil {
+		return nil, fmt.Errorf("loading repositories: %w", err)
+	}
+
+	switch scope {
+	case scopeAll:
+		return repos, nil
+
+	case scopeCandidates:
+		assessor, err := analyze.LoadAssessor(s, owner)
+		if err != nil {
+			return nil, fmt.Errorf("loading archive signals: %w", err)
+		}
+		var candidates []github.Repository
+		for _, repo := range repos {
+			if !repo.IsArchived && assessor.Assess(repo).Candidate {
+				candidates = append(candidates, repo)
+			}
+		}
+		return candidates, nil
+
+	case scopeMarked:
+		names, err := s.GetMarkedRepos(owner)
+		if err != nil {
+			return nil, fmt.Errorf("loading marked repositories: %w", err)
+		}
+		markedSet := make(map[string]bool, len(names))
+		for _, name := range names {
+			markedSet[name] = true
+		}
+		var marked []github.Repository
+		for _, repo := range repos {
+			if markedSet[repo.Name] {
+				marked = append(marked, repo)
+			}
+		}
+		return marked, nil
+	}
+
+	return nil, fmt.Errorf("unknown scope %q: must be one of marked, candidates, all", scope)
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/llbbl/repjan/internal/analyze"
+	"github.com/llbbl/repjan/internal/backup"
+	"github.com/llbbl/repjan/internal/db"
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+// resolveDBPath returns the configured database path, or the default path.
+func resolveDBPath() (string, error) {
+	if cfg != nil && cfg.DBPath != "" {
+		return cfg.DBPath, nil
+	}
+	return db.GetDefaultDBPath()
+}
+
+// resolveBackupDir returns the configured backup directory, or the default.
+func resolveBackupDir() (string, error) {
+	if cfg != nil && cfg.BackupDir != "" {
+		return cfg.BackupDir, nil
+	}
+	return backup.DefaultDir()
+}
+
+// newBacker creates a backer using the configured backup directory and format.
+func newBacker(client *github.Client) (*backup.Backer, error) {
+	dir, err := resolveBackupDir()
+	if err != nil {
+		return nil, err
+	}
+	format := backup.FormatMirror
+	if cfg != nil && cfg.BackupFormat != "" {
+		format = cfg.BackupFormat
+	}
+	return backup.NewBacker(client, dir, format), nil
+}
+
+// openStore opens the database, runs pending migrations and returns a Store.
+// The returned close function must be called to release the database.
+func openStore() (*store.Store, func(), error) {
+	dbPath, err := resolveDBPath()
+	if err != nil {
+		slog.Error("failed to get database path", "component", "cmd", "error", err)
+		return nil, nil, fmt.Errorf("getting database path: %w", err)
+	}
+
+	slog.Debug("opening database", "component", "cmd", "path", dbPath)
+	database, err := db.Open(dbPath)
+	if err != nil {
+		slog.Error("failed to open database", "component", "cmd", "path", dbPath, "error", err)
+		return nil, nil, fmt.Errorf("opening database: %w", err)
+	}
+
+	if err := db.RunMigrations(database); err != nil {
+		db.Close(database)
+		slog.Error("migration failed", "component", "cmd", "error", err)
+		return nil, nil, fmt.Errorf("running migrations: %w", err)
+	}
+
+	return store.New(database), func() { db.Close(database) }, nil
+}
+
+// resolveOwner returns the --owner flag value, falling back to the
+// authenticated gh user when no owner was given.
+func resolveOwner(client *github.Client) (string, error) {
+	if owner != "" {
+		return owner, nil
+	}
+
+	slog.Debug("no owner specified, getting authenticated user", "component", "cmd")
+	user, err := client.GetAuthenticatedUser()
+	if err != nil {
+		slog.Error("failed to get authenticated user", "component", "cmd", "error", err)
+		return "", fmt.Errorf("failed to get authenticated user: %w\nMake sure you're logged in with 'gh auth login'", err)
+	}
+	return user, nil
+}
+
+// newAnalyzer creates the configured AI analyzer. --ai-backend overrides
+// REPJAN_AI_BACKEND and --fabric-path sets the fabric binary.
+func newAnalyzer() (analyze.Analyzer, error) {
+	c := analyze.AIConfig{FabricPath: fabricPath}
+	if cfg != nil {
+		c.Backend = cfg.AIBackend
+		c.URL = cfg.AIURL
+		c.Model = cfg.AIModel
+		c.APIKey = cfg.AIAPIKey
+		c.Timeout = cfg.AITimeout
+		c.TokenBudget = cfg.AITokenBudget
+	}
+	if aiBackend != "" {
+		c.Backend = aiBackend
+	}
+
+	a, err := analyze.NewAnalyzer(c)
+	if err != nil {
+		return nil, err
+	}
+	slog.Debug("using AI backend", "component", "cmd", "backend", a.Name())
+	return a, nil
+}
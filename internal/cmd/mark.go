@@ -0,0 +1,519 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/llbbl/repjan/internal/analyze"
+	"github.com/llbbl/repjan/internal/export"
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+// Mark import formats.
+const (
+	markFormatAuto = "auto"
+	markFormatList = "list"
+	markFormatCSV  = "csv"
+	markFormatJSON = "json"
+)
+
+var (
+	markImportFormat  string
+	markImportReplace bool
+	markImportForce   bool
+	markImportDryRun  bool
+	markExportOutput  string
+	forceClearMarks   bool
+)
+
+var markCmd = &cobra.Command{
+	Use:   "mark",
+	Short: "Manage repositories marked for archiving",
+	Long: `Import, list, clear and export the repositories marked for archiving.
+
+Marks live in the local database, so one person can prepare a list with
+'repjan mark import' and another can review it in the TUI or with 'repjan mark list'.`,
+}
+
+var markImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Mark repositories listed in a file",
+	Long: `Mark repositories listed in a file, or - for stdin.
+
+Accepted formats:
+  list  one repository per line as name, owner/name or a GitHub URL;
+        blank lines and lines starting with # are ignored
+  csv   a CSV file with a full_name, name, repository or repo column,
+        such as 'repjan export --format csv' output
+  json  'repjan export' JSON, or a JSON array of names
+
+With --format auto the format is chosen from the file extension and contents.
+Every entry is checked against the database; unknown and already-archived
+repositories are reported and skipped. Run 'repjan sync' first.
+
+--replace unmarks repositories missing from the file. It refuses a file
+without any valid entry, which would clear every mark, unless --force is given.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		source := args[0]
+
+		var content []byte
+		var err error
+		if source == export.Stdout {
+			content, err = io.ReadAll(os.Stdin)
+		} else {
+			content, err = os.ReadFile(source)
+		}
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", source, err)
+		}
+
+		format := markImportFormat
+		if format == markFormatAuto {
+			format = detectMarkFormat(source, content)
+		}
+
+		refs, err := parseMarkRefs(bytes.NewReader(content), format)
+		if err != nil {
+			return fmt.Errorf("parsing %s as %s: %w", source, format, err)
+		}
+
+		targetOwner, err := resolveOwner(github.NewDefaultClient())
+		if err != nil {
+			return err
+		}
+
+		repoStore, closeStore, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer closeStore()
+
+		repos, err := repoStore.GetRepositories(targetOwner)
+		if err != nil {
+			return fmt.Errorf("loading repositories: %w", err)
+		}
+		existing, err := repoStore.GetMarkedRepos(targetOwner)
+		if err != nil {
+			return fmt.Errorf("loading marked repositories: %w", err)
+		}
+
+		result := resolveMarkRefs(refs, targetOwner, repos)
+		slog.Debug("resolved mark import", "component", "cmd", "source", source, "format", format,
+			"valid", len(result.Valid), "unknown", len(result.Unknown), "archived", len(result.Archived))
+
+		for _, ref := range result.Unknown {
+			fmt.Printf("unknown:  %s\n", ref)
+		}
+		for _, name := range result.Archived {
+			fmt.Printf("archived: %s/%s\n", targetOwner, name)
+		}
+
+		if markImportReplace && len(result.Valid) == 0 && len(existing) > 0 && !markImportForce {
+			return fmt.Errorf("no valid entries in %s: --replace would unmark all %d repositories; use --force to do so", source, len(existing))
+		}
+
+		added := newMarks(existing, result.Valid)
+		var removed []string
+		if markImportReplace {
+			removed = newMarks(result.Valid, existing)
+		}
+		if markImportDryRun {
+			fmt.Printf("Dry run: would mark %d repositories (%d new, %d unmarked, %d unknown, %d already archived)\n",
+				len(result.Valid), len(added), len(removed), len(result.Unknown), len(result.Archived))
+			return nil
+		}
+
+		if err := applyMarks(repoStore, targetOwner, result.Valid, markImportReplace); err != nil {
+			return err
+		}
+
+		note := "imported from " + source
+		for _, name := range added {
+			if err := repoStore.RecordRepoChange(targetOwner, name, "marked", "user", nil, nil, note); err != nil {
+				slog.Warn("failed to record mark", "component", "cmd", "repo", name, "error", err)
+			}
+		}
+		recordUnmarks(repoStore, targetOwner, removed, "replaced by import from "+source)
+
+		fmt.Printf("Marked %d repositories (%d new, %d unmarked, %d unknown, %d already archived)\n",
+			len(result.Valid), len(added), len(removed), len(result.Unknown), len(result.Archived))
+		return nil
+	},
+}
+
+var markListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List marked repositories",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		targetOwner, err := resolveOwner(github.NewDefaultClient())
+		if err != nil {
+			return err
+		}
+
+		repoStore, closeStore, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer closeStore()
+
+		repos, err := selectReposForScope(repoStore, targetOwner, scopeMarked)
+		if err != nil {
+			return err
+		}
+
+		if len(repos) == 0 {
+			fmt.Printf("No repositories marked for %s\n", targetOwner)
+			return nil
+		}
+
+		assessor, err := analyze.LoadAssessor(repoStore, targetOwner)
+		if err != nil {
+			return fmt.Errorf("loading archive signals: %w", err)
+		}
+
+		for _, repo := range repos {
+			reason := assessor.Assess(repo).Summary()
+			if reason == "" {
+				reason = "-"
+			}
+			fmt.Printf("%-40s %5dd  %s\n", repo.FullName(), repo.DaysSinceActivity, reason)
+		}
+		fmt.Printf("\n%d repositories marked\n", len(repos))
+		return nil
+	},
+}
+
+var markClearCmd = &cobra.Command{
+	Use:   "clear",
+	Short: "Remove all marks",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		targetOwner, err := resolveOwner(github.NewDefaultClient())
+		if err != nil {
+			return err
+		}
+
+		repoStore, closeStore, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer closeStore()
+
+		names, err := repoStore.GetMarkedRepos(targetOwner)
+		if err != nil {
+			return fmt.Errorf("loading marked repositories: %w", err)
+		}
+		if len(names) == 0 {
+			fmt.Printf("No repositories marked for %s\n", targetOwner)
+			return nil
+		}
+
+		if !forceClearMarks {
+			fmt.Printf("This will unmark %d repositories for %s\n", len(names), targetOwner)
+			fmt.Print("Type 'yes' to confirm: ")
+
+			reader := bufio.NewReader(os.Stdin)
+			confirmation, err := reader.ReadString('\n')
+			if err != nil {
+				return fmt.Errorf("reading confirmation: %w", err)
+			}
+			if strings.TrimSpace(strings.ToLower(confirmation)) != "yes" {
+				fmt.Println("Aborted.")
+				return nil
+			}
+		}
+
+		if err := repoStore.ClearMarkedRepos(targetOwner); err != nil {
+			return err
+		}
+		recordUnmarks(repoStore, targetOwner, names, "mark clear")
+		fmt.Printf("Cleared %d marks\n", len(names))
+		return nil
+	},
+}
+
+var markExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Write marked repositories as a list 'repjan mark import' accepts",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		targetOwner, err := resolveOwner(github.NewDefaultClient())
+		if err != nil {
+			return err
+		}
+
+		repoStore, closeStore, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer closeStore()
+
+		names, err := repoStore.GetMarkedRepos(targetOwner)
+		if err != nil {
+			return fmt.Errorf("loading marked repositories: %w", err)
+		}
+
+		var b strings.Builder
+		writeMarkList(&b, targetOwner, names, time.Now())
+
+		if markExportOutput == export.Stdout {
+			fmt.Print(b.String())
+			return nil
+		}
+		if err := os.WriteFile(markExportOutput, []byte(b.String()), 0644); err != nil {
+			return fmt.Errorf("failed to write file: %w", err)
+		}
+		fmt.Printf("Exported %d marks to %s\n", len(names), markExportOutput)
+		return nil
+	},
+}
+
+func init() {
+	markImportCmd.Flags().StringVar(&markImportFormat, "format", markFormatAuto, "Input format: auto, list, csv, json")
+	markImportCmd.Flags().BoolVar(&markImportReplace, "replace", false, "Replace existing marks instead of adding to them")
+	markImportCmd.Flags().BoolVar(&markImportForce, "force", false, "Allow --replace to clear every mark when the file has no valid entries")
+	markImportCmd.Flags().BoolVar(&markImportDryRun, "dry-run", false, "Report what would be marked without saving")
+	markClearCmd.Flags().BoolVar(&forceClearMarks, "force", false, "Skip confirmation prompt")
+	markExportCmd.Flags().StringVar(&markExportOutput, "output", export.Stdout, "Output file path, or - for stdout")
+
+	markCmd.AddCommand(markImportCmd)
+	markCmd.AddCommand(markListCmd)
+	markCmd.AddCommand(markClearCmd)
+	markCmd.AddCommand(markExportCmd)
+}
+
+// detectMarkFormat picks an import format from the file extension, falling
+// back to sniffing the content.
+func detectMarkFormat(filename string, content []byte) string {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".json":
+		return markFormatJSON
+	case ".csv":
+		return markFormatCSV
+	}
+
+	trimmed := bytes.TrimSpace(content)
+	if len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') {
+		return markFormatJSON
+	}
+	return markFormatList
+}
+
+// parseMarkRefs reads repository references from r in the given format.
+// References are returned as written; resolveMarkRefs normalizes them.
+func parseMarkRefs(r io.Reader, format string) ([]string, error) {
+	switch format {
+	case markFormatList:
+		return parseMarkList(r)
+	case markFormatCSV:
+		return parseMarkCSV(r)
+	case markFormatJSON:
+		return parseMarkJSON(r)
+	}
+	return nil, fmt.Errorf("unknown format %q: must be one of auto, list, csv, json", format)
+}
+
+// parseMarkList reads one reference per line, skipping blanks and # comments.
+func parseMarkList(r io.Reader) ([]string, error) {
+	var refs []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		refs = append(refs, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+// markCSVColumns are the header names recognized as repository columns, in
+// order of preference.
+var markCSVColumns = []string{"full_name", "name", "repository", "repo"}
+
+// parseMarkCSV reads references from the first recognized column of a CSV file.
+// Files without a recognized header use the first column of every row.
+func parseMarkCSV(r io.Reader) ([]string, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	col, start := 0, 0
+	header := make(map[string]int, len(records[0]))
+	for i, h := range records[0] {
+		header[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	for _, name := range markCSVColumns {
+		if i, ok := header[name]; ok {
+			col, start = i, 1
+			break
+		}
+	}
+
+	var refs []string
+	for _, record := range records[start:] {
+		if col < len(record) && strings.TrimSpace(record[col]) != "" {
+			refs = append(refs, strings.TrimSpace(record[col]))
+		}
+	}
+	return refs, nil
+}
+
+// parseMarkJSON reads references from repjan export JSON or a JSON array of names.
+func parseMarkJSON(r io.Reader) ([]string, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	if err := json.Unmarshal(content, &names); err == nil {
+		return names, nil
+	}
+
+	var data export.ExportData
+	if err := json.Unmarshal(content, &data); err != nil {
+		return nil, err
+	}
+	if data.Repositories == nil {
+		return nil, errors.New("no repositories field found")
+	}
+
+	refs := make([]string, 0, len(data.Repositories))
+	for _, repo := range data.Repositories {
+		if repo.FullName != "" {
+			refs = append(refs, repo.FullName)
+		} else if repo.Name != "" {
+			refs = append(refs, repo.Name)
+		}
+	}
+	return refs, nil
+}
+
+// markImportResult groups imported references by how they matched the store.
+type markImportResult struct {
+	Valid    []string // repository names to mark
+	Unknown  []string // references not found for the owner
+	Archived []string // repository names that are already archived
+}
+
+// resolveMarkRefs matches references against the owner's stored repositories.
+// References may be a bare name, owner/name or a GitHub URL; duplicates are
+// collapsed and names are matched case-insensitively.
+func resolveMarkRefs(refs []string, owner string, repos []github.Repository) markImportResult {
+	byName := make(map[string]github.Repository, len(repos))
+	for _, repo := range repos {
+		byName[strings.ToLower(repo.Name)] = repo
+	}
+
+	var result markImportResult
+	seen := make(map[string]bool, len(refs))
+	for _, ref := range refs {
+		refOwner, name := splitRepoRef(ref)
+		if refOwner != "" && !strings.EqualFold(refOwner, owner) {
+			result.Unknown = append(result.Unknown, ref)
+			continue
+		}
+
+		repo, ok := byName[strings.ToLower(name)]
+		if !ok {
+			result.Unknown = append(result.Unknown, ref)
+			continue
+		}
+		if seen[repo.Name] {
+			continue
+		}
+		seen[repo.Name] = true
+
+		if repo.IsArchived {
+			result.Archived = append(result.Archived, repo.Name)
+			continue
+		}
+		result.Valid = append(result.Valid, repo.Name)
+	}
+	return result
+}
+
+// splitRepoRef splits a reference such as "name", "owner/name" or
+// "https://github.com/owner/name.git" into owner and name.
+func splitRepoRef(ref string) (owner, name string) {
+	ref = strings.TrimSpace(ref)
+	for _, prefix := range []string{"https://github.com/", "http://github.com/", "github.com/"} {
+		ref = strings.TrimPrefix(ref, prefix)
+	}
+	ref = strings.TrimSuffix(strings.TrimSuffix(ref, "/"), ".git")
+
+	if i := strings.LastIndex(ref, "/"); i >= 0 {
+		return ref[:i], ref[i+1:]
+	}
+	return "", ref
+}
+
+// newMarks returns the names in imported that are not already marked.
+func newMarks(existing, imported []string) []string {
+	marked := make(map[string]bool, len(existing))
+	for _, name := range existing {
+		marked[name] = true
+	}
+
+	var added []string
+	for _, name := range imported {
+		if !marked[name] {
+			added = append(added, name)
+		}
+	}
+	return added
+}
+
+// recordUnmarks records the removal of marks in the change history.
+func recordUnmarks(s *store.Store, owner string, names []string, note string) {
+	for _, name := range names {
+		if err := s.RecordRepoChange(owner, name, "unmarked", "user", nil, nil, note); err != nil {
+			slog.Warn("failed to record unmark", "component", "cmd", "repo", name, "error", err)
+		}
+	}
+}
+
+// applyMarks saves imported marks, either replacing or merging with existing marks.
+func applyMarks(s *store.Store, owner string, imported []string, replace bool) error {
+	if replace {
+		return s.SaveMarkedRepos(owner, imported)
+	}
+
+	for _, name := range imported {
+		if err := s.AddMarkedRepo(owner, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeMarkList writes marks as a commented plain list of owner/name lines.
+func writeMarkList(w io.Writer, owner string, names []string, exportedAt time.Time) {
+	fmt.Fprintf(w, "# repjan marks for %s, exported %s\n", owner, exportedAt.UTC().Format(time.RFC3339))
+	for _, name := range names {
+		fmt.Fprintf(w, "%s/%s\n", owner, name)
+	}
+}
@@ -0,0 +1,189 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/export"
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+func TestDetectMarkFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		content  string
+		want     string
+	}{
+		{"json extension", "marks.json", "", markFormatJSON},
+		{"csv extension", "marks.CSV", "", markFormatCSV},
+		{"json object content", "-", `  {"repositories": []}`, markFormatJSON},
+		{"json array content", "marks", `["a"]`, markFormatJSON},
+		{"plain list", "marks.txt", "repo1\nrepo2\n", markFormatList},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, detectMarkFormat(tt.filename, []byte(tt.content)))
+		})
+	}
+}
+
+func TestParseMarkRefs(t *testing.T) {
+	exported := jsonExport(t)
+
+	tests := []struct {
+		name    string
+		format  string
+		content string
+		want    []string
+	}{
+		{
+			name:    "plain list skips blanks and comments",
+			format:  markFormatList,
+			content: "# marks\nrepo1\n\n  owner/repo2  \n",
+			want:    []string{"repo1", "owner/repo2"},
+		},
+		{
+			name:    "csv with full_name column",
+			format:  markFormatCSV,
+			content: "name,full_name\nrepo1,owner/repo1\nrepo2,owner/repo2\n",
+			want:    []string{"owner/repo1", "owner/repo2"},
+		},
+		{
+			name:    "csv without header uses first column",
+			format:  markFormatCSV,
+			content: "repo1,x\nrepo2,y\n",
+			want:    []string{"repo1", "repo2"},
+		},
+		{
+			name:    "json array",
+			format:  markFormatJSON,
+			content: `["repo1", "owner/repo2"]`,
+			want:    []string{"repo1", "owner/repo2"},
+		},
+		{
+			name:    "repjan export json",
+			format:  markFormatJSON,
+			content: exported,
+			want:    []string{"testowner/exported"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseMarkRefs(strings.NewReader(tt.content), tt.format)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := parseMarkRefs(strings.NewReader(`{"owner": "x"}`), markFormatJSON)
+	require.Error(t, err)
+
+	_, err = parseMarkRefs(strings.NewReader(""), "xml")
+	require.Error(t, err)
+}
+
+// jsonExport renders a one-repo repjan JSON export.
+func jsonExport(t *testing.T) string {
+	t.Helper()
+	e, err := export.NewExporter("json")
+	require.NoError(t, err)
+
+	var b strings.Builder
+	repo := testutil.NewTestRepo(testutil.WithOwner("testowner"), testutil.WithName("exported"))
+	require.NoError(t, e.Write(&b, export.BuildExportData([]github.Repository{repo}, "testowner", nil)))
+	return b.String()
+}
+
+func TestSplitRepoRef(t *testing.T) {
+	tests := []struct {
+		ref       string
+		wantOwner string
+		wantName  string
+	}{
+		{"repo", "", "repo"},
+		{"owner/repo", "owner", "repo"},
+		{"https://github.com/owner/repo", "owner", "repo"},
+		{"https://github.com/owner/repo.git", "owner", "repo"},
+		{"github.com/owner/repo/", "owner", "repo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			gotOwner, gotName := splitRepoRef(tt.ref)
+			assert.Equal(t, tt.wantOwner, gotOwner)
+			assert.Equal(t, tt.wantName, gotName)
+		})
+	}
+}
+
+func TestResolveMarkRefs(t *testing.T) {
+	repos := []github.Repository{
+		testutil.NewTestRepo(testutil.WithOwner("me"), testutil.WithName("Alpha")),
+		testutil.NewTestRepo(testutil.WithOwner("me"), testutil.WithName("beta")),
+		testutil.NewTestRepo(testutil.WithOwner("me"), testutil.WithName("old"), testutil.WithArchived(true)),
+	}
+
+	refs := []string{"alpha", "me/Alpha", "https://github.com/me/beta", "old", "missing", "someone/beta"}
+	result := resolveMarkRefs(refs, "me", repos)
+
+	assert.Equal(t, []string{"Alpha", "beta"}, result.Valid)
+	assert.Equal(t, []string{"old"}, result.Archived)
+	assert.Equal(t, []string{"missing", "someone/beta"}, result.Unknown)
+}
+
+func TestApplyMarks(t *testing.T) {
+	s := testutil.NewStore(t)
+	require.NoError(t, s.SaveMarkedRepos("me", []string{"existing"}))
+
+	require.NoError(t, applyMarks(s, "me", []string{"new1", "existing"}, false))
+	names, err := s.GetMarkedRepos("me")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"existing", "new1"}, names)
+
+	require.NoError(t, applyMarks(s, "me", []string{"new2"}, true))
+	names, err = s.GetMarkedRepos("me")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"new2"}, names)
+}
+
+func TestNewMarks(t *testing.T) {
+	assert.Equal(t, []string{"b"}, newMarks([]string{"a"}, []string{"a", "b"}))
+	assert.Nil(t, newMarks([]string{"a"}, []string{"a"}))
+	// Swapped, it lists the marks a replacing import drops
+	assert.Equal(t, []string{"c"}, newMarks([]string{"a"}, []string{"a", "c"}))
+}
+
+func TestRecordUnmarks(t *testing.T) {
+	s := testutil.NewStore(t)
+
+	recordUnmarks(s, "me", []string{"a", "b"}, "mark clear")
+
+	changes, err := s.GetChangesByAction("me", "unmarked", 10)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	for _, c := range changes {
+		assert.Equal(t, "mark clear", c.Notes)
+	}
+}
+
+func TestWriteMarkList_RoundTrips(t *testing.T) {
+	var b strings.Builder
+	writeMarkList(&b, "me", []string{"alpha", "beta"}, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+
+	assert.Equal(t, "# repjan marks for me, exported 2026-01-02T03:04:05Z\nme/alpha\nme/beta\n", b.String())
+
+	refs, err := parseMarkRefs(strings.NewReader(b.String()), markFormatList)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"me/alpha", "me/beta"}, refs)
+}
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cmd
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/mcp"
+)
+
+var mcpAllowWrites bool
+
+var mcpCmd = &cobra.Command{
+	Use:   "mcp",
+	Short: "Run a Model Context Protocol server over stdio",
+	Long: `Run a Model Context Protocol (JSON-RPC over stdio) server so automation
+agents can query the repository inventory in the local database.
+
+Tools: list_repositories, get_repository, explain_candidate, plan_archive,
+and, with --allow-writes, mark and unmark. Nothing is ever archived through
+this server. Logs go to stderr; stdout carries only protocol messages.
+
+Run 'repjan sync' first to populate the database.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		targetOwner, err := resolveOwner(github.NewDefaultClient())
+		if err != nil {
+			return err
+		}
+
+		repoStore, closeStore, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer closeStore()
+
+		slog.Info("starting mcp server", "component", "cmd", "owner", targetOwner, "allow_writes", mcpAllowWrites)
+		return mcp.New(repoStore, targetOwner, Version, mcpAllowWrites).Serve(os.Stdin, os.Stdout)
+	},
+}
+
+func init() {
+	mcpCmd.Flags().BoolVar(&mcpAllowWrites, "allow-writes", false, "Enable the mark and unmark tools")
+}
@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/llbbl/repjan/internal/analyze"
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+// topFeatureCount is how many features the model commands list.
+const topFeatureCount = 5
+
+var modelHoldout int
+
+var modelCmd = &cobra.Command{
+	Use:   "model",
+	Short: "Train and evaluate the archive prediction model",
+	Long: `Learn from past archive decisions to predict which repositories will be archived.
+
+The model is a logistic regression over repository features (inactivity,
+age, stars, forks, fork and legacy-language flags, ...). Training examples
+come from the change history: repos archived through repjan are archive
+examples, and repos unarchived, unmarked or simply left active are keep
+examples. Predictions appear as a column in the TUI once a model is trained.`,
+}
+
+var modelTrainCmd = &cobra.Command{
+	Use:   "train",
+	Short: "Train the model on past archive decisions",
+	Long: `Train the archive prediction model and store it in the database.
+
+About --holdout percent of repositories are held out of training and used
+to report accuracy; the split is stable across runs.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if modelHoldout < 0 || modelHoldout > 50 {
+			return fmt.Errorf("invalid --holdout %d: must be between 0 and 50", modelHoldout)
+		}
+
+		targetOwner, repoStore, closeStore, err := openModelStore()
+		if err != nil {
+			return err
+		}
+		defer closeStore()
+
+		examples, err := loadExamples(repoStore, targetOwner)
+		if err != nil {
+			return err
+		}
+		train, test := analyze.SplitExamples(examples, modelHoldout)
+
+		classifier, err := analyze.TrainClassifier(train)
+		if err != nil {
+			return err
+		}
+
+		var accuracy *float64
+		fmt.Printf("Trained on %d repos (%d held out)\n", len(train), len(test))
+		if len(test) > 0 {
+			eval := classifier.Evaluate(test)
+			acc := eval.Accuracy()
+			accuracy = &acc
+			printEvaluation(os.Stdout, eval)
+		}
+		printImportance(os.Stdout, classifier)
+
+		if err := analyze.SaveClassifier(repoStore, targetOwner, classifier, len(train), accuracy); err != nil {
+			return err
+		}
+		fmt.Println("Model saved; predictions appear in the TUI")
+		return nil
+	},
+}
+
+var modelEvalCmd = &cobra.Command{
+	Use:   "eval",
+	Short: "Evaluate the stored model on held-out decisions",
+	Long: `Evaluate the stored model against the held-out repositories' current
+decisions, including decisions made since the model was trained.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		targetOwner, repoStore, closeStore, err := openModelStore()
+		if err != nil {
+			return err
+		}
+		defer closeStore()
+
+		classifier, meta, err := analyze.LoadClassifier(repoStore, targetOwner)
+		if errors.Is(err, store.ErrNotFound) {
+			return fmt.Errorf("no model trained for %s; run 'repjan model train' first", targetOwner)
+		}
+		if err != nil {
+			return err
+		}
+
+		examples, err := loadExamples(repoStore, targetOwner)
+		if err != nil {
+			return err
+		}
+		_, test := analyze.SplitExamples(examples, modelHoldout)
+
+		fmt.Printf("Model trained %s on %d repos\n", meta.TrainedAt.Format("2006-01-02 15:04"), meta.Examples)
+		if len(test) == 0 {
+			fmt.Println("No held-out repos to evaluate")
+		} else {
+			printEvaluation(os.Stdout, classifier.Evaluate(test))
+		}
+		printImportance(os.Stdout, classifier)
+		return nil
+	},
+}
+
+func init() {
+	modelCmd.PersistentFlags().IntVar(&modelHoldout, "holdout", 20, "Percent of repos held out for evaluation")
+
+	modelCmd.AddCommand(modelTrainCmd)
+	modelCmd.AddCommand(modelEvalCmd)
+}
+
+// openModelStore resolves the owner and opens the store for the model commands.
+func openModelStore() (string, *store.Store, func(), error) {
+	targetOwner, err := resolveOwner(github.NewDefaultClient())
+	if err != nil {
+		return "", nil, nil, err
+	}
+	repoStore, closeStore, err := openStore()
+	if err != nil {
+		return "", nil, nil, err
+	}
+	return targetOwner, repoStore, closeStore, nil
+}
+
+// loadExamples builds labeled examples from the stored repos and history.
+func loadExamples(s *store.Store, owner string) ([]analyze.Example, error) {
+	repos, err := s.GetRepositories(owner)
+	if err != nil {
+		return nil, fmt.Errorf("loading repositories: %w", err)
+	}
+	changes, err := s.GetChangesByActions(owner, analyze.DecisionActions)
+	if err != nil {
+		return nil, fmt.Errorf("loading change history: %w", err)
+	}
+	marked, err := s.GetMarkedRepos(owner)
+	if err != nil {
+		return nil, fmt.Errorf("loading marked repos: %w", err)
+	}
+	return analyze.TrainingExamples(repos, changes, marked, time.Now()), nil
+}
+
+// printEvaluation writes held-out metrics and the confusion matrix.
+func printEvaluation(w io.Writer, e analyze.Evaluation) {
+	fmt.Fprintf(w, "Held-out accuracy: %.1f%% on %d repos (precision %.1f%%, recall %.1f%%)\n",
+		e.Accuracy()*100, e.Total(), e.Precision()*100, e.Recall()*100)
+	fmt.Fprintf(w, "  predicted archive: %d archived, %d kept\n", e.TruePositives, e.FalsePositives)
+	fmt.Fprintf(w, "  predicted keep:    %d archived, %d kept\n", e.FalseNegatives, e.TrueNegatives)
+}
+
+// printImportance writes the features with the largest learned weights.
+func printImportance(w io.Writer, c *analyze.Classifier) {
+	fmt.Fprintln(w, "Top features (positive favors archiving):")
+	importance := c.Importance()
+	for _, f := range importance[:min(topFeatureCount, len(importance))] {
+		fmt.Fprintf(w, "  %s\n", f)
+	}
+}
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/llbbl/repjan/internal/analyze"
+	"github.com/llbbl/repjan/internal/github"
+)
+
+var (
+	readmeRefresh  bool
+	readmeArchived bool
+)
+
+var readmeCmd = &cobra.Command{
+	Use:   "readme",
+	Short: "Fetch and analyze repository READMEs",
+	Long: `Fetch, cache and analyze the README of every stored repository.
+
+READMEs are scanned offline for archive signals: deprecation notices,
+"no longer maintained" statements, "moved to <url>" notices, and missing,
+empty or boilerplate READMEs. Cached READMEs are reused for a week unless
+--refresh is given; the TUI uses the cache to include these signals in its
+archive analysis.
+
+Run 'repjan sync' first to populate the database.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client := github.NewDefaultClient()
+		targetOwner, err := resolveOwner(client)
+		if err != nil {
+			return err
+		}
+
+		repoStore, closeStore, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer closeStore()
+
+		repos, err := repoStore.GetRepositories(targetOwner)
+		if err != nil {
+			return fmt.Errorf("loading repositories: %w", err)
+		}
+
+		analyzer := analyze.NewReadmeAnalyzer(client, repoStore)
+		checked, flagged, failed := 0, 0, 0
+		for _, repo := range repos {
+			if repo.IsArchived && !readmeArchived {
+				continue
+			}
+
+			signals, found, err := analyzer.Analyze(repo, readmeRefresh)
+			if err != nil {
+				slog.Warn("failed to analyze readme", "component", "cmd", "repo", repo.FullName(), "error", err)
+				failed++
+				continue
+			}
+			if !found {
+				continue
+			}
+			checked++
+
+			if line := readmeScanLine(repo, signals); line != "" {
+				flagged++
+				fmt.Println(line)
+			}
+		}
+
+		fmt.Printf("Checked %d README(s): %d with archive signals, %d failed\n", checked, flagged, failed)
+		return nil
+	},
+}
+
+func init() {
+	readmeCmd.Flags().BoolVar(&readmeRefresh, "refresh", false, "Re-fetch READMEs even when cached")
+	readmeCmd.Flags().BoolVar(&readmeArchived, "archived", false, "Include archived repositories")
+}
+
+// readmeScanLine formats the README findings for repo as one line, or
+// returns "" when no README reason applies.
+func readmeScanLine(repo github.Repository, signals analyze.ReadmeSignals) string {
+	reasons := analyze.ReadmeReasons(repo, signals)
+	if len(reasons) == 0 {
+		return ""
+	}
+
+	codes := make([]string, len(reasons))
+	for i, r := range reasons {
+		codes[i] = string(r.Code)
+	}
+	line := fmt.Sprintf("%s\t%s", repo.FullName(), strings.Join(codes, ","))
+
+	quoted := make([]string, len(signals.Phrases))
+	for i, p := range signals.Phrases {
+		quoted[i] = fmt.Sprintf("%q", p)
+	}
+	if len(quoted) > 0 {
+		line += "\t" + strings.Join(quoted, "; ")
+	}
+	return line
+}
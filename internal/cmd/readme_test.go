@@ -0,0 +1,26 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/llbbl/repjan/internal/analyze"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+func TestReadmeScanLine(t *testing.T) {
+	repo := testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("widget"), testutil.WithDaysInactive(10))
+
+	assert.Empty(t, readmeScanLine(repo, analyze.ReadmeSignals{}))
+	// A missing README only counts once the repo has gone quiet
+	assert.Empty(t, readmeScanLine(repo, analyze.ReadmeSignals{Missing: true}))
+
+	signals := analyze.AnalyzeReadme("This project is deprecated and no longer maintained.")
+	assert.Equal(t,
+		"acme/widget\treadme_deprecated,readme_unmaintained\t\"This project is deprecated\"; \"no longer maintained\"",
+		readmeScanLine(repo, signals))
+}
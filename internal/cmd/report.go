@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/llbbl/repjan/internal/analyze"
+	"github.com/llbbl/repjan/internal/export"
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/report"
+)
+
+// reportHistoryLimit caps the change and sync records loaded for a report.
+const reportHistoryLimit = 10000
+
+var (
+	reportFormat string
+	reportOutput string
+	reportSince  string
+)
+
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Generate a portfolio health report",
+	Long: `Generate a Markdown or HTML portfolio report from the local database.
+
+The report covers repository counts by status, language distribution, an age
+histogram, archive candidates grouped by reason, repositories archived during
+the period and the repository count trend from sync history.
+
+--since takes a number of days, weeks, months or years (90d, 12w, 3m, 1y) or a
+date (2026-01-01). Run 'repjan sync' first to populate the database.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		now := time.Now()
+		since, err := parseSince(reportSince, now)
+		if err != nil {
+			return err
+		}
+
+		targetOwner, err := resolveOwner(github.NewDefaultClient())
+		if err != nil {
+			return err
+		}
+
+		repoStore, closeStore, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer closeStore()
+
+		repos, err := repoStore.GetRepositories(targetOwner)
+		if err != nil {
+			return fmt.Errorf("loading repositories: %w", err)
+		}
+		changes, err := repoStore.GetChangesByAction(targetOwner, "archived", reportHistoryLimit)
+		if err != nil {
+			return fmt.Errorf("loading archive history: %w", err)
+		}
+		syncs, err := repoStore.GetSyncHistory(targetOwner, reportHistoryLimit)
+		if err != nil {
+			return fmt.Errorf("loading sync history: %w", err)
+		}
+		assessor, err := analyze.LoadAssessor(repoStore, targetOwner)
+		if err != nil {
+			return fmt.Errorf("loading archive signals: %w", err)
+		}
+
+		slog.Debug("building report", "component", "cmd", "owner", targetOwner, "since", since, "repos", len(repos))
+		r := report.Build(targetOwner, repos, assessor, changes, syncs, since, now)
+
+		var b strings.Builder
+		if err := r.Write(&b, reportFormat); err != nil {
+			return err
+		}
+
+		if reportOutput == export.Stdout {
+			fmt.Print(b.String())
+			return nil
+		}
+
+		path := reportOutput
+		if path == "" {
+			path = fmt.Sprintf("portfolio-report-%s.%s", now.Format("2006-01-02"), report.Extension(reportFormat))
+		}
+		if err := os.WriteFile(path, []byte(b.String()), 0644); err != nil {
+			return fmt.Errorf("failed to write file: %w", err)
+		}
+		fmt.Printf("Wrote report to %s\n", path)
+		return nil
+	},
+}
+
+func init() {
+	reportCmd.Flags().StringVar(&reportFormat, "format", report.FormatMarkdown, "Report format: markdown, html")
+	reportCmd.Flags().StringVar(&reportOutput, "output", "", "Output file path, or - for stdout (default: dated file)")
+	reportCmd.Flags().StringVar(&reportSince, "since", "90d", "Start of the reporting period: 90d, 12w, 3m, 1y or YYYY-MM-DD")
+}
+
+// parseSince parses a --since value relative to now.
+func parseSince(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if t, err := time.ParseInLocation("2006-01-02", value, now.Location()); err == nil {
+		return t, nil
+	}
+
+	if len(value) >= 2 {
+		n, err := strconv.Atoi(value[:len(value)-1])
+		if err == nil && n >= 0 {
+			switch value[len(value)-1] {
+			case 'd':
+				return now.AddDate(0, 0, -n), nil
+			case 'w':
+				return now.AddDate(0, 0, -7*n), nil
+			case 'm':
+				return now.AddDate(0, -n, 0), nil
+			case 'y':
+				return now.AddDate(-n, 0, 0), nil
+			}
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid --since %q: use a period such as 90d, 12w, 3m, 1y or a date YYYY-MM-DD", value)
+}
@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2026, 4, 15, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value string
+		want  time.Time
+	}{
+		{"90d", now.AddDate(0, 0, -90)},
+		{"2w", now.AddDate(0, 0, -14)},
+		{"3m", now.AddDate(0, -3, 0)},
+		{"1y", now.AddDate(-1, 0, 0)},
+		{"2026-01-01", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseSince(tt.value, now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	for _, bad := range []string{"", "d", "ten days", "-5d", "5h"} {
+		t.Run("invalid "+bad, func(t *testing.T) {
+			_, err := parseSince(bad, now)
+			assert.Error(t, err)
+		})
+	}
+}
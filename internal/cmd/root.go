@@ -11,6 +11,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
 
+	"github.com/llbbl/repjan/internal/analyze"
 	"github.com/llbbl/repjan/internal/config"
 	"github.com/llbbl/repjan/internal/db"
 	"github.com/llbbl/repjan/internal/github"
@@ -18,6 +19,7 @@ import (
 	"github.com/llbbl/repjan/internal/store"
 	"github.com/llbbl/repjan/internal/sync"
 	"github.com/llbbl/repjan/internal/tui"
+	"github.com/llbbl/repjan/internal/workflow"
 )
 
 // Version is set at build time with -ldflags
@@ -34,6 +36,8 @@ var (
 	syncInterval time.Duration
 	logLevel     string
 	logFormat    string
+	batchWorkers int
+	aiBackend    string
 )
 
 var rootCmd = &cobra.Command{
@@ -48,6 +52,12 @@ It helps identify inactive repos and batch archive them.`,
 			effectiveSyncInterval = syncInterval
 		}
 
+		// Resolve effective batch worker count (CLI flag overrides config)
+		effectiveBatchWorkers := cfg.BatchWorkers
+		if batchWorkers > 0 {
+			effectiveBatchWorkers = batchWorkers
+		}
+
 		// Create GitHub client
 		client := github.NewDefaultClient()
 
@@ -91,6 +101,7 @@ It helps identify inactive repos and batch archive them.`,
 		var repos []github.Repository
 		var lastSyncTime time.Time
 		var usingCache bool
+		var activityErr error
 
 		lastSyncTime, _ = repoStore.GetLastSyncTime(targetOwner)
 		cachedRepos, cacheErr := repoStore.GetRepositories(targetOwner)
@@ -117,6 +128,9 @@ It helps identify inactive repos and batch archive them.`,
 				repos = freshRepos
 				lastSyncTime = time.Now()
 				slog.Info("found repositories", "count", len(repos))
+				if activityErr = client.AddIssueActivity(targetOwner, repos); activityErr != nil {
+					slog.Warn("failed to fetch issue activity", "error", activityErr)
+				}
 
 				// Upsert fresh repos to database
 				if err := repoStore.UpsertRepositories(targetOwner, repos); err != nil {
@@ -132,6 +146,44 @@ It helps identify inactive repos and batch archive them.`,
 
 		// Initialize TUI model with store and sync channel
 		model := tui.NewModelWithOptions(repos, targetOwner, client, repoStore, fabric, fabricPath, lastSyncTime, usingCache, syncCh)
+		model.SetBatchWorkers(effectiveBatchWorkers)
+		if activityErr != nil {
+			model.SetStatusMessage(fmt.Sprintf("Issue activity not updated: %v", activityErr))
+		}
+		model.SetArchiveGraceDays(cfg.ArchiveGraceDays)
+		model.SetGraveyard(cfg.GraveyardOwner, cfg.GraveyardArchive)
+		backer, err := newBacker(client)
+		if err != nil {
+			return err
+		}
+		model.SetBackup(backer)
+		// Deleting is opt-in and always backs repos up first
+		model.SetDeletion(cfg.AllowDelete)
+
+		// Run archive batches through the configured workflow
+		if cfg.ArchiveWorkflow != "" || cfg.BackupOnArchive {
+			steps, err := workflow.Parse(cfg.ArchiveWorkflow)
+			if err != nil {
+				return fmt.Errorf("invalid REPJAN_ARCHIVE_WORKFLOW: %w", err)
+			}
+			if cfg.BackupOnArchive {
+				steps = workflow.WithBackup(steps)
+			}
+			model.SetArchiveWorkflow(steps)
+		}
+
+		// Enable AI analysis: fabric needs --fabric, other backends only configuration
+		analyzer, err := newAnalyzer()
+		if err != nil {
+			return err
+		}
+		switch {
+		case analyzer.Name() != analyze.BackendFabric:
+			model.SetAnalyzer(analyzer)
+		case fabric && !analyze.CheckFabricAvailable(fabricPath):
+			slog.Warn("fabric not found, AI analysis disabled", "component", "cmd", "path", fabricPath)
+			model.SetAnalyzer(nil)
+		}
 
 		// Load marked repos from database
 		if err := model.LoadMarkedRepos(); err != nil {
@@ -167,11 +219,30 @@ func init() {
 	rootCmd.PersistentFlags().DurationVar(&syncInterval, "sync-interval", 0, "Interval for background repository sync (overrides env)")
 	rootCmd.PersistentFlags().StringVar(&logLevel, "log-level", "", "Log level: debug, info, warn, error (overrides env)")
 	rootCmd.PersistentFlags().StringVar(&logFormat, "log-format", "", "Log format: text, json (overrides env)")
+	rootCmd.PersistentFlags().IntVar(&batchWorkers, "batch-workers", 0, "Concurrent workers for batch operations (overrides env)")
+	rootCmd.PersistentFlags().StringVar(&aiBackend, "ai-backend", "", "AI backend: fabric, ollama, openai (overrides env)")
 
 	// Add subcommands
 	rootCmd.AddCommand(versionCmd)
 	rootCmd.AddCommand(dbCmd)
 	rootCmd.AddCommand(syncCmd)
+	rootCmd.AddCommand(exportCmd)
+	rootCmd.AddCommand(markCmd)
+	rootCmd.AddCommand(reportCmd)
+	rootCmd.AddCommand(serveCmd)
+	rootCmd.AddCommand(mcpCmd)
+	rootCmd.AddCommand(readmeCmd)
+	rootCmd.AddCommand(analyzeCmd)
+	rootCmd.AddCommand(modelCmd)
+	rootCmd.AddCommand(activityCmd)
+	rootCmd.AddCommand(applyDueCmd)
+	rootCmd.AddCommand(transferCmd)
+	rootCmd.AddCommand(deleteCmd)
+	rootCmd.AddCommand(backupCmd)
+	rootCmd.AddCommand(visibilityCmd)
+	rootCmd.AddCommand(topicCmd)
+	rootCmd.AddCommand(descriptionCmd)
+	rootCmd.AddCommand(actionsCmd)
 }
 
 // Execute runs the root command.
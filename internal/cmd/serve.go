@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/server"
+)
+
+// shutdownTimeout is how long the server waits for requests to finish on exit.
+const shutdownTimeout = 5 * time.Second
+
+var serveAddr string
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve a local web dashboard and JSON API",
+	Long: `Start a local HTTP server with a web dashboard for browsing and marking
+repositories, backed by the local database.
+
+The JSON API is read-only apart from marking:
+  GET    /api/repos              filter, language, q, sort, order, private, archived
+  GET    /api/repos/{name}       repository details and history
+  GET    /api/repos/{name}/history
+  GET    /api/languages
+  GET    /api/sync               last sync time and sync history
+  GET    /api/marks
+  PUT    /api/marks/{name}       mark a repository
+  DELETE /api/marks/{name}       unmark a repository
+  GET    /api/export             format, scope (marked or visible)
+
+Run 'repjan sync' first to populate the database.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		targetOwner, err := resolveOwner(github.NewDefaultClient())
+		if err != nil {
+			return err
+		}
+
+		repoStore, closeStore, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer closeStore()
+
+		httpServer := &http.Server{
+			Addr:              serveAddr,
+			Handler:           server.New(repoStore, targetOwner),
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		errCh := make(chan error, 1)
+		go func() {
+			slog.Info("starting server", "component", "cmd", "addr", serveAddr, "owner", targetOwner)
+			errCh <- httpServer.ListenAndServe()
+		}()
+		fmt.Printf("Serving %s at http://%s (Ctrl+C to stop)\n", targetOwner, serveAddr)
+
+		select {
+		case err := <-errCh:
+			if !errors.Is(err, http.ErrServerClosed) {
+				return fmt.Errorf("serving: %w", err)
+			}
+			return nil
+		case <-ctx.Done():
+		}
+
+		slog.Debug("shutting down server", "component", "cmd")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			return fmt.Errorf("shutting down server: %w", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	serveCmd.Flags().StringVar(&serveAddr, "addr", "127.0.0.1:8080", "Address to listen on")
+}
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/transfer"
+)
+
+var (
+	transferTo      string
+	transferArchive bool
+	transferDryRun  bool
+)
+
+var transferCmd = &cobra.Command{
+	Use:   "transfer [repo...]",
+	Short: "Transfer repositories to a graveyard organization",
+	Long: `Transfer repositories to a graveyard organization instead of archiving
+them in place. Without arguments the marked repositories are transferred.
+
+The target defaults to REPJAN_GRAVEYARD_OWNER, and --archive (or
+REPJAN_GRAVEYARD_ARCHIVE=true) archives each repository after its transfer.
+Transferred repositories keep their history in the local database under the
+new owner. A repository already present under the target is not transferred
+again, so an interrupted run can simply be repeated.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		target := transferTo
+		archive := transferArchive
+		if cfg != nil {
+			if target == "" {
+				target = cfg.GraveyardOwner
+			}
+			if !cmd.Flags().Changed("archive") {
+				archive = cfg.GraveyardArchive
+			}
+		}
+		if target == "" {
+			return errors.New("no target owner: pass --to or set REPJAN_GRAVEYARD_OWNER")
+		}
+
+		client := github.NewDefaultClient()
+		targetOwner, err := resolveOwner(client)
+		if err != nil {
+			return err
+		}
+
+		repoStore, closeStore, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer closeStore()
+
+		var repos []github.Repository
+		if len(args) == 0 {
+			repos, err = selectReposForScope(repoStore, targetOwner, scopeMarked)
+			if err != nil {
+				return err
+			}
+		}
+		for _, name := range args {
+			name = strings.TrimPrefix(name, targetOwner+"/")
+			repo, err := repoStore.GetRepository(targetOwner, name)
+			if err != nil {
+				return fmt.Errorf("loading %s/%s: %w", targetOwner, name, err)
+			}
+			repos = append(repos, *repo)
+		}
+		if len(repos) == 0 {
+			fmt.Printf("No repositories to transfer for %s\n", targetOwner)
+			return nil
+		}
+
+		suffix := ""
+		if archive {
+			suffix = " and archive"
+		}
+		if transferDryRun {
+			for _, repo := range repos {
+				fmt.Printf("would transfer%s\t%s -> %s/%s\n", suffix, repo.FullName(), target, repo.Name)
+			}
+			fmt.Printf("Dry run: would transfer %d repositories to %s\n", len(repos), target)
+			return nil
+		}
+
+		mover := transfer.New(client, repoStore)
+		var failed int
+		for _, repo := range repos {
+			result, err := mover.Transfer(repo, target, archive)
+			switch {
+			case err != nil:
+				failed++
+				fmt.Printf("failed\t%s\t%v\n", repo.FullName(), err)
+			case result.Transferred:
+				fmt.Printf("transferred%s\t%s -> %s/%s\n", suffix, repo.FullName(), target, repo.Name)
+			default:
+				fmt.Printf("already moved\t%s/%s\n", target, repo.Name)
+			}
+		}
+		fmt.Printf("%d transferred to %s, %d failed\n", len(repos)-failed, target, failed)
+		if failed > 0 {
+			return fmt.Errorf("%d transfers failed", failed)
+		}
+		return nil
+	},
+}
+
+func init() {
+	transferCmd.Flags().StringVar(&transferTo, "to", "", "Organization to transfer to (default REPJAN_GRAVEYARD_OWNER)")
+	transferCmd.Flags().BoolVar(&transferArchive, "archive", false, "Archive repositories after transferring them (default REPJAN_GRAVEYARD_ARCHIVE)")
+	transferCmd.Flags().BoolVar(&transferDryRun, "dry-run", false, "Show what would be transferred without changing anything")
+}
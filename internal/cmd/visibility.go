@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/visibility"
+)
+
+var visibilityDryRun bool
+
+var visibilityCmd = &cobra.Command{
+	Use:   "visibility <private|public> [repo...]",
+	Short: "Make repositories private or public",
+	Long: `Make repositories private or public. Without repository arguments the
+marked repositories are changed.
+
+Making a public repository private removes its stars and watchers and
+detaches its forks, so affected repositories are listed before anything
+changes. Every change is recorded in the repository's history.`,
+	Args:      cobra.MinimumNArgs(1),
+	ValidArgs: []string{"private", "public"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var private bool
+		switch args[0] {
+		case "private":
+			private = true
+		case "public":
+		default:
+			return fmt.Errorf("invalid visibility %q: must be private or public", args[0])
+		}
+		target := visibility.Name(private)
+
+		client := github.NewDefaultClient()
+		targetOwner, err := resolveOwner(client)
+		if err != nil {
+			return err
+		}
+
+		repoStore, closeStore, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer closeStore()
+
+		var repos []github.Repository
+		if len(args) == 1 {
+			repos, err = selectReposForScope(repoStore, targetOwner, scopeMarked)
+			if err != nil {
+				return err
+			}
+		}
+		for _, name := range args[1:] {
+			name = strings.TrimPrefix(name, targetOwner+"/")
+			repo, err := repoStore.GetRepository(targetOwner, name)
+			if err != nil {
+				return fmt.Errorf("loading %s/%s: %w", targetOwner, name, err)
+			}
+			repos = append(repos, *repo)
+		}
+		if len(repos) == 0 {
+			fmt.Printf("No repositories to make %s for %s\n", target, targetOwner)
+			return nil
+		}
+
+		if affected := visibility.Affected(repos, private); len(affected) > 0 {
+			fmt.Println("Warning: these repositories lose their stars and watchers, and their forks are detached:")
+			for _, repo := range affected {
+				fmt.Printf("  %s\t%s\n", repo.FullName(), visibility.Impact(repo))
+			}
+		}
+
+		if visibilityDryRun {
+			var pending int
+			for _, repo := range repos {
+				if repo.IsPrivate == private {
+					fmt.Printf("already %s\t%s\n", target, repo.FullName())
+					continue
+				}
+				pending++
+				fmt.Printf("would make %s\t%s\n", target, repo.FullName())
+			}
+			fmt.Printf("Dry run: would make %d repositories %s\n", pending, target)
+			return nil
+		}
+
+		changer := visibility.New(client, repoStore)
+		var changed, failed int
+		for _, repo := range repos {
+			ok, err := changer.Set(repo, private)
+			switch {
+			case err != nil:
+				failed++
+				fmt.Printf("failed\t%s\t%v\n", repo.FullName(), err)
+			case ok:
+				changed++
+				fmt.Printf("made %s\t%s\n", target, repo.FullName())
+			default:
+				fmt.Printf("already %s\t%s\n", target, repo.FullName())
+			}
+		}
+		fmt.Printf("%d made %s, %d failed\n", changed, target, failed)
+		if failed > 0 {
+			return fmt.Errorf("%d visibility changes failed", failed)
+		}
+		return nil
+	},
+}
+
+func init() {
+	visibilityCmd.Flags().BoolVar(&visibilityDryRun, "dry-run", false, "Show what would change without changing anything")
+}
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"os"
 	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -19,6 +21,27 @@ type Config struct {
 	LogFormat    string        // text, json (default: text)
 	SyncInterval time.Duration // default: 5m
 	DBPath       string        // default: ~/.repjan/repjan.db (empty means use default)
+	BatchWorkers int           // concurrent workers for batch operations (default: 4)
+
+	AIBackend     string        // fabric, ollama, openai (default: fabric)
+	AIURL         string        // base URL for the ollama or openai backend (empty means backend default)
+	AIModel       string        // model name for the ollama or openai backend
+	AIAPIKey      string        // API key for the openai backend
+	AITimeout     time.Duration // timeout for a single AI request (default: 60s)
+	AITokenBudget int           // approximate token budget per AI prompt (default: 1500)
+
+	BotPatterns []string // extra commit authors treated as bots, '*' wildcards allowed
+
+	ArchiveWorkflow  string // comma-separated archive workflow steps (empty means archive only)
+	ArchiveGraceDays int    // days between a scheduled archive's notice and the archive (default: 30)
+
+	GraveyardOwner   string // organization inactive repos are transferred to (empty disables transfers)
+	GraveyardArchive bool   // archive repos after transferring them (default: false)
+
+	AllowDelete     bool   // enable deleting repositories (default: false)
+	BackupDir       string // directory for repository backups (empty means ~/.repjan/backups)
+	BackupFormat    string // mirror, bundle (default: mirror)
+	BackupOnArchive bool   // back repositories up before archiving them (default: false)
 }
 
 // validLogLevels contains the allowed log level values.
@@ -27,6 +50,12 @@ var validLogLevels = []string{"debug", "info", "warn", "error"}
 // validLogFormats contains the allowed log format values.
 var validLogFormats = []string{"text", "json"}
 
+// validAIBackends contains the allowed AI backend values.
+var validAIBackends = []string{"fabric", "ollama", "openai"}
+
+// validBackupFormats contains the allowed backup format values.
+var validBackupFormats = []string{"mirror", "bundle"}
+
 // Load reads configuration from environment variables, with .env file as optional override.
 // The .env file is loaded if present but errors are ignored if it doesn't exist.
 func Load() (*Config, error) {
@@ -35,10 +64,27 @@ func Load() (*Config, error) {
 
 	// Read from env vars with defaults
 	cfg := &Config{
-		LogLevel:     getEnv("REPJAN_LOG_LEVEL", "info"),
-		LogFormat:    getEnv("REPJAN_LOG_FORMAT", "text"),
-		SyncInterval: getDurationEnv("REPJAN_SYNC_INTERVAL", 5*time.Minute),
-		DBPath:       getEnv("REPJAN_DB_PATH", ""),
+		LogLevel:      getEnv("REPJAN_LOG_LEVEL", "info"),
+		LogFormat:     getEnv("REPJAN_LOG_FORMAT", "text"),
+		SyncInterval:  getDurationEnv("REPJAN_SYNC_INTERVAL", 5*time.Minute),
+		DBPath:        getEnv("REPJAN_DB_PATH", ""),
+		BatchWorkers:  getIntEnv("REPJAN_BATCH_WORKERS", 4),
+		AIBackend:     getEnv("REPJAN_AI_BACKEND", "fabric"),
+		AIURL:         getEnv("REPJAN_AI_URL", ""),
+		AIModel:       getEnv("REPJAN_AI_MODEL", ""),
+		AIAPIKey:      getEnv("REPJAN_AI_API_KEY", ""),
+		AITimeout:     getDurationEnv("REPJAN_AI_TIMEOUT", 60*time.Second),
+		AITokenBudget: getIntEnv("REPJAN_AI_TOKEN_BUDGET", 1500),
+		BotPatterns:   getListEnv("REPJAN_BOT_PATTERNS"),
+
+		ArchiveWorkflow:  getEnv("REPJAN_ARCHIVE_WORKFLOW", ""),
+		ArchiveGraceDays: getIntEnv("REPJAN_ARCHIVE_GRACE_DAYS", 30),
+		GraveyardOwner:   getEnv("REPJAN_GRAVEYARD_OWNER", ""),
+		GraveyardArchive: getBoolEnv("REPJAN_GRAVEYARD_ARCHIVE", false),
+		AllowDelete:      getBoolEnv("REPJAN_ALLOW_DELETE", false),
+		BackupDir:        getEnv("REPJAN_BACKUP_DIR", ""),
+		BackupFormat:     getEnv("REPJAN_BACKUP_FORMAT", "mirror"),
+		BackupOnArchive:  getBoolEnv("REPJAN_BACKUP_ON_ARCHIVE", false),
 	}
 
 	// Validate log level
@@ -51,6 +97,31 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("invalid REPJAN_LOG_FORMAT %q: must be one of %v", cfg.LogFormat, validLogFormats)
 	}
 
+	// Validate batch workers
+	if cfg.BatchWorkers < 1 {
+		return nil, fmt.Errorf("invalid REPJAN_BATCH_WORKERS %d: must be at least 1", cfg.BatchWorkers)
+	}
+
+	// Validate AI backend
+	if !slices.Contains(validAIBackends, cfg.AIBackend) {
+		return nil, fmt.Errorf("invalid REPJAN_AI_BACKEND %q: must be one of %v", cfg.AIBackend, validAIBackends)
+	}
+
+	// Validate backup format
+	if !slices.Contains(validBackupFormats, cfg.BackupFormat) {
+		return nil, fmt.Errorf("invalid REPJAN_BACKUP_FORMAT %q: must be one of %v", cfg.BackupFormat, validBackupFormats)
+	}
+
+	// Validate archive grace period
+	if cfg.ArchiveGraceDays < 1 {
+		return nil, fmt.Errorf("invalid REPJAN_ARCHIVE_GRACE_DAYS %d: must be at least 1", cfg.ArchiveGraceDays)
+	}
+
+	// Validate AI token budget
+	if cfg.AITokenBudget < 1 {
+		return nil, fmt.Errorf("invalid REPJAN_AI_TOKEN_BUDGET %d: must be at least 1", cfg.AITokenBudget)
+	}
+
 	return cfg, nil
 }
 
@@ -62,6 +133,18 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+// getListEnv retrieves a comma-separated environment variable as a list,
+// dropping empty entries.
+func getListEnv(key string) []string {
+	var list []string
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
 // getDurationEnv retrieves a duration environment variable or returns a default value.
 // If the value cannot be parsed as a duration, the default is returned.
 func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
@@ -76,3 +159,33 @@ func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
 	}
 	return duration
 }
+
+// getBoolEnv retrieves a boolean environment variable or returns a default value.
+// If the value cannot be parsed as a boolean, the default is returned.
+func getBoolEnv(key string, defaultValue bool) bool {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return defaultValue
+	}
+	return b
+}
+
+// getIntEnv retrieves an integer environment variable or returns a default value.
+// If the value cannot be parsed as an integer, the default is returned.
+func getIntEnv(key string, defaultValue int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return defaultValue
+	}
+	return n
+}
@@ -26,6 +26,9 @@ func TestLoad_Defaults(t *testing.T) {
 	assert.Equal(t, "text", cfg.LogFormat)
 	assert.Equal(t, 5*time.Minute, cfg.SyncInterval)
 	assert.Equal(t, "", cfg.DBPath)
+	assert.Equal(t, "fabric", cfg.AIBackend)
+	assert.Equal(t, 60*time.Second, cfg.AITimeout)
+	assert.Equal(t, 1500, cfg.AITokenBudget)
 }
 
 func TestLoad_EnvVars(t *testing.T) {
@@ -296,3 +299,202 @@ func TestLoad_DBPathWithSpecialChars(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "/path/with-dashes_and_underscores/test.db", cfg.DBPath)
 }
+
+func TestLoad_BatchWorkers(t *testing.T) {
+	os.Unsetenv("REPJAN_BATCH_WORKERS")
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, 4, cfg.BatchWorkers)
+
+	os.Setenv("REPJAN_BATCH_WORKERS", "8")
+	defer os.Unsetenv("REPJAN_BATCH_WORKERS")
+
+	cfg, err = Load()
+	require.NoError(t, err)
+	assert.Equal(t, 8, cfg.BatchWorkers)
+}
+
+func TestLoad_InvalidBatchWorkers(t *testing.T) {
+	os.Setenv("REPJAN_BATCH_WORKERS", "0")
+	defer os.Unsetenv("REPJAN_BATCH_WORKERS")
+
+	cfg, err := Load()
+	assert.Nil(t, cfg)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid REPJAN_BATCH_WORKERS")
+}
+
+func TestGetIntEnv(t *testing.T) {
+	tests := []struct {
+		name         string
+		envValue     string
+		defaultValue int
+		expected     int
+		setEnv       bool
+	}{
+		{
+			name:         "returns parsed int when valid",
+			envValue:     "12",
+			defaultValue: 4,
+			expected:     12,
+			setEnv:       true,
+		},
+		{
+			name:         "returns default when not set",
+			defaultValue: 4,
+			expected:     4,
+			setEnv:       false,
+		},
+		{
+			name:         "returns default when invalid",
+			envValue:     "many",
+			defaultValue: 4,
+			expected:     4,
+			setEnv:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := "TEST_GET_INT_KEY"
+			if tt.setEnv {
+				os.Setenv(key, tt.envValue)
+			} else {
+				os.Unsetenv(key)
+			}
+			defer os.Unsetenv(key)
+
+			result := getIntEnv(key, tt.defaultValue)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestLoad_AIBackend(t *testing.T) {
+	os.Setenv("REPJAN_AI_BACKEND", "ollama")
+	os.Setenv("REPJAN_AI_URL", "http://gpu-box:11434")
+	os.Setenv("REPJAN_AI_MODEL", "qwen2.5")
+	os.Setenv("REPJAN_AI_TIMEOUT", "2m")
+	os.Setenv("REPJAN_AI_TOKEN_BUDGET", "4000")
+	defer func() {
+		os.Unsetenv("REPJAN_AI_BACKEND")
+		os.Unsetenv("REPJAN_AI_URL")
+		os.Unsetenv("REPJAN_AI_MODEL")
+		os.Unsetenv("REPJAN_AI_TIMEOUT")
+		os.Unsetenv("REPJAN_AI_TOKEN_BUDGET")
+	}()
+
+	cfg, err := Load()
+	require.NoError(t, err)
+
+	assert.Equal(t, "ollama", cfg.AIBackend)
+	assert.Equal(t, "http://gpu-box:11434", cfg.AIURL)
+	assert.Equal(t, "qwen2.5", cfg.AIModel)
+	assert.Equal(t, 2*time.Minute, cfg.AITimeout)
+	assert.Equal(t, 4000, cfg.AITokenBudget)
+}
+
+func TestLoad_InvalidAITokenBudget(t *testing.T) {
+	os.Setenv("REPJAN_AI_TOKEN_BUDGET", "0")
+	defer os.Unsetenv("REPJAN_AI_TOKEN_BUDGET")
+
+	cfg, err := Load()
+	assert.Nil(t, cfg)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid REPJAN_AI_TOKEN_BUDGET")
+}
+
+func TestLoad_BotPatterns(t *testing.T) {
+	os.Setenv("REPJAN_BOT_PATTERNS", "release-bot, acme-ci*,,")
+	defer os.Unsetenv("REPJAN_BOT_PATTERNS")
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"release-bot", "acme-ci*"}, cfg.BotPatterns)
+}
+
+func TestLoad_ArchiveWorkflow(t *testing.T) {
+	os.Setenv("REPJAN_ARCHIVE_WORKFLOW", "topic:deprecated,close-prs,archive")
+	defer os.Unsetenv("REPJAN_ARCHIVE_WORKFLOW")
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, "topic:deprecated,close-prs,archive", cfg.ArchiveWorkflow)
+}
+
+func TestLoad_Graveyard(t *testing.T) {
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Empty(t, cfg.GraveyardOwner)
+	assert.False(t, cfg.GraveyardArchive)
+
+	os.Setenv("REPJAN_GRAVEYARD_OWNER", "acme-attic")
+	os.Setenv("REPJAN_GRAVEYARD_ARCHIVE", "true")
+	defer os.Unsetenv("REPJAN_GRAVEYARD_OWNER")
+	defer os.Unsetenv("REPJAN_GRAVEYARD_ARCHIVE")
+
+	cfg, err = Load()
+	require.NoError(t, err)
+	assert.Equal(t, "acme-attic", cfg.GraveyardOwner)
+	assert.True(t, cfg.GraveyardArchive)
+}
+
+func TestLoad_Deletion(t *testing.T) {
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.False(t, cfg.AllowDelete)
+	assert.Empty(t, cfg.BackupDir)
+
+	os.Setenv("REPJAN_ALLOW_DELETE", "1")
+	os.Setenv("REPJAN_BACKUP_DIR", "/srv/repjan-backups")
+	defer os.Unsetenv("REPJAN_ALLOW_DELETE")
+	defer os.Unsetenv("REPJAN_BACKUP_DIR")
+
+	cfg, err = Load()
+	require.NoError(t, err)
+	assert.True(t, cfg.AllowDelete)
+	assert.Equal(t, "/srv/repjan-backups", cfg.BackupDir)
+}
+
+func TestLoad_InvalidArchiveGraceDays(t *testing.T) {
+	os.Setenv("REPJAN_ARCHIVE_GRACE_DAYS", "0")
+	defer os.Unsetenv("REPJAN_ARCHIVE_GRACE_DAYS")
+
+	cfg, err := Load()
+	assert.Nil(t, cfg)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid REPJAN_ARCHIVE_GRACE_DAYS")
+}
+
+func TestLoad_Backup(t *testing.T) {
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, "mirror", cfg.BackupFormat)
+	assert.False(t, cfg.BackupOnArchive)
+
+	os.Setenv("REPJAN_BACKUP_FORMAT", "bundle")
+	os.Setenv("REPJAN_BACKUP_ON_ARCHIVE", "true")
+	defer os.Unsetenv("REPJAN_BACKUP_FORMAT")
+	defer os.Unsetenv("REPJAN_BACKUP_ON_ARCHIVE")
+
+	cfg, err = Load()
+	require.NoError(t, err)
+	assert.Equal(t, "bundle", cfg.BackupFormat)
+	assert.True(t, cfg.BackupOnArchive)
+
+	os.Setenv("REPJAN_BACKUP_FORMAT", "zip")
+	cfg, err = Load()
+	assert.Nil(t, cfg)
+	assert.ErrorContains(t, err, "invalid REPJAN_BACKUP_FORMAT")
+}
+
+func TestLoad_InvalidAIBackend(t *testing.T) {
+	os.Setenv("REPJAN_AI_BACKEND", "clippy")
+	defer os.Unsetenv("REPJAN_AI_BACKEND")
+
+	cfg, err := Load()
+	assert.Nil(t, cfg)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid REPJAN_AI_BACKEND")
+}
@@ -56,10 +56,10 @@ func TestGetMigrationVersion(t *testing.T) {
 	err = RunMigrations(db)
 	require.NoError(t, err)
 
-	// Check version - should be 4 after running all migrations
+	// Check version - should be 15 after running all migrations
 	version, err := GetMigrationVersion(db)
 	require.NoError(t, err)
-	assert.Equal(t, int64(4), version, "migration version should be 4 after running all migrations")
+	assert.Equal(t, int64(15), version, "migration version should be 15 after running all migrations")
 }
 
 func TestClose_NilDB(t *testing.T) {
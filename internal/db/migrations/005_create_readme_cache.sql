@@ -0,0 +1,14 @@
+-- SPDX-FileCopyrightText: 2026 api2spec
+-- SPDX-License-Identifier: FSL-1.1-MIT
+
+-- +goose Up
+CREATE TABLE readme_cache (
+    owner TEXT NOT NULL,
+    repo_name TEXT NOT NULL,
+    content TEXT NOT NULL DEFAULT '',  -- decoded README, empty when none exists
+    fetched_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (owner, repo_name)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS readme_cache;
@@ -0,0 +1,16 @@
+-- SPDX-FileCopyrightText: 2026 api2spec
+-- SPDX-License-Identifier: FSL-1.1-MIT
+
+-- +goose Up
+CREATE TABLE ai_analyses (
+    owner TEXT NOT NULL,
+    repo_name TEXT NOT NULL,
+    pushed_at DATETIME NOT NULL,  -- repo pushed_at the analysis was made for
+    backend TEXT NOT NULL,        -- fabric, ollama, openai
+    result TEXT NOT NULL,
+    analyzed_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (owner, repo_name, pushed_at, backend)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS ai_analyses;
@@ -0,0 +1,18 @@
+-- SPDX-FileCopyrightText: 2026 api2spec
+-- SPDX-License-Identifier: FSL-1.1-MIT
+
+-- +goose Up
+CREATE TABLE repo_verdicts (
+    owner TEXT NOT NULL,
+    repo_name TEXT NOT NULL,
+    verdict TEXT NOT NULL,      -- archive, keep, needs-owner-review
+    confidence REAL NOT NULL,   -- 0-1
+    rationale TEXT NOT NULL DEFAULT '',
+    replacement TEXT NOT NULL DEFAULT '',
+    backend TEXT NOT NULL,      -- fabric, ollama, openai
+    analyzed_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (owner, repo_name)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS repo_verdicts;
@@ -0,0 +1,14 @@
+-- SPDX-FileCopyrightText: 2026 api2spec
+-- SPDX-License-Identifier: FSL-1.1-MIT
+
+-- +goose Up
+CREATE TABLE archive_models (
+    owner TEXT PRIMARY KEY,
+    model TEXT NOT NULL,            -- JSON weights and feature scaling
+    examples INTEGER NOT NULL,      -- labeled repos used for training
+    accuracy REAL,                  -- held-out accuracy, NULL when nothing was held out
+    trained_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS archive_models;
@@ -0,0 +1,15 @@
+-- SPDX-FileCopyrightText: 2026 api2spec
+-- SPDX-License-Identifier: FSL-1.1-MIT
+
+-- +goose Up
+CREATE TABLE repo_activity (
+    owner TEXT NOT NULL,
+    repo_name TEXT NOT NULL,
+    last_human_activity DATETIME,   -- latest commit by a non-bot author (NULL when none seen)
+    last_bot_activity DATETIME,     -- latest commit by a bot
+    checked_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (owner, repo_name)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS repo_activity;
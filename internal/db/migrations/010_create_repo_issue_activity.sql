@@ -0,0 +1,17 @@
+-- SPDX-FileCopyrightText: 2026 api2spec
+-- SPDX-License-Identifier: FSL-1.1-MIT
+
+-- +goose Up
+CREATE TABLE repo_issue_activity (
+    owner TEXT NOT NULL,
+    repo_name TEXT NOT NULL,
+    open_issues INTEGER NOT NULL DEFAULT 0,
+    open_pull_requests INTEGER NOT NULL DEFAULT 0,
+    open_discussions INTEGER NOT NULL DEFAULT 0,
+    last_updated DATETIME,          -- latest issue, PR or discussion update (NULL when none)
+    checked_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (owner, repo_name)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS repo_issue_activity;
@@ -0,0 +1,14 @@
+-- SPDX-FileCopyrightText: 2026 api2spec
+-- SPDX-License-Identifier: FSL-1.1-MIT
+
+-- +goose Up
+CREATE TABLE archive_impacts (
+    owner TEXT NOT NULL,
+    repo_name TEXT NOT NULL,
+    impact TEXT NOT NULL,           -- JSON encoded github.ArchiveImpact
+    fetched_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (owner, repo_name)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS archive_impacts;
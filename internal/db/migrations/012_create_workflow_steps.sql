@@ -0,0 +1,14 @@
+-- SPDX-FileCopyrightText: 2026 api2spec
+-- SPDX-License-Identifier: FSL-1.1-MIT
+
+-- +goose Up
+CREATE TABLE workflow_steps (
+    owner TEXT NOT NULL,
+    repo_name TEXT NOT NULL,
+    step TEXT NOT NULL,             -- step spec, e.g. topic:deprecated
+    completed_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (owner, repo_name, step)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS workflow_steps;
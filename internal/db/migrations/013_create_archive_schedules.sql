@@ -0,0 +1,18 @@
+-- SPDX-FileCopyrightText: 2026 api2spec
+-- SPDX-License-Identifier: FSL-1.1-MIT
+
+-- +goose Up
+CREATE TABLE archive_schedules (
+    owner TEXT NOT NULL,
+    repo_name TEXT NOT NULL,
+    archive_on DATETIME NOT NULL,   -- archived by apply-due once this has passed
+    issue_number INTEGER NOT NULL,  -- notice issue announcing the archive
+    issue_url TEXT NOT NULL,
+    announced_by TEXT NOT NULL,     -- login that opened the notice; its comments are not objections
+    pushed_at DATETIME,             -- last push when announced; a newer push cancels the archive
+    announced_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (owner, repo_name)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS archive_schedules;
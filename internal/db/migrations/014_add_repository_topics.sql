@@ -0,0 +1,8 @@
+-- SPDX-FileCopyrightText: 2026 api2spec
+-- SPDX-License-Identifier: FSL-1.1-MIT
+
+-- +goose Up
+ALTER TABLE repositories ADD COLUMN topics TEXT; -- comma-separated; topics cannot contain commas
+
+-- +goose Down
+ALTER TABLE repositories DROP COLUMN topics;
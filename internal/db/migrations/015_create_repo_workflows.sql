@@ -0,0 +1,20 @@
+-- SPDX-FileCopyrightText: 2026 api2spec
+-- SPDX-License-Identifier: FSL-1.1-MIT
+
+-- +goose Up
+CREATE TABLE repo_workflows (
+    owner TEXT NOT NULL,
+    repo_name TEXT NOT NULL,
+    workflow_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    path TEXT NOT NULL,
+    state TEXT NOT NULL,            -- active, disabled_manually, disabled_inactivity, ...
+    triggers TEXT,                  -- comma-separated events from the workflow file's "on" key
+    last_run_at DATETIME,           -- NULL when it never ran
+    last_status TEXT,               -- conclusion of the latest run, or its status while running
+    checked_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (owner, repo_name, workflow_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS repo_workflows;
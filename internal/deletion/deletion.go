@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package deletion permanently deletes repositories, but only after a
+// verified local backup of each one has been made and recorded.
+package deletion
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/llbbl/repjan/internal/backup"
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+// Actions recorded in repo_changes.
+const (
+	ActionBackedUp = "backed_up"
+	ActionDeleted  = "deleted"
+)
+
+// Client is the GitHub access deleting repositories needs.
+type Client interface {
+	DeleteRepository(owner, name string) error
+}
+
+// Deleter backs up and deletes repositories.
+type Deleter struct {
+	client Client
+	store  *store.Store
+	backer *backup.Backer
+}
+
+// New creates a deleter backing repositories up with backer. The store is
+// required: no repository is deleted without its backup on record.
+func New(client Client, s *store.Store, backer *backup.Backer) *Deleter {
+	return &Deleter{client: client, store: s, backer: backer}
+}
+
+// Delete backs repo up, verifies the backup, records its location and only
+// then deletes the repository on GitHub and from the store. Any failure
+// before the delete leaves the repository alone.
+func (d *Deleter) Delete(repo github.Repository) (backup.Metadata, error) {
+	if d.store == nil || d.backer == nil {
+		return backup.Metadata{}, errors.New("deleting needs a database and a backup directory")
+	}
+
+	b, err := d.backer.Backup(repo)
+	if err != nil {
+		return backup.Metadata{}, fmt.Errorf("backing up: %w", err)
+	}
+	state := map[string]any{"path": b.Path, "format": b.Format, "refs": b.Refs}
+	if err := d.store.RecordRepoChange(repo.Owner, repo.Name, ActionBackedUp, "user", nil, state, "before deletion"); err != nil {
+		return b, fmt.Errorf("recording backup: %w", err)
+	}
+
+	if err := d.client.DeleteRepository(repo.Owner, repo.Name); err != nil {
+		return b, err
+	}
+	location := b.Path
+	if location == "" {
+		location = d.backer.Dir() + " (empty repository, metadata only)"
+	}
+	if err := d.store.RecordRepoChange(repo.Owner, repo.Name, ActionDeleted, "user", nil, nil, "backup: "+location); err != nil {
+		slog.Warn("failed to record deletion", "component", "deletion", "repo", repo.FullName(), "error", err)
+	}
+	if err := d.store.DeleteRepository(repo.Owner, repo.Name); err != nil {
+		slog.Warn("failed to forget deleted repo", "component", "deletion", "repo", repo.FullName(), "error", err)
+	}
+	return b, nil
+}
+
+// CheckConfirmation reports whether typed, what the user entered when asked
+// for the number of repositories to delete, matches count.
+func CheckConfirmation(typed string, count int) error {
+	n, err := strconv.Atoi(strings.TrimSpace(typed))
+	if err != nil || n != count {
+		return fmt.Errorf("confirmation %q does not match the %d repositories to delete", strings.TrimSpace(typed), count)
+	}
+	return nil
+}
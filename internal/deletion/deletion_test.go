@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package deletion
+
+import (
+	"encoding/json"
+	"errors"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/backup"
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+// fakeClient records deleted repositories and has no issues.
+type fakeClient struct {
+	deleted []string
+	err     error
+}
+
+func (c *fakeClient) DeleteRepository(owner, name string) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.deleted = append(c.deleted, owner+"/"+name)
+	return nil
+}
+
+func (c *fakeClient) ListIssues(owner, name string) ([]github.IssueSummary, error) {
+	return nil, nil
+}
+
+// newBacker returns a backer that clones every repository from remote.
+func newBacker(t *testing.T, client *fakeClient, remote string) *backup.Backer {
+	t.Helper()
+	b := backup.NewBacker(client, t.TempDir(), "")
+	b.SetRemoteURL(func(owner, name string) string { return remote })
+	return b
+}
+
+func TestDelete(t *testing.T) {
+	remote := testutil.NewGitRemote(t)
+	client := &fakeClient{}
+	s := testutil.NewStore(t)
+	repo := github.Repository{Owner: "acme", Name: "junk"}
+	require.NoError(t, s.UpsertRepositories("acme", []github.Repository{repo}))
+
+	b, err := New(client, s, newBacker(t, client, remote)).Delete(repo)
+	require.NoError(t, err)
+	assert.DirExists(t, b.Path)
+	assert.Equal(t, []string{"acme/junk"}, client.deleted)
+
+	_, err = s.GetRepository("acme", "junk")
+	assert.ErrorIs(t, err, store.ErrNotFound)
+	backedUp, err := s.GetChangesByAction("acme", ActionBackedUp, 10)
+	require.NoError(t, err)
+	require.Len(t, backedUp, 1)
+	var state map[string]any
+	require.NoError(t, json.Unmarshal([]byte(backedUp[0].NewState), &state))
+	assert.Equal(t, b.Path, state["path"])
+	deleted, err := s.GetChangesByAction("acme", ActionDeleted, 10)
+	require.NoError(t, err)
+	require.Len(t, deleted, 1)
+	assert.Equal(t, "backup: "+b.Path, deleted[0].Notes)
+}
+
+func TestDelete_BackupFailureKeepsRepo(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	client := &fakeClient{}
+	backer := newBacker(t, client, filepath.Join(t.TempDir(), "missing.git"))
+
+	_, err := New(client, testutil.NewStore(t), backer).Delete(github.Repository{Owner: "acme", Name: "junk"})
+	assert.ErrorContains(t, err, "backing up")
+	assert.Empty(t, client.deleted)
+
+	_, err = New(client, nil, backer).Delete(github.Repository{Owner: "acme", Name: "junk"})
+	assert.Error(t, err)
+	assert.Empty(t, client.deleted)
+}
+
+func TestDelete_APIFailure(t *testing.T) {
+	remote := testutil.NewGitRemote(t)
+	s := testutil.NewStore(t)
+	repo := github.Repository{Owner: "acme", Name: "junk"}
+	require.NoError(t, s.UpsertRepositories("acme", []github.Repository{repo}))
+	client := &fakeClient{err: errors.New("HTTP 403")}
+
+	_, err := New(client, s, newBacker(t, client, remote)).Delete(repo)
+	assert.Error(t, err)
+	_, err = s.GetRepository("acme", "junk")
+	assert.NoError(t, err)
+}
+
+func TestCheckConfirmation(t *testing.T) {
+	assert.NoError(t, CheckConfirmation(" 3\n", 3))
+	assert.Error(t, CheckConfirmation("2", 3))
+	assert.Error(t, CheckConfirmation("yes", 3))
+	assert.Error(t, CheckConfirmation("", 0))
+}
@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package edit adds and removes topics and sets or prefixes descriptions of
+// repositories, keeping the store and the change history in step.
+package edit
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+// Actions recorded in repo_changes.
+const (
+	ActionTopicsChanged      = "topics_changed"
+	ActionDescriptionChanged = "description_changed"
+)
+
+// Kind is the kind of an edit.
+type Kind int
+
+const (
+	AddTopic Kind = iota
+	RemoveTopic
+	SetDescription
+	PrefixDescription
+)
+
+// Kinds lists every kind of edit, in display order.
+var Kinds = []Kind{AddTopic, RemoveTopic, SetDescription, PrefixDescription}
+
+// String returns the kind's display name, e.g. "Add topic".
+func (k Kind) String() string {
+	switch k {
+	case AddTopic:
+		return "Add topic"
+	case RemoveTopic:
+		return "Remove topic"
+	case SetDescription:
+		return "Set description"
+	case PrefixDescription:
+		return "Prefix description"
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// topicPattern matches the topics GitHub accepts.
+var topicPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,49}$`)
+
+// Edit is a change applied to each repository of a batch.
+type Edit struct {
+	Kind  Kind
+	Value string // topic, description or prefix
+}
+
+// Validate reports whether the edit can be applied. Topics must be lowercase
+// letters, digits and hyphens, and prefixes must not be empty. An empty
+// description clears it.
+func (e Edit) Validate() error {
+	switch e.Kind {
+	case AddTopic, RemoveTopic:
+		if !topicPattern.MatchString(e.Value) {
+			return fmt.Errorf("invalid topic %q: use up to 50 lowercase letters, digits and hyphens", e.Value)
+		}
+	case PrefixDescription:
+		if strings.TrimSpace(e.Value) == "" {
+			return errors.New("prefix cannot be empty")
+		}
+	case SetDescription:
+	default:
+		return fmt.Errorf("unknown edit kind %d", int(e.Kind))
+	}
+	return nil
+}
+
+// String describes the edit, e.g. `add topic "deprecated"`.
+func (e Edit) String() string {
+	return fmt.Sprintf("%s %q", strings.ToLower(e.Kind.String()), e.Value)
+}
+
+// Client is the GitHub access editing needs.
+type Client interface {
+	FetchRepoSettings(owner, name string) (github.RepoSettings, error)
+	AddTopic(owner, name, topic string) error
+	RemoveTopic(owner, name, topic string) error
+	SetDescription(owner, name, description string) error
+}
+
+// Editor applies edits to repositories.
+type Editor struct {
+	client Client
+	store  *store.Store
+}
+
+// New creates an editor. The store may be nil.
+func New(client Client, s *store.Store) *Editor {
+	return &Editor{client: client, store: s}
+}
+
+// ApplyTo applies e to the topics and description of repo, reporting false
+// when it already has the result.
+func (e Edit) ApplyTo(repo *github.Repository) bool {
+	switch e.Kind {
+	case AddTopic:
+		if slices.Contains(repo.Topics, e.Value) {
+			return false
+		}
+		repo.Topics = append(slices.Clone(repo.Topics), e.Value)
+	case RemoveTopic:
+		if !slices.Contains(repo.Topics, e.Value) {
+			return false
+		}
+		repo.Topics = slices.DeleteFunc(slices.Clone(repo.Topics), func(t string) bool { return t == e.Value })
+	case SetDescription:
+		if repo.Description == e.Value {
+			return false
+		}
+		repo.Description = e.Value
+	case PrefixDescription:
+		if strings.HasPrefix(repo.Description, e.Value) {
+			return false
+		}
+		repo.Description = strings.TrimSpace(e.Value + " " + repo.Description)
+	default:
+		return false
+	}
+	return true
+}
+
+// Apply applies e to repo, starting from its live settings. It reports false
+// without changing anything when the repository already has the result, so
+// an interrupted batch can be repeated.
+func (ed *Editor) Apply(repo github.Repository, e Edit) (bool, error) {
+	if err := e.Validate(); err != nil {
+		return false, err
+	}
+	settings, err := ed.client.FetchRepoSettings(repo.Owner, repo.Name)
+	if err != nil {
+		return false, err
+	}
+	live := github.Repository{Description: settings.Description, Topics: settings.Topics}
+	if !e.ApplyTo(&live) {
+		return false, nil
+	}
+
+	switch e.Kind {
+	case AddTopic:
+		err = ed.client.AddTopic(repo.Owner, repo.Name, e.Value)
+	case RemoveTopic:
+		err = ed.client.RemoveTopic(repo.Owner, repo.Name, e.Value)
+	default:
+		err = ed.client.SetDescription(repo.Owner, repo.Name, live.Description)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if e.Kind == AddTopic || e.Kind == RemoveTopic {
+		ed.record(repo, ActionTopicsChanged,
+			map[string][]string{"topics": append([]string{}, settings.Topics...)},
+			map[string][]string{"topics": live.Topics}, e,
+			func(stored *github.Repository) { stored.Topics = live.Topics })
+	} else {
+		ed.record(repo, ActionDescriptionChanged,
+			map[string]string{"description": settings.Description},
+			map[string]string{"description": live.Description}, e,
+			func(stored *github.Repository) { stored.Description = live.Description })
+	}
+	return true, nil
+}
+
+// record stores an applied edit in the change history and updates the stored
+// repository. GitHub has already changed, so failures are only logged.
+func (ed *Editor) record(repo github.Repository, action string, prev, next any, e Edit, update func(*github.Repository)) {
+	if ed.store == nil {
+		return
+	}
+	if err := ed.store.RecordRepoChange(repo.Owner, repo.Name, action, "user", prev, next, e.String()); err != nil {
+		slog.Warn("failed to record edit", "component", "edit", "repo", repo.FullName(), "error", err)
+	}
+	stored, err := ed.store.GetRepository(repo.Owner, repo.Name)
+	if err == nil {
+		update(stored)
+		err = ed.store.UpdateRepository(*stored)
+	}
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		slog.Warn("failed to update edited repo", "component", "edit", "repo", repo.FullName(), "error", err)
+	}
+}
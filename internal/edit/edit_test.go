@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package edit
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+// fakeClient keeps repository settings in memory.
+type fakeClient struct {
+	settings map[string]*github.RepoSettings
+	calls    int
+	err      error
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{settings: map[string]*github.RepoSettings{}}
+}
+
+func (c *fakeClient) get(owner, name string) *github.RepoSettings {
+	key := owner + "/" + name
+	if c.settings[key] == nil {
+		c.settings[key] = &github.RepoSettings{}
+	}
+	return c.settings[key]
+}
+
+func (c *fakeClient) FetchRepoSettings(owner, name string) (github.RepoSettings, error) {
+	return *c.get(owner, name), nil
+}
+
+func (c *fakeClient) AddTopic(owner, name, topic string) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.calls++
+	s := c.get(owner, name)
+	s.Topics = append(s.Topics, topic)
+	return nil
+}
+
+func (c *fakeClient) RemoveTopic(owner, name, topic string) error {
+	c.calls++
+	s := c.get(owner, name)
+	s.Topics = slices.DeleteFunc(s.Topics, func(t string) bool { return t == topic })
+	return nil
+}
+
+func (c *fakeClient) SetDescription(owner, name, description string) error {
+	c.calls++
+	c.get(owner, name).Description = description
+	return nil
+}
+
+func TestEdit_Validate(t *testing.T) {
+	assert.NoError(t, Edit{Kind: AddTopic, Value: "deprecated"}.Validate())
+	assert.NoError(t, Edit{Kind: RemoveTopic, Value: "go-1-22"}.Validate())
+	assert.Error(t, Edit{Kind: AddTopic, Value: "Deprecated"}.Validate())
+	assert.Error(t, Edit{Kind: AddTopic, Value: "no spaces"}.Validate())
+	assert.Error(t, Edit{Kind: AddTopic}.Validate())
+	assert.NoError(t, Edit{Kind: SetDescription}.Validate())
+	assert.Error(t, Edit{Kind: PrefixDescription, Value: " "}.Validate())
+}
+
+func TestApply_Topics(t *testing.T) {
+	client := newFakeClient()
+	client.get("acme", "widget").Topics = []string{"cli"}
+	s := testutil.NewStore(t)
+	repo := github.Repository{Owner: "acme", Name: "widget", Topics: []string{"cli"}}
+	require.NoError(t, s.UpsertRepositories("acme", []github.Repository{repo}))
+	editor := New(client, s)
+
+	changed, err := editor.Apply(repo, Edit{Kind: AddTopic, Value: "deprecated"})
+	require.NoError(t, err)
+	assert.True(t, changed)
+	stored, err := s.GetRepository("acme", "widget")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cli", "deprecated"}, stored.Topics)
+
+	// Adding it again changes nothing
+	changed, err = editor.Apply(repo, Edit{Kind: AddTopic, Value: "deprecated"})
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, 1, client.calls)
+
+	changed, err = editor.Apply(repo, Edit{Kind: RemoveTopic, Value: "cli"})
+	require.NoError(t, err)
+	assert.True(t, changed)
+	stored, err = s.GetRepository("acme", "widget")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"deprecated"}, stored.Topics)
+
+	changes, err := s.GetChangesByAction("acme", ActionTopicsChanged, 10)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	var prev, next map[string][]string
+	require.NoError(t, json.Unmarshal([]byte(changes[1].PreviousState), &prev))
+	require.NoError(t, json.Unmarshal([]byte(changes[1].NewState), &next))
+	assert.Equal(t, []string{"cli"}, prev["topics"])
+	assert.Equal(t, []string{"cli", "deprecated"}, next["topics"])
+	assert.Equal(t, `add topic "deprecated"`, changes[1].Notes)
+}
+
+func TestApply_Description(t *testing.T) {
+	client := newFakeClient()
+	client.get("acme", "widget").Description = "A widget"
+	s := testutil.NewStore(t)
+	repo := github.Repository{Owner: "acme", Name: "widget", Description: "A widget"}
+	require.NoError(t, s.UpsertRepositories("acme", []github.Repository{repo}))
+	editor := New(client, s)
+
+	prefix := Edit{Kind: PrefixDescription, Value: "[DEPRECATED] use gizmo instead."}
+	changed, err := editor.Apply(repo, prefix)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	stored, err := s.GetRepository("acme", "widget")
+	require.NoError(t, err)
+	assert.Equal(t, "[DEPRECATED] use gizmo instead. A widget", stored.Description)
+
+	changed, err = editor.Apply(repo, prefix)
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	changed, err = editor.Apply(repo, Edit{Kind: SetDescription, Value: "Retired"})
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, "Retired", client.get("acme", "widget").Description)
+
+	changes, err := s.GetChangesByAction("acme", ActionDescriptionChanged, 10)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	var prev, next map[string]string
+	require.NoError(t, json.Unmarshal([]byte(changes[0].PreviousState), &prev))
+	require.NoError(t, json.Unmarshal([]byte(changes[0].NewState), &next))
+	assert.Equal(t, "[DEPRECATED] use gizmo instead. A widget", prev["description"])
+	assert.Equal(t, "Retired", next["description"])
+}
+
+func TestApply_Error(t *testing.T) {
+	client := newFakeClient()
+	client.err = errors.New("HTTP 403")
+	s := testutil.NewStore(t)
+	repo := github.Repository{Owner: "acme", Name: "widget"}
+	require.NoError(t, s.UpsertRepositories("acme", []github.Repository{repo}))
+
+	_, err := New(client, s).Apply(repo, Edit{Kind: AddTopic, Value: "deprecated"})
+	assert.Error(t, err)
+	stored, err := s.GetRepository("acme", "widget")
+	require.NoError(t, err)
+	assert.Empty(t, stored.Topics)
+	changes, err := s.GetChangesByAction("acme", ActionTopicsChanged, 10)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package export
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// column describes one field of an exported repository for tabular formats.
+// Every tabular exporter uses the same columns so the field set stays consistent.
+type column struct {
+	Key   string // machine-readable name, matches the JSON field
+	Label string // human-readable header
+	Value func(r ExportedRepo) string
+}
+
+// columns lists the exported repository fields in output order.
+var columns = []column{
+	{"name", "Name", func(r ExportedRepo) string { return r.Name }},
+	{"full_name", "Repository", func(r ExportedRepo) string { return r.FullName }},
+	{"description", "Description", func(r ExportedRepo) string { return r.Description }},
+	{"language", "Language", func(r ExportedRepo) string { return r.Language }},
+	{"stars", "Stars", func(r ExportedRepo) string { return strconv.Itoa(r.Stars) }},
+	{"forks", "Forks", func(r ExportedRepo) string { return strconv.Itoa(r.Forks) }},
+	{"days_since_activity", "Days Inactive", func(r ExportedRepo) string { return strconv.Itoa(r.DaysSinceActivity) }},
+	{"last_push", "Last Push", func(r ExportedRepo) string { return formatDate(r.LastPush) }},
+	{"is_fork", "Fork", func(r ExportedRepo) string { return strconv.FormatBool(r.IsFork) }},
+	{"is_private", "Private", func(r ExportedRepo) string { return strconv.FormatBool(r.IsPrivate) }},
+	{"is_archived", "Archived", func(r ExportedRepo) string { return strconv.FormatBool(r.IsArchived) }},
+	{"score", "Score", func(r ExportedRepo) string { return strconv.Itoa(r.Score) }},
+	{"reason_codes", "Reason Codes", func(r ExportedRepo) string { return strings.Join(r.ReasonCodes, ";") }},
+	{"reason", "Reason", func(r ExportedRepo) string { return r.Reason }},
+}
+
+// formatDate formats a time as RFC 3339, or an empty string if zero.
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package export
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// csvExporter writes export data as CSV with a header row of column keys.
+type csvExporter struct{}
+
+// Format implements Exporter.
+func (csvExporter) Format() string { return "csv" }
+
+// Extension implements Exporter.
+func (csvExporter) Extension() string { return "csv" }
+
+// Write implements Exporter.
+func (csvExporter) Write(w io.Writer, data ExportData) error {
+	cw := csv.NewWriter(w)
+
+	header := make([]string, len(columns))
+	for i, c := range columns {
+		header[i] = c.Key
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	for _, repo := range data.Repositories {
+		record := make([]string, len(columns))
+		for i, c := range columns {
+			record[i] = c.Value(repo)
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package export
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/llbbl/repjan/internal/analyze"
+	"github.com/llbbl/repjan/internal/github"
+)
+
+// Stdout is the output path that writes an export to standard output.
+const Stdout = "-"
+
+// Exporter renders export data in a specific output format.
+type Exporter interface {
+	// Format returns the format name used to select the exporter (e.g. "csv").
+	Format() string
+	// Extension returns the file extension without a leading dot.
+	Extension() string
+	// Write renders data to w.
+	Write(w io.Writer, data ExportData) error
+}
+
+// exporters holds the registered exporters keyed by format name.
+var exporters = map[string]Exporter{}
+
+// register adds an exporter to the registry.
+func register(e Exporter) {
+	exporters[e.Format()] = e
+}
+
+func init() {
+	register(jsonExporter{})
+	register(csvExporter{})
+	register(markdownExporter{})
+	register(htmlExporter{})
+	register(yamlExporter{})
+}
+
+// NewExporter returns the exporter for the given format name.
+func NewExporter(format string) (Exporter, error) {
+	e, ok := exporters[strings.ToLower(format)]
+	if !ok {
+		return nil, fmt.Errorf("unknown export format %q: must be one of %v", format, Formats())
+	}
+	return e, nil
+}
+
+// Formats returns the names of all supported export formats in sorted order.
+func Formats() []string {
+	names := make([]string, 0, len(exporters))
+	for name := range exporters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// BuildExportData converts repositories into the export data model,
+// including heuristic reasons, reason codes and scores. The assessor adds
+// stored README and AI verdict signals; nil uses repository metadata only.
+func BuildExportData(repos []github.Repository, owner string, assessor *analyze.Assessor) ExportData {
+	exportedRepos := make([]ExportedRepo, 0, len(repos))
+
+	for _, repo := range repos {
+		assessment := assessor.Assess(repo)
+
+		exportedRepos = append(exportedRepos, ExportedRepo{
+			Name:              repo.Name,
+			FullName:          repo.FullName(),
+			Description:       repo.Description,
+			Stars:             repo.StargazerCount,
+			Forks:             repo.ForkCount,
+			DaysSinceActivity: repo.DaysSinceActivity,
+			Reason:            assessment.Summary(),
+			ReasonCodes:       assessment.Codes(),
+			Score:             assessment.Score,
+			Language:          repo.PrimaryLanguage,
+			LastPush:          repo.PushedAt,
+			IsFork:            repo.IsFork,
+			IsPrivate:         repo.IsPrivate,
+			IsArchived:        repo.IsArchived,
+		})
+	}
+
+	return ExportData{
+		ExportedAt:   time.Now(),
+		Owner:        owner,
+		TotalMarked:  len(repos),
+		Repositories: exportedRepos,
+	}
+}
+
+// DefaultFilename returns the timestamped filename used when no output path is given.
+func DefaultFilename(e Exporter) string {
+	return fmt.Sprintf("archived-repos-%s.%s", time.Now().Format("2006-01-02-150405"), e.Extension())
+}
+
+// ExportTo writes repositories in the given format to path.
+// An empty path writes to a timestamped file in the current directory and
+// Stdout ("-") writes to standard output. Returns the path written.
+func ExportTo(repos []github.Repository, owner string, assessor *analyze.Assessor, format, path string) (string, error) {
+	e, err := NewExporter(format)
+	if err != nil {
+		return "", err
+	}
+	return WriteData(e, BuildExportData(repos, owner, assessor), path)
+}
+
+// WriteData renders data with the exporter to path, following the same
+// path conventions as ExportTo.
+func WriteData(e Exporter, data ExportData, path string) (string, error) {
+	if path == Stdout {
+		if err := e.Write(os.Stdout, data); err != nil {
+			return "", fmt.Errorf("failed to write %s export: %w", e.Format(), err)
+		}
+		return Stdout, nil
+	}
+
+	if path == "" {
+		path = DefaultFilename(e)
+	}
+
+	var b strings.Builder
+	if err := e.Write(&b, data); err != nil {
+		return "", fmt.Errorf("failed to render %s export: %w", e.Format(), err)
+	}
+
+	if err := os.WriteFile(path, []byte(b.String()), 0644); err != nil {
+		return "", fmt.Errorf("failed to write file: %w", err)
+	}
+
+	return path, nil
+}
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+// sampleData returns export data with one archive candidate.
+func sampleData() ExportData {
+	repo := testutil.NewTestRepo(
+		testutil.WithName("old|repo"),
+		testutil.WithDescription("Uses <script> & pipes"),
+		testutil.WithDaysInactive(800),
+		testutil.WithStars(0),
+		testutil.WithForks(0),
+	)
+	return BuildExportData([]github.Repository{repo}, "testowner", nil)
+}
+
+func TestNewExporter(t *testing.T) {
+	for _, format := range []string{"json", "csv", "markdown", "html", "yaml", "CSV"} {
+		t.Run(format, func(t *testing.T) {
+			e, err := NewExporter(format)
+			require.NoError(t, err)
+			assert.Equal(t, strings.ToLower(format), e.Format())
+		})
+	}
+
+	_, err := NewExporter("xml")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown export format")
+}
+
+func TestFormats(t *testing.T) {
+	assert.Equal(t, []string{"csv", "html", "json", "markdown", "yaml"}, Formats())
+}
+
+func TestBuildExportData_ReasonCodesAndScore(t *testing.T) {
+	data := sampleData()
+
+	require.Len(t, data.Repositories, 1)
+	repo := data.Repositories[0]
+	assert.Equal(t, []string{"inactive_2y", "no_engagement"}, repo.ReasonCodes)
+	assert.Equal(t, 60, repo.Score)
+	assert.Equal(t, "No activity in 2+ years; No community engagement", repo.Reason)
+}
+
+func TestCSVExporter(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, csvExporter{}.Write(&buf, sampleData()))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	assert.Equal(t, "name", records[0][0])
+	assert.Contains(t, records[0], "reason_codes")
+	assert.Contains(t, records[0], "score")
+	assert.Equal(t, "old|repo", records[1][0])
+	assert.Contains(t, records[1], "inactive_2y;no_engagement")
+}
+
+func TestMarkdownExporter(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, markdownExporter{}.Write(&buf, sampleData()))
+	out := buf.String()
+
+	assert.Contains(t, out, "# Repositories for testowner")
+	assert.Contains(t, out, "| Name | Repository |")
+	assert.Contains(t, out, `old\|repo`, "pipes in cells should be escaped")
+	assert.Contains(t, out, "1 repository.")
+}
+
+func TestHTMLExporter(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, htmlExporter{}.Write(&buf, sampleData()))
+	out := buf.String()
+
+	assert.True(t, strings.HasPrefix(out, "<!DOCTYPE html>"))
+	assert.Contains(t, out, "<th>Reason Codes</th>")
+	assert.Contains(t, out, "&lt;script&gt;", "content should be HTML escaped")
+	assert.NotContains(t, out, "<script>")
+}
+
+func TestYAMLExporter(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, yamlExporter{}.Write(&buf, sampleData()))
+
+	var decoded ExportData
+	require.NoError(t, yaml.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, "testowner", decoded.Owner)
+	require.Len(t, decoded.Repositories, 1)
+	assert.Equal(t, 60, decoded.Repositories[0].Score)
+	assert.Contains(t, buf.String(), "reason_codes:")
+}
+
+func TestExportTo_Path(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.md")
+
+	written, err := ExportTo([]github.Repository{testutil.NewTestRepo()}, "testowner", nil, "markdown", path)
+	require.NoError(t, err)
+	assert.Equal(t, path, written)
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "testowner/testrepo")
+}
+
+func TestExportTo_DefaultFilenameUsesExtension(t *testing.T) {
+	tmpDir := t.TempDir()
+	oldWd, _ := os.Getwd()
+	require.NoError(t, os.Chdir(tmpDir))
+	defer func() { _ = os.Chdir(oldWd) }()
+
+	written, err := ExportTo([]github.Repository{testutil.NewTestRepo()}, "testowner", nil, "yaml", "")
+	require.NoError(t, err)
+	assert.True(t, strings.HasSuffix(written, ".yaml"), "got %q", written)
+}
+
+func TestExportTo_UnknownFormat(t *testing.T) {
+	_, err := ExportTo(nil, "testowner", nil, "xml", "")
+	assert.Error(t, err)
+}
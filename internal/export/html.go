@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package export
+
+import (
+	"html/template"
+	"io"
+)
+
+// htmlReportTemplate is a standalone HTML report with inline styles.
+var htmlReportTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Repositories for {{.Data.Owner}}</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2rem; color: #222; }
+h1 { color: #7D56F4; }
+table { border-collapse: collapse; width: 100%; font-size: 0.9rem; }
+th, td { border: 1px solid #ddd; padding: 0.4rem 0.6rem; text-align: left; vertical-align: top; }
+th { background: #f4f1fe; }
+tr:nth-child(even) { background: #fafafa; }
+.meta { color: #626262; }
+</style>
+</head>
+<body>
+<h1>Repositories for {{.Data.Owner}}</h1>
+<p class="meta">Exported {{.Data.ExportedAt.Format "2006-01-02 15:04 MST"}} &middot; {{.Data.TotalMarked}} repositories</p>
+<table>
+<thead>
+<tr>{{range .Columns}}<th>{{.Label}}</th>{{end}}</tr>
+</thead>
+<tbody>
+{{range .Rows}}<tr>{{range .}}<td>{{.}}</td>{{end}}</tr>
+{{end}}</tbody>
+</table>
+</body>
+</html>
+`))
+
+// htmlExporter writes export data as a standalone HTML report.
+type htmlExporter struct{}
+
+// Format implements Exporter.
+func (htmlExporter) Format() string { return "html" }
+
+// Extension implements Exporter.
+func (htmlExporter) Extension() string { return "html" }
+
+// Write implements Exporter.
+func (htmlExporter) Write(w io.Writer, data ExportData) error {
+	rows := make([][]string, len(data.Repositories))
+	for i, repo := range data.Repositories {
+		row := make([]string, len(columns))
+		for j, c := range columns {
+			row[j] = c.Value(repo)
+		}
+		rows[i] = row
+	}
+
+	return htmlReportTemplate.Execute(w, struct {
+		Data    ExportData
+		Columns []column
+		Rows    [][]string
+	}{data, columns, rows})
+}
@@ -7,74 +7,59 @@ package export
 import (
 	"encoding/json"
 	"fmt"
-	"os"
+	"io"
 	"time"
 
-	"github.com/llbbl/repjan/internal/analyze"
 	"github.com/llbbl/repjan/internal/github"
 )
 
 // ExportData represents the complete export structure.
 type ExportData struct {
-	ExportedAt   time.Time      `json:"exported_at"`
-	Owner        string         `json:"owner"`
-	TotalMarked  int            `json:"total_marked"`
-	Repositories []ExportedRepo `json:"repositories"`
+	ExportedAt   time.Time      `json:"exported_at" yaml:"exported_at"`
+	Owner        string         `json:"owner" yaml:"owner"`
+	TotalMarked  int            `json:"total_marked" yaml:"total_marked"`
+	Repositories []ExportedRepo `json:"repositories" yaml:"repositories"`
 }
 
 // ExportedRepo represents a single repository in the export.
 type ExportedRepo struct {
-	Name              string    `json:"name"`
-	FullName          string    `json:"full_name"`
-	Stars             int       `json:"stars"`
-	Forks             int       `json:"forks"`
-	DaysSinceActivity int       `json:"days_since_activity"`
-	Reason            string    `json:"reason"`
-	Language          string    `json:"language"`
-	LastPush          time.Time `json:"last_push"`
-	IsFork            bool      `json:"is_fork"`
-	IsPrivate         bool      `json:"is_private"`
+	Name              string    `json:"name" yaml:"name"`
+	FullName          string    `json:"full_name" yaml:"full_name"`
+	Description       string    `json:"description" yaml:"description"`
+	Stars             int       `json:"stars" yaml:"stars"`
+	Forks             int       `json:"forks" yaml:"forks"`
+	DaysSinceActivity int       `json:"days_since_activity" yaml:"days_since_activity"`
+	Reason            string    `json:"reason" yaml:"reason"`
+	ReasonCodes       []string  `json:"reason_codes" yaml:"reason_codes"`
+	Score             int       `json:"score" yaml:"score"`
+	Language          string    `json:"language" yaml:"language"`
+	LastPush          time.Time `json:"last_push" yaml:"last_push"`
+	IsFork            bool      `json:"is_fork" yaml:"is_fork"`
+	IsPrivate         bool      `json:"is_private" yaml:"is_private"`
+	IsArchived        bool      `json:"is_archived" yaml:"is_archived"`
 }
 
-// Export writes marked repositories as JSON to a timestamped file.
-// Returns the filename on success or an empty string with an error on failure.
-func Export(repos []github.Repository, owner string) (string, error) {
-	exportedRepos := make([]ExportedRepo, 0, len(repos))
+// jsonExporter writes export data as indented JSON.
+type jsonExporter struct{}
 
-	for _, repo := range repos {
-		_, reason := analyze.IsArchiveCandidate(repo)
+// Format implements Exporter.
+func (jsonExporter) Format() string { return "json" }
 
-		exportedRepos = append(exportedRepos, ExportedRepo{
-			Name:              repo.Name,
-			FullName:          repo.FullName(),
-			Stars:             repo.StargazerCount,
-			Forks:             repo.ForkCount,
-			DaysSinceActivity: repo.DaysSinceActivity,
-			Reason:            reason,
-			Language:          repo.PrimaryLanguage,
-			LastPush:          repo.PushedAt,
-			IsFork:            repo.IsFork,
-			IsPrivate:         repo.IsPrivate,
-		})
-	}
-
-	data := ExportData{
-		ExportedAt:   time.Now(),
-		Owner:        owner,
-		TotalMarked:  len(repos),
-		Repositories: exportedRepos,
-	}
+// Extension implements Exporter.
+func (jsonExporter) Extension() string { return "json" }
 
+// Write implements Exporter.
+func (jsonExporter) Write(w io.Writer, data ExportData) error {
 	jsonData, err := json.MarshalIndent(data, "", "  ")
 	if err != nil {
-		return "", fmt.Errorf("failed to marshal JSON: %w", err)
-	}
-
-	filename := fmt.Sprintf("archived-repos-%s.json", time.Now().Format("2006-01-02-150405"))
-
-	if err := os.WriteFile(filename, jsonData, 0644); err != nil {
-		return "", fmt.Errorf("failed to write file: %w", err)
+		return fmt.Errorf("failed to marshal JSON: %w", err)
 	}
+	_, err = w.Write(jsonData)
+	return err
+}
 
-	return filename, nil
+// Export writes marked repositories as JSON to a timestamped file.
+// Returns the filename on success or an empty string with an error on failure.
+func Export(repos []github.Repository, owner string) (string, error) {
+	return ExportTo(repos, owner, nil, "json", "")
 }
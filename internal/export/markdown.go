@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package export
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// markdownExporter writes export data as a Markdown document with a single table.
+type markdownExporter struct{}
+
+// Format implements Exporter.
+func (markdownExporter) Format() string { return "markdown" }
+
+// Extension implements Exporter.
+func (markdownExporter) Extension() string { return "md" }
+
+// Write implements Exporter.
+func (markdownExporter) Write(w io.Writer, data ExportData) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# Repositories for %s\n\n", data.Owner)
+	fmt.Fprintf(&b, "Exported %s: %d repositor%s.\n\n",
+		data.ExportedAt.Format("2006-01-02 15:04 MST"), data.TotalMarked, pluralY(data.TotalMarked))
+
+	labels := make([]string, len(columns))
+	separators := make([]string, len(columns))
+	for i, c := range columns {
+		labels[i] = c.Label
+		separators[i] = "---"
+	}
+	fmt.Fprintf(&b, "| %s |\n", strings.Join(labels, " | "))
+	fmt.Fprintf(&b, "| %s |\n", strings.Join(separators, " | "))
+
+	for _, repo := range data.Repositories {
+		cells := make([]string, len(columns))
+		for i, c := range columns {
+			cells[i] = escapeMarkdownCell(c.Value(repo))
+		}
+		fmt.Fprintf(&b, "| %s |\n", strings.Join(cells, " | "))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// escapeMarkdownCell escapes characters that would break a Markdown table cell.
+func escapeMarkdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	s = strings.ReplaceAll(s, "\r\n", " ")
+	return strings.ReplaceAll(s, "\n", " ")
+}
+
+// pluralY returns "y" for a count of one and "ies" otherwise.
+func pluralY(n int) string {
+	if n == 1 {
+		return "y"
+	}
+	return "ies"
+}
@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package export
+
+import (
+	"embed"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+//go:embed templates/*.tmpl
+var builtinTemplates embed.FS
+
+// TemplateData is the data model passed to export templates.
+//
+// Templates see the top-level fields below; each entry in Repositories has
+// every ExportedRepo field (Name, FullName, Description, Stars, Forks,
+// DaysSinceActivity, Reason, ReasonCodes, Score, Language, LastPush, IsFork,
+// IsPrivate, IsArchived) plus URL and History.
+type TemplateData struct {
+	ExportedAt   time.Time
+	Owner        string
+	Total        int
+	Repositories []TemplateRepo
+}
+
+// TemplateRepo is a single repository as seen by export templates.
+type TemplateRepo struct {
+	ExportedRepo
+	URL     string         // GitHub web URL
+	History []HistoryEntry // recorded changes, newest first
+}
+
+// HistoryEntry is a recorded change to a repository, as seen by export templates.
+type HistoryEntry struct {
+	Action      string
+	PerformedAt time.Time
+	PerformedBy string
+	Notes       string
+}
+
+// historyLimit caps the number of history entries loaded per repository.
+const historyLimit = 20
+
+// LoadHistory reads the recorded changes for each repository from the store,
+// keyed by full name for use as TemplateExporter.History.
+func LoadHistory(s *store.Store, owner string, repos []github.Repository) (map[string][]HistoryEntry, error) {
+	history := make(map[string][]HistoryEntry, len(repos))
+	for _, repo := range repos {
+		changes, err := s.GetRepoHistory(owner, repo.Name, historyLimit)
+		if err != nil {
+			return nil, fmt.Errorf("loading history for %s: %w", repo.FullName(), err)
+		}
+		entries := make([]HistoryEntry, len(changes))
+		for i, c := range changes {
+			entries[i] = HistoryEntry{
+				Action:      c.Action,
+				PerformedAt: c.PerformedAt,
+				PerformedBy: c.PerformedBy,
+				Notes:       c.Notes,
+			}
+		}
+		history[repo.FullName()] = entries
+	}
+	return history, nil
+}
+
+// templateFuncs are the helper functions available to export templates.
+var templateFuncs = template.FuncMap{
+	"join":  strings.Join,
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"date":  formatDay,
+	"csv":   csvField,
+	"add":   func(a, b int) int { return a + b },
+}
+
+// TemplateExporter renders export data with a Go text/template.
+type TemplateExporter struct {
+	name string
+	ext  string
+	tmpl *template.Template
+
+	// History holds recorded changes keyed by repository full name.
+	// Repositories without an entry get an empty history.
+	History map[string][]HistoryEntry
+}
+
+// NewTemplateExporter parses text as a template named name whose output uses
+// the file extension ext.
+func NewTemplateExporter(name, ext, text string) (*TemplateExporter, error) {
+	tmpl, err := template.New(name).Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parsing template %s: %w", name, err)
+	}
+	return &TemplateExporter{name: name, ext: ext, tmpl: tmpl}, nil
+}
+
+// LoadTemplate reads a template file. The output extension comes from the file
+// name with any .tmpl suffix removed (e.g. "report.md.tmpl" writes .md files),
+// falling back to "txt".
+func LoadTemplate(filename string) (*TemplateExporter, error) {
+	text, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("reading template: %w", err)
+	}
+	name, ext := splitTemplateName(filepath.Base(filename))
+	return NewTemplateExporter(name, ext, string(text))
+}
+
+// BuiltinTemplate returns the built-in template with the given name.
+func BuiltinTemplate(name string) (*TemplateExporter, error) {
+	matches, err := builtinTemplates.ReadDir("templates")
+	if err != nil {
+		return nil, fmt.Errorf("reading built-in templates: %w", err)
+	}
+	for _, entry := range matches {
+		base, ext := splitTemplateName(entry.Name())
+		if base != name {
+			continue
+		}
+		text, err := builtinTemplates.ReadFile(path.Join("templates", entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("reading built-in template %s: %w", name, err)
+		}
+		return NewTemplateExporter(base, ext, string(text))
+	}
+	return nil, fmt.Errorf("unknown built-in template %q: must be one of %v", name, BuiltinTemplates())
+}
+
+// BuiltinTemplates returns the names of the built-in templates in sorted order.
+func BuiltinTemplates() []string {
+	entries, err := builtinTemplates.ReadDir("templates")
+	if err != nil {
+		return nil
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		name, _ := splitTemplateName(entry.Name())
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ResolveTemplate returns the built-in template called nameOrPath, or loads
+// nameOrPath as a template file if no built-in has that name.
+func ResolveTemplate(nameOrPath string) (*TemplateExporter, error) {
+	for _, name := range BuiltinTemplates() {
+		if name == nameOrPath {
+			return BuiltinTemplate(name)
+		}
+	}
+	return LoadTemplate(nameOrPath)
+}
+
+// Format implements Exporter.
+func (e *TemplateExporter) Format() string { return "template:" + e.name }
+
+// Extension implements Exporter.
+func (e *TemplateExporter) Extension() string { return e.ext }
+
+// Write implements Exporter.
+func (e *TemplateExporter) Write(w io.Writer, data ExportData) error {
+	if err := e.tmpl.Execute(w, e.templateData(data)); err != nil {
+		return fmt.Errorf("executing template %s: %w", e.name, err)
+	}
+	return nil
+}
+
+// templateData builds the template data model from export data and history.
+func (e *TemplateExporter) templateData(data ExportData) TemplateData {
+	repos := make([]TemplateRepo, len(data.Repositories))
+	for i, repo := range data.Repositories {
+		repos[i] = TemplateRepo{
+			ExportedRepo: repo,
+			URL:          "https://github.com/" + repo.FullName,
+			History:      e.History[repo.FullName],
+		}
+	}
+	return TemplateData{
+		ExportedAt:   data.ExportedAt,
+		Owner:        data.Owner,
+		Total:        len(repos),
+		Repositories: repos,
+	}
+}
+
+// splitTemplateName splits a template file name such as "jira.csv.tmpl" into
+// its name ("jira") and output extension ("csv").
+func splitTemplateName(filename string) (name, ext string) {
+	base := strings.TrimSuffix(filename, ".tmpl")
+	ext = strings.TrimPrefix(filepath.Ext(base), ".")
+	name = strings.TrimSuffix(base, filepath.Ext(base))
+	if ext == "" {
+		ext = "txt"
+	}
+	return name, ext
+}
+
+// formatDay formats a time as YYYY-MM-DD, or an empty string if zero.
+func formatDay(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format("2006-01-02")
+}
+
+// csvField quotes s as a single CSV field when it contains separators,
+// quotes or newlines.
+func csvField(s string) string {
+	if !strings.ContainsAny(s, ",\"\r\n") {
+		return s
+	}
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+func TestBuiltinTemplates(t *testing.T) {
+	assert.Equal(t, []string{"change-request", "email", "jira"}, BuiltinTemplates())
+
+	for _, name := range BuiltinTemplates() {
+		t.Run(name, func(t *testing.T) {
+			e, err := BuiltinTemplate(name)
+			require.NoError(t, err)
+			assert.Equal(t, "template:"+name, e.Format())
+
+			var buf bytes.Buffer
+			require.NoError(t, e.Write(&buf, sampleData()))
+			assert.Contains(t, buf.String(), "old|repo")
+		})
+	}
+
+	_, err := BuiltinTemplate("missing")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown built-in template")
+}
+
+func TestBuiltinTemplate_JiraIsValidCSV(t *testing.T) {
+	e, err := BuiltinTemplate("jira")
+	require.NoError(t, err)
+	assert.Equal(t, "csv", e.Extension())
+
+	var buf bytes.Buffer
+	require.NoError(t, e.Write(&buf, sampleData()))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, []string{"Summary", "Description", "Issue Type", "Priority", "Labels"}, records[0])
+	assert.Equal(t, "Archive testowner/old|repo", records[1][0])
+	assert.Contains(t, records[1][1], "Score: 60/100")
+	assert.Equal(t, "Medium", records[1][3])
+	assert.Equal(t, "inactive_2y no_engagement", records[1][4])
+}
+
+func TestTemplateExporter_History(t *testing.T) {
+	e, err := NewTemplateExporter("history", "txt",
+		`{{range .Repositories}}{{.FullName}} {{.URL}}{{range .History}} [{{.Action}} by {{.PerformedBy}} on {{date .PerformedAt}}]{{end}}{{end}}`)
+	require.NoError(t, err)
+
+	e.History = map[string][]HistoryEntry{
+		"testowner/old|repo": {{Action: "marked", PerformedBy: "user", PerformedAt: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, e.Write(&buf, sampleData()))
+	assert.Equal(t, "testowner/old|repo https://github.com/testowner/old|repo [marked by user on 2026-03-01]", buf.String())
+}
+
+func TestLoadTemplate(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "names.md.tmpl")
+	require.NoError(t, os.WriteFile(path, []byte(`{{range .Repositories}}- {{upper .Name}}{{"\n"}}{{end}}`), 0644))
+
+	e, err := LoadTemplate(path)
+	require.NoError(t, err)
+	assert.Equal(t, "template:names", e.Format())
+	assert.Equal(t, "md", e.Extension())
+
+	out := filepath.Join(dir, "out.md")
+	written, err := WriteData(e, sampleData(), out)
+	require.NoError(t, err)
+	data, err := os.ReadFile(written)
+	require.NoError(t, err)
+	assert.Equal(t, "- OLD|REPO\n", string(data))
+
+	resolved, err := ResolveTemplate(path)
+	require.NoError(t, err)
+	assert.Equal(t, "template:names", resolved.Format())
+
+	_, err = LoadTemplate(filepath.Join(dir, "missing.tmpl"))
+	require.Error(t, err)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{{.Nope`), 0644))
+	_, err = LoadTemplate(path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "parsing template")
+}
+
+func TestSplitTemplateName(t *testing.T) {
+	tests := []struct {
+		filename string
+		wantName string
+		wantExt  string
+	}{
+		{"jira.csv.tmpl", "jira", "csv"},
+		{"notes.tmpl", "notes", "txt"},
+		{"report.md", "report", "md"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			name, ext := splitTemplateName(tt.filename)
+			assert.Equal(t, tt.wantName, name)
+			assert.Equal(t, tt.wantExt, ext)
+		})
+	}
+}
+
+func TestCSVField(t *testing.T) {
+	assert.Equal(t, "plain", csvField("plain"))
+	assert.Equal(t, `"a,b"`, csvField("a,b"))
+	assert.Equal(t, `"say ""hi"""`, csvField(`say "hi"`))
+	assert.Equal(t, "\"two\nlines\"", csvField("two\nlines"))
+}
+
+func TestLoadHistory(t *testing.T) {
+	s := testutil.NewStore(t)
+	require.NoError(t, s.RecordRepoChange("testowner", "repo1", "marked", "user", nil, nil, "cleanup"))
+
+	repos := []github.Repository{
+		testutil.NewTestRepo(testutil.WithOwner("testowner"), testutil.WithName("repo1")),
+		testutil.NewTestRepo(testutil.WithOwner("testowner"), testutil.WithName("repo2")),
+	}
+
+	history, err := LoadHistory(s, "testowner", repos)
+	require.NoError(t, err)
+	require.Len(t, history["testowner/repo1"], 1)
+	assert.Equal(t, "marked", history["testowner/repo1"][0].Action)
+	assert.Equal(t, "cleanup", history["testowner/repo1"][0].Notes)
+	assert.Empty(t, history["testowner/repo2"])
+}
@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package export
+
+import (
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+// yamlExporter writes export data as YAML using the same field names as JSON.
+type yamlExporter struct{}
+
+// Format implements Exporter.
+func (yamlExporter) Format() string { return "yaml" }
+
+// Extension implements Exporter.
+func (yamlExporter) Extension() string { return "yaml" }
+
+// Write implements Exporter.
+func (yamlExporter) Write(w io.Writer, data ExportData) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(data); err != nil {
+		return err
+	}
+	return enc.Close()
+}
@@ -5,11 +5,13 @@
 package github
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
+	"strconv"
 	"strings"
 )
 
@@ -49,7 +51,7 @@ func (c *Client) FetchRepositories(owner string) ([]Repository, error) {
 
 	output, err := c.executor.Execute(
 		"gh", "repo", "list", owner,
-		"--json", "name,description,pushedAt,createdAt,stargazerCount,forkCount,isArchived,isFork,isPrivate,primaryLanguage,owner",
+		"--json", "name,description,pushedAt,createdAt,stargazerCount,forkCount,isArchived,isFork,isPrivate,primaryLanguage,owner,repositoryTopics",
 		"--limit", "1000",
 	)
 	if err != nil {
@@ -75,6 +77,77 @@ func (c *Client) FetchRepositories(owner string) ([]Repository, error) {
 	return repos, nil
 }
 
+// AddIssueActivity fetches the issue activity of owner's repositories and
+// sets it on repos. On error repos are left without activity.
+func (c *Client) AddIssueActivity(owner string, repos []Repository) error {
+	activity, err := c.FetchIssueActivity(owner)
+	if err != nil {
+		return err
+	}
+	for i := range repos {
+		if a, ok := activity[repos[i].Name]; ok {
+			repos[i].IssueActivity = &a
+		}
+	}
+	return nil
+}
+
+// issueActivityQuery fetches open item counts and the latest issue, pull
+// request and discussion update for each of an owner's repositories.
+const issueActivityQuery = `query($owner: String!, $endCursor: String) {
+  repositoryOwner(login: $owner) {
+    repositories(first: 100, after: $endCursor) {
+      nodes {
+        name
+        issues(states: OPEN) { totalCount }
+        pullRequests(states: OPEN) { totalCount }
+        discussions(states: OPEN) { totalCount }
+        latestIssue: issues(first: 1, orderBy: {field: UPDATED_AT, direction: DESC}) { nodes { updatedAt } }
+        latestPullRequest: pullRequests(first: 1, orderBy: {field: UPDATED_AT, direction: DESC}) { nodes { updatedAt } }
+        latestDiscussion: discussions(first: 1, orderBy: {field: UPDATED_AT, direction: DESC}) { nodes { updatedAt } }
+      }
+      pageInfo { hasNextPage endCursor }
+    }
+  }
+}`
+
+// issueActivityJQ flattens each repository node into one IssueActivity
+// object plus its name. ISO 8601 timestamps compare correctly as strings.
+const issueActivityJQ = `.data.repositoryOwner.repositories.nodes[] | {name,
+  openIssues: .issues.totalCount,
+  openPullRequests: .pullRequests.totalCount,
+  openDiscussions: .discussions.totalCount,
+  lastUpdated: ([.latestIssue.nodes[0].updatedAt, .latestPullRequest.nodes[0].updatedAt, .latestDiscussion.nodes[0].updatedAt] | map(select(. != null)) | max)}`
+
+// FetchIssueActivity fetches the open issues, pull requests and discussions
+// and their latest update for all repositories of owner, keyed by name.
+func (c *Client) FetchIssueActivity(owner string) (map[string]IssueActivity, error) {
+	if owner == "" {
+		return nil, fmt.Errorf("owner cannot be empty")
+	}
+
+	output, err := c.executor.Execute("gh", "api", "graphql", "--paginate",
+		"-f", "owner="+owner, "-f", "query="+issueActivityQuery, "--jq", issueActivityJQ)
+	if err != nil {
+		return nil, c.wrapError(err, output, "fetching issue activity for %s", owner)
+	}
+
+	// --jq prints one JSON object per repository
+	activity := make(map[string]IssueActivity)
+	decoder := json.NewDecoder(bytes.NewReader(output))
+	for decoder.More() {
+		var node struct {
+			Name string `json:"name"`
+			IssueActivity
+		}
+		if err := decoder.Decode(&node); err != nil {
+			return nil, fmt.Errorf("parsing issue activity: %w", err)
+		}
+		activity[node.Name] = node.IssueActivity
+	}
+	return activity, nil
+}
+
 // GetAuthenticatedUser returns the login of the currently authenticated user.
 // This is useful when --owner flag is not provided.
 func (c *Client) GetAuthenticatedUser() (string, error) {
@@ -151,6 +224,67 @@ func (c *Client) UnarchiveRepository(owner, name string) error {
 	return nil
 }
 
+// SetVisibility makes a repository private or public. Making a public
+// repository private removes its stars and watchers and detaches its forks.
+func (c *Client) SetVisibility(owner, name string, private bool) error {
+	if owner == "" || name == "" {
+		return fmt.Errorf("owner and name cannot be empty")
+	}
+
+	visibility := "public"
+	if private {
+		visibility = "private"
+	}
+	repoFullName := owner + "/" + name
+	slog.Debug("changing repository visibility", "component", "github", "repo", repoFullName, "visibility", visibility)
+
+	output, err := c.executor.Execute("gh", "repo", "edit", repoFullName,
+		"--visibility", visibility, "--accept-visibility-change-consequences")
+	if err != nil {
+		return c.wrapError(err, output, "making repository %s %s", repoFullName, visibility)
+	}
+	return nil
+}
+
+// DeleteRepository permanently deletes a repository. The gh token needs the
+// delete_repo scope.
+func (c *Client) DeleteRepository(owner, name string) error {
+	if owner == "" || name == "" {
+		return fmt.Errorf("owner and name cannot be empty")
+	}
+
+	repoFullName := owner + "/" + name
+	slog.Debug("deleting repository", "component", "github", "repo", repoFullName)
+
+	output, err := c.executor.Execute("gh", "repo", "delete", repoFullName, "--yes")
+	if err != nil {
+		return c.wrapError(err, output, "deleting repository %s", repoFullName)
+	}
+	return nil
+}
+
+// TransferRepository transfers a repository to newOwner, an organization or
+// user. The repository keeps its name.
+func (c *Client) TransferRepository(owner, name, newOwner string) error {
+	if owner == "" || name == "" || newOwner == "" {
+		return fmt.Errorf("owner, name and new owner cannot be empty")
+	}
+
+	repoFullName := owner + "/" + name
+	slog.Debug("transferring repository",
+		"component", "github",
+		"repo", repoFullName,
+		"newOwner", newOwner,
+	)
+
+	endpoint := fmt.Sprintf("repos/%s/%s/transfer", owner, name)
+	output, err := c.executor.Execute("gh", "api", "-X", "POST", endpoint, "-f", "new_owner="+newOwner, "--silent")
+	if err != nil {
+		return c.wrapError(err, output, "transferring repository %s to %s", repoFullName, newOwner)
+	}
+	return nil
+}
+
 // FetchReadme fetches the README content for the specified repository.
 // Returns an empty string (not an error) if no README exists.
 func (c *Client) FetchReadme(owner, name string) (string, error) {
@@ -185,6 +319,450 @@ func (c *Client) FetchReadme(owner, name string) (string, error) {
 	return string(decoded), nil
 }
 
+// commitsJQ flattens the commits API response into a JSON array of Commit.
+const commitsJQ = `[.[] | {login: (.author.login // ""), name: .commit.author.name, email: .commit.author.email, date: .commit.committer.date}]`
+
+// FetchRecentCommits fetches up to limit of the most recent commits on the
+// default branch, newest first. Returns nil (not an error) for an empty
+// repository.
+func (c *Client) FetchRecentCommits(owner, name string, limit int) ([]Commit, error) {
+	if owner == "" || name == "" {
+		return nil, fmt.Errorf("owner and name cannot be empty")
+	}
+	if limit < 1 || limit > 100 {
+		return nil, fmt.Errorf("invalid commit limit %d: must be between 1 and 100", limit)
+	}
+
+	endpoint := fmt.Sprintf("repos/%s/%s/commits?per_page=%d", owner, name, limit)
+	output, err := c.executor.Execute("gh", "api", endpoint, "--jq", commitsJQ)
+	if err != nil {
+		// GitHub answers 409 Conflict for a repository without commits
+		combined := strings.ToLower(string(output)) + " " + strings.ToLower(err.Error())
+		if strings.Contains(combined, "409") || strings.Contains(combined, "repository is empty") {
+			return nil, nil
+		}
+		return nil, c.wrapError(err, output, "fetching commits for %s/%s", owner, name)
+	}
+
+	var commits []Commit
+	if err := json.Unmarshal(output, &commits); err != nil {
+		return nil, fmt.Errorf("parsing commit list: %w", err)
+	}
+	return commits, nil
+}
+
+// archiveImpactQuery fetches the GraphQL-visible parts of an ArchiveImpact.
+const archiveImpactQuery = `query($owner: String!, $name: String!) {
+  repository(owner: $owner, name: $name) {
+    issues(states: OPEN) { totalCount }
+    pullRequests(states: OPEN) { totalCount }
+    releases(first: 10, orderBy: {field: CREATED_AT, direction: DESC}) {
+      nodes { tagName publishedAt releaseAssets(first: 50) { nodes { downloadCount } } }
+    }
+    deployments(first: 1, orderBy: {field: CREATED_AT, direction: DESC}) { nodes { createdAt environment } }
+    packages(first: 20) { nodes { name } }
+  }
+}`
+
+// archiveImpactJQ maps the archiveImpactQuery response onto ArchiveImpact.
+const archiveImpactJQ = `.data.repository | {
+  openIssues: .issues.totalCount,
+  openPullRequests: .pullRequests.totalCount,
+  latestRelease: (.releases.nodes[0].tagName // ""),
+  latestReleaseAt: .releases.nodes[0].publishedAt,
+  releaseDownloads: ([.releases.nodes[].releaseAssets.nodes[].downloadCount] | add // 0),
+  lastDeploymentAt: .deployments.nodes[0].createdAt,
+  deploymentEnvironment: (.deployments.nodes[0].environment // ""),
+  packages: [.packages.nodes[].name]}`
+
+// FetchArchiveImpact collects what archiving a repository would affect. Listing
+// webhooks needs admin access; without it Webhooks is -1.
+func (c *Client) FetchArchiveImpact(owner, name string) (ArchiveImpact, error) {
+	if owner == "" || name == "" {
+		return ArchiveImpact{}, fmt.Errorf("owner and name cannot be empty")
+	}
+
+	output, err := c.executor.Execute("gh", "api", "graphql",
+		"-f", "owner="+owner, "-f", "name="+name, "-f", "query="+archiveImpactQuery, "--jq", archiveImpactJQ)
+	if err != nil {
+		return ArchiveImpact{}, c.wrapError(err, output, "fetching archive impact for %s/%s", owner, name)
+	}
+	var impact ArchiveImpact
+	if err := json.Unmarshal(output, &impact); err != nil {
+		return ArchiveImpact{}, fmt.Errorf("parsing archive impact: %w", err)
+	}
+
+	// A 404 means Pages is not enabled
+	output, err = c.executor.Execute("gh", "api", fmt.Sprintf("repos/%s/%s/pages", owner, name), "--jq", ".html_url")
+	if err != nil && !c.isNotFoundError(err, output) {
+		return ArchiveImpact{}, c.wrapError(err, output, "fetching Pages site for %s/%s", owner, name)
+	}
+	if err == nil {
+		impact.PagesURL = strings.TrimSpace(string(output))
+	}
+
+	output, err = c.executor.Execute("gh", "api", fmt.Sprintf("repos/%s/%s/hooks", owner, name), "--jq", "[.[] | select(.active)] | length")
+	if err != nil {
+		slog.Debug("cannot list webhooks", "component", "github", "repo", owner+"/"+name, "error", err)
+		impact.Webhooks = -1
+	} else if impact.Webhooks, err = strconv.Atoi(strings.TrimSpace(string(output))); err != nil {
+		return ArchiveImpact{}, fmt.Errorf("parsing webhook count: %w", err)
+	}
+
+	return impact, nil
+}
+
+// repoSettingsJQ flattens gh repo view output onto RepoSettings.
+const repoSettingsJQ = `{id, description, isArchived, pushedAt, topics: [.repositoryTopics[]?.name]}`
+
+// FetchRepoSettings fetches the current description, topics and archive state
+// of a repository, bypassing any cached repository list.
+func (c *Client) FetchRepoSettings(owner, name string) (RepoSettings, error) {
+	if owner == "" || name == "" {
+		return RepoSettings{}, fmt.Errorf("owner and name cannot be empty")
+	}
+
+	repoFullName := owner + "/" + name
+	output, err := c.executor.Execute("gh", "repo", "view", repoFullName,
+		"--json", "id,description,isArchived,pushedAt,repositoryTopics", "--jq", repoSettingsJQ)
+	if err != nil {
+		return RepoSettings{}, c.wrapError(err, output, "fetching settings for %s", repoFullName)
+	}
+	var settings RepoSettings
+	if err := json.Unmarshal(output, &settings); err != nil {
+		return RepoSettings{}, fmt.Errorf("parsing settings: %w", err)
+	}
+	return settings, nil
+}
+
+// AddTopic adds a topic to a repository. Adding a topic it already has is a no-op.
+func (c *Client) AddTopic(owner, name, topic string) error {
+	if owner == "" || name == "" || topic == "" {
+		return fmt.Errorf("owner, name and topic cannot be empty")
+	}
+
+	repoFullName := owner + "/" + name
+	output, err := c.executor.Execute("gh", "repo", "edit", repoFullName, "--add-topic", topic)
+	if err != nil {
+		return c.wrapError(err, output, "adding topic %s to %s", topic, repoFullName)
+	}
+	return nil
+}
+
+// RemoveTopic removes a topic from a repository. Removing a topic it does not
+// have is a no-op.
+func (c *Client) RemoveTopic(owner, name, topic string) error {
+	if owner == "" || name == "" || topic == "" {
+		return fmt.Errorf("owner, name and topic cannot be empty")
+	}
+
+	repoFullName := owner + "/" + name
+	output, err := c.executor.Execute("gh", "repo", "edit", repoFullName, "--remove-topic", topic)
+	if err != nil {
+		return c.wrapError(err, output, "removing topic %s from %s", topic, repoFullName)
+	}
+	return nil
+}
+
+// SetDescription replaces the description of a repository.
+func (c *Client) SetDescription(owner, name, description string) error {
+	if owner == "" || name == "" {
+		return fmt.Errorf("owner and name cannot be empty")
+	}
+
+	repoFullName := owner + "/" + name
+	output, err := c.executor.Execute("gh", "repo", "edit", repoFullName, "--description", description)
+	if err != nil {
+		return c.wrapError(err, output, "setting description of %s", repoFullName)
+	}
+	return nil
+}
+
+// ListOpenPullRequests returns the numbers of a repository's open pull requests.
+func (c *Client) ListOpenPullRequests(owner, name string) ([]int, error) {
+	if owner == "" || name == "" {
+		return nil, fmt.Errorf("owner and name cannot be empty")
+	}
+
+	repoFullName := owner + "/" + name
+	output, err := c.executor.Execute("gh", "pr", "list", "-R", repoFullName,
+		"--state", "open", "--limit", "1000", "--json", "number", "--jq", ".[].number")
+	if err != nil {
+		return nil, c.wrapError(err, output, "listing pull requests of %s", repoFullName)
+	}
+
+	var numbers []int
+	for _, field := range strings.Fields(string(output)) {
+		n, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, fmt.Errorf("parsing pull request number %q: %w", field, err)
+		}
+		numbers = append(numbers, n)
+	}
+	return numbers, nil
+}
+
+// ListIssues returns up to 1000 of a repository's open and closed issues,
+// most recently created first. A repository with issues disabled has none.
+func (c *Client) ListIssues(owner, name string) ([]IssueSummary, error) {
+	if owner == "" || name == "" {
+		return nil, fmt.Errorf("owner and name cannot be empty")
+	}
+
+	repoFullName := owner + "/" + name
+	output, err := c.executor.Execute("gh", "issue", "list", "-R", repoFullName,
+		"--state", "all", "--limit", "1000", "--json", "number,title,state,updatedAt")
+	if err != nil {
+		if strings.Contains(strings.ToLower(string(output)), "disabled issues") {
+			return nil, nil
+		}
+		return nil, c.wrapError(err, output, "listing issues of %s", repoFullName)
+	}
+
+	var issues []IssueSummary
+	if err := json.Unmarshal(output, &issues); err != nil {
+		return nil, fmt.Errorf("parsing issues of %s: %w", repoFullName, err)
+	}
+	return issues, nil
+}
+
+// ClosePullRequest closes a pull request, leaving comment on it first.
+func (c *Client) ClosePullRequest(owner, name string, number int, comment string) error {
+	if owner == "" || name == "" {
+		return fmt.Errorf("owner and name cannot be empty")
+	}
+
+	repoFullName := owner + "/" + name
+	args := []string{"pr", "close", strconv.Itoa(number), "-R", repoFullName}
+	if comment != "" {
+		args = append(args, "--comment", comment)
+	}
+	output, err := c.executor.Execute("gh", args...)
+	if err != nil {
+		return c.wrapError(err, output, "closing pull request #%d of %s", number, repoFullName)
+	}
+	return nil
+}
+
+// FetchReadmeFile fetches a repository's README along with its path and SHA.
+// Returns nil (not an error) if no README exists.
+func (c *Client) FetchReadmeFile(owner, name string) (*ReadmeFile, error) {
+	if owner == "" || name == "" {
+		return nil, fmt.Errorf("owner and name cannot be empty")
+	}
+
+	endpoint := fmt.Sprintf("repos/%s/%s/readme", owner, name)
+	output, err := c.executor.Execute("gh", "api", endpoint, "--jq", "{path, sha, content}")
+	if err != nil {
+		if c.isNotFoundError(err, output) {
+			return nil, nil
+		}
+		return nil, c.wrapError(err, output, "fetching README for %s/%s", owner, name)
+	}
+
+	var resp struct {
+		ReadmeFile
+		Content string `json:"content"`
+	}
+	if err := json.Unmarshal(output, &resp); err != nil {
+		return nil, fmt.Errorf("parsing README: %w", err)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(resp.Content, "\n", ""))
+	if err != nil {
+		return nil, fmt.Errorf("decoding README content: %w", err)
+	}
+	file := resp.ReadmeFile
+	file.Content = string(decoded)
+	return &file, nil
+}
+
+// ListWorkflows returns the GitHub Actions workflows of a repository, without
+// their triggers or runs. A repository without Actions has none.
+func (c *Client) ListWorkflows(owner, name string) ([]ActionsWorkflow, error) {
+	if owner == "" || name == "" {
+		return nil, fmt.Errorf("owner and name cannot be empty")
+	}
+
+	endpoint := fmt.Sprintf("repos/%s/%s/actions/workflows", owner, name)
+	output, err := c.executor.Execute("gh", "api", endpoint, "--paginate", "--jq", ".workflows[] | {id, name, path, state}")
+	if err != nil {
+		if c.isNotFoundError(err, output) {
+			return nil, nil
+		}
+		return nil, c.wrapError(err, output, "listing workflows of %s/%s", owner, name)
+	}
+
+	// --jq prints one JSON object per workflow
+	var workflows []ActionsWorkflow
+	decoder := json.NewDecoder(bytes.NewReader(output))
+	for decoder.More() {
+		var w ActionsWorkflow
+		if err := decoder.Decode(&w); err != nil {
+			return nil, fmt.Errorf("parsing workflows: %w", err)
+		}
+		workflows = append(workflows, w)
+	}
+	return workflows, nil
+}
+
+// FetchLatestWorkflowRun returns the latest run of a workflow, or nil when it
+// never ran.
+func (c *Client) FetchLatestWorkflowRun(owner, name string, workflowID int64) (*WorkflowRun, error) {
+	if owner == "" || name == "" {
+		return nil, fmt.Errorf("owner and name cannot be empty")
+	}
+
+	endpoint := fmt.Sprintf("repos/%s/%s/actions/workflows/%d/runs?per_page=1", owner, name, workflowID)
+	output, err := c.executor.Execute("gh", "api", endpoint,
+		"--jq", ".workflow_runs[0] // empty | {createdAt: .created_at, status, conclusion}")
+	if err != nil {
+		return nil, c.wrapError(err, output, "fetching runs of workflow %d in %s/%s", workflowID, owner, name)
+	}
+	if len(bytes.TrimSpace(output)) == 0 {
+		return nil, nil
+	}
+
+	var run WorkflowRun
+	if err := json.Unmarshal(output, &run); err != nil {
+		return nil, fmt.Errorf("parsing workflow run: %w", err)
+	}
+	return &run, nil
+}
+
+// FetchFileContent returns the content of a file on the default branch.
+func (c *Client) FetchFileContent(owner, name, path string) ([]byte, error) {
+	if owner == "" || name == "" || path == "" {
+		return nil, fmt.Errorf("owner, name and path cannot be empty")
+	}
+
+	endpoint := fmt.Sprintf("repos/%s/%s/contents/%s", owner, name, path)
+	output, err := c.executor.Execute("gh", "api", endpoint, "--jq", ".content")
+	if err != nil {
+		return nil, c.wrapError(err, output, "fetching %s from %s/%s", path, owner, name)
+	}
+	content, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(strings.TrimSpace(string(output)), "\n", ""))
+	if err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", path, err)
+	}
+	return content, nil
+}
+
+// DisableWorkflow disables a GitHub Actions workflow so it no longer runs.
+func (c *Client) DisableWorkflow(owner, name string, workflowID int64) error {
+	if owner == "" || name == "" {
+		return fmt.Errorf("owner and name cannot be empty")
+	}
+
+	repoFullName := owner + "/" + name
+	slog.Debug("disabling workflow", "component", "github", "repo", repoFullName, "workflow", workflowID)
+
+	output, err := c.executor.Execute("gh", "workflow", "disable", strconv.FormatInt(workflowID, 10), "-R", repoFullName)
+	if err != nil {
+		return c.wrapError(err, output, "disabling workflow %d in %s", workflowID, repoFullName)
+	}
+	return nil
+}
+
+// UpdateFile commits new content for an existing file on the default branch.
+// sha is the blob SHA of the content being replaced.
+func (c *Client) UpdateFile(owner, name, path, sha, content, message string) error {
+	if owner == "" || name == "" || path == "" {
+		return fmt.Errorf("owner, name and path cannot be empty")
+	}
+
+	endpoint := fmt.Sprintf("repos/%s/%s/contents/%s", owner, name, path)
+	output, err := c.executor.Execute("gh", "api", "-X", "PUT", endpoint,
+		"-f", "message="+message,
+		"-f", "content="+base64.StdEncoding.EncodeToString([]byte(content)),
+		"-f", "sha="+sha)
+	if err != nil {
+		return c.wrapError(err, output, "updating %s in %s/%s", path, owner, name)
+	}
+	return nil
+}
+
+// CreateIssue opens an issue on a repository.
+func (c *Client) CreateIssue(owner, name, title, body string) (Issue, error) {
+	if owner == "" || name == "" || title == "" {
+		return Issue{}, fmt.Errorf("owner, name and title cannot be empty")
+	}
+
+	endpoint := fmt.Sprintf("repos/%s/%s/issues", owner, name)
+	output, err := c.executor.Execute("gh", "api", endpoint,
+		"-f", "title="+title, "-f", "body="+body, "--jq", "{number, url: .html_url}")
+	if err != nil {
+		return Issue{}, c.wrapError(err, output, "creating issue on %s/%s", owner, name)
+	}
+	var issue Issue
+	if err := json.Unmarshal(output, &issue); err != nil {
+		return Issue{}, fmt.Errorf("parsing issue: %w", err)
+	}
+	return issue, nil
+}
+
+// issueCommentsJQ emits one IssueComment JSON object per line.
+const issueCommentsJQ = `.[] | {login: (.user.login // ""), body, createdAt: .created_at}`
+
+// FetchIssueComments fetches every comment on an issue, oldest first.
+func (c *Client) FetchIssueComments(owner, name string, number int) ([]IssueComment, error) {
+	if owner == "" || name == "" {
+		return nil, fmt.Errorf("owner and name cannot be empty")
+	}
+
+	endpoint := fmt.Sprintf("repos/%s/%s/issues/%d/comments", owner, name, number)
+	output, err := c.executor.Execute("gh", "api", endpoint, "--paginate", "--jq", issueCommentsJQ)
+	if err != nil {
+		return nil, c.wrapError(err, output, "fetching comments on %s/%s#%d", owner, name, number)
+	}
+
+	var comments []IssueComment
+	dec := json.NewDecoder(bytes.NewReader(output))
+	for dec.More() {
+		var comment IssueComment
+		if err := dec.Decode(&comment); err != nil {
+			return nil, fmt.Errorf("parsing issue comment: %w", err)
+		}
+		comments = append(comments, comment)
+	}
+	return comments, nil
+}
+
+// CloseIssue closes an issue, leaving comment on it first.
+func (c *Client) CloseIssue(owner, name string, number int, comment string) error {
+	if owner == "" || name == "" {
+		return fmt.Errorf("owner and name cannot be empty")
+	}
+
+	repoFullName := owner + "/" + name
+	args := []string{"issue", "close", strconv.Itoa(number), "-R", repoFullName}
+	if comment != "" {
+		args = append(args, "--comment", comment)
+	}
+	output, err := c.executor.Execute("gh", args...)
+	if err != nil {
+		return c.wrapError(err, output, "closing issue #%d of %s", number, repoFullName)
+	}
+	return nil
+}
+
+// ReopenIssue reopens a closed issue, leaving comment on it.
+func (c *Client) ReopenIssue(owner, name string, number int, comment string) error {
+	if owner == "" || name == "" {
+		return fmt.Errorf("owner and name cannot be empty")
+	}
+
+	repoFullName := owner + "/" + name
+	args := []string{"issue", "reopen", strconv.Itoa(number), "-R", repoFullName}
+	if comment != "" {
+		args = append(args, "--comment", comment)
+	}
+	output, err := c.executor.Execute("gh", args...)
+	if err != nil {
+		return c.wrapError(err, output, "reopening issue #%d of %s", number, repoFullName)
+	}
+	return nil
+}
+
 // wrapError wraps command execution errors with context and checks for common error types.
 func (c *Client) wrapError(err error, output []byte, format string, args ...any) error {
 	msg := fmt.Sprintf(format, args...)
@@ -133,7 +133,7 @@ func TestClient_FetchRepositories(t *testing.T) {
 			if tt.owner != "" {
 				mock.AddResponse("gh", []string{
 					"repo", "list", tt.owner,
-					"--json", "name,description,pushedAt,createdAt,stargazerCount,forkCount,isArchived,isFork,isPrivate,primaryLanguage,owner",
+					"--json", "name,description,pushedAt,createdAt,stargazerCount,forkCount,isArchived,isFork,isPrivate,primaryLanguage,owner,repositoryTopics",
 					"--limit", "1000",
 				}, []byte(tt.mockResp), tt.mockErr)
 			}
@@ -174,11 +174,12 @@ func TestClient_FetchRepositories_PopulatesFields(t *testing.T) {
 		"isArchived": false,
 		"isFork": true,
 		"isPrivate": false,
-		"primaryLanguage": {"name": "Go"}
+		"primaryLanguage": {"name": "Go"},
+		"repositoryTopics": [{"name": "cli"}, {"name": "deprecated"}]
 	}]`
 	mock.AddResponse("gh", []string{
 		"repo", "list", "testowner",
-		"--json", "name,description,pushedAt,createdAt,stargazerCount,forkCount,isArchived,isFork,isPrivate,primaryLanguage,owner",
+		"--json", "name,description,pushedAt,createdAt,stargazerCount,forkCount,isArchived,isFork,isPrivate,primaryLanguage,owner,repositoryTopics",
 		"--limit", "1000",
 	}, []byte(jsonResp), nil)
 
@@ -220,6 +221,9 @@ func TestClient_FetchRepositories_PopulatesFields(t *testing.T) {
 	if repo.PrimaryLanguage != "Go" {
 		t.Errorf("PrimaryLanguage = %q, want %q", repo.PrimaryLanguage, "Go")
 	}
+	if strings.Join(repo.Topics, ",") != "cli,deprecated" {
+		t.Errorf("Topics = %v, want [cli deprecated]", repo.Topics)
+	}
 }
 
 func TestClient_GetAuthenticatedUser(t *testing.T) {
@@ -497,6 +501,155 @@ func TestClient_FetchReadme(t *testing.T) {
 	}
 }
 
+func TestClient_FetchRecentCommits(t *testing.T) {
+	endpoint := "repos/testowner/testrepo/commits?per_page=30"
+
+	tests := []struct {
+		name     string
+		mockResp string
+		mockErr  error
+		wantLen  int
+		wantErr  bool
+	}{
+		{
+			name:     "commits",
+			mockResp: `[{"login":"dependabot[bot]","name":"dependabot[bot]","email":"bot@example.com","date":"2025-06-01T12:00:00Z"},{"login":"","name":"Mona","email":"mona@example.com","date":"2024-01-01T12:00:00Z"}]`,
+			wantLen:  2,
+		},
+		{
+			name:    "empty repository returns nil",
+			mockErr: errors.New("HTTP 409: Git Repository is empty."),
+		},
+		{
+			name:    "other errors are returned",
+			mockErr: errors.New("HTTP 404: Not Found"),
+			wantErr: true,
+		},
+		{
+			name:     "invalid JSON",
+			mockResp: "not json",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := NewMockExecutor()
+			mock.AddResponse("gh", []string{"api", endpoint, "--jq", commitsJQ}, []byte(tt.mockResp), tt.mockErr)
+
+			commits, err := NewClient(mock).FetchRecentCommits("testowner", "testrepo", 30)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(commits) != tt.wantLen {
+				t.Fatalf("got %d commits, want %d", len(commits), tt.wantLen)
+			}
+			if tt.wantLen > 0 && (commits[0].AuthorLogin != "dependabot[bot]" || commits[1].AuthorName != "Mona" || commits[0].Date.Year() != 2025) {
+				t.Errorf("unexpected commits: %+v", commits)
+			}
+		})
+	}
+
+	if _, err := NewClient(NewMockExecutor()).FetchRecentCommits("testowner", "testrepo", 0); err == nil {
+		t.Error("expected error for invalid limit")
+	}
+}
+
+func TestClient_FetchIssueActivity(t *testing.T) {
+	args := []string{"api", "graphql", "--paginate", "-f", "owner=testowner", "-f", "query=" + issueActivityQuery, "--jq", issueActivityJQ}
+	output := `{"name":"lib","openIssues":4,"openPullRequests":1,"openDiscussions":2,"lastUpdated":"2025-06-01T12:00:00Z"}
+{"name":"quiet","openIssues":0,"openPullRequests":0,"openDiscussions":0,"lastUpdated":null}
+`
+
+	mock := NewMockExecutor()
+	mock.AddResponse("gh", args, []byte(output), nil)
+	activity, err := NewClient(mock).FetchIssueActivity("testowner")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(activity) != 2 {
+		t.Fatalf("got %d repos, want 2", len(activity))
+	}
+	lib := activity["lib"]
+	if lib.OpenItems() != 7 || lib.LastUpdated.Year() != 2025 {
+		t.Errorf("unexpected activity for lib: %+v", lib)
+	}
+	if quiet := activity["quiet"]; !quiet.LastUpdated.IsZero() || quiet.DaysSinceUpdate() != -1 {
+		t.Errorf("unexpected activity for quiet: %+v", quiet)
+	}
+
+	// AddIssueActivity sets the activity on the repos it knows
+	repos := []Repository{{Owner: "testowner", Name: "lib"}, {Owner: "testowner", Name: "new"}}
+	if err := NewClient(mock).AddIssueActivity("testowner", repos); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repos[0].IssueActivity == nil || repos[0].IssueActivity.OpenIssues != 4 {
+		t.Errorf("expected issue activity on lib, got %+v", repos[0].IssueActivity)
+	}
+	if repos[1].IssueActivity != nil {
+		t.Errorf("expected no issue activity on new, got %+v", repos[1].IssueActivity)
+	}
+
+	// A failed issue query is reported and leaves the repos untouched
+	mock.AddResponse("gh", args, nil, errors.New("HTTP 502"))
+	if _, err := NewClient(mock).FetchIssueActivity("testowner"); err == nil {
+		t.Error("expected error, got nil")
+	}
+	repos = []Repository{{Owner: "testowner", Name: "lib"}}
+	if err := NewClient(mock).AddIssueActivity("testowner", repos); err == nil {
+		t.Error("expected error, got nil")
+	}
+	if repos[0].IssueActivity != nil {
+		t.Errorf("expected no issue activity after a failure, got %+v", repos[0].IssueActivity)
+	}
+}
+
+func TestClient_FetchArchiveImpact(t *testing.T) {
+	graphqlArgs := []string{"api", "graphql", "-f", "owner=acme", "-f", "name=widget", "-f", "query=" + archiveImpactQuery, "--jq", archiveImpactJQ}
+	pagesArgs := []string{"api", "repos/acme/widget/pages", "--jq", ".html_url"}
+	hooksArgs := []string{"api", "repos/acme/widget/hooks", "--jq", "[.[] | select(.active)] | length"}
+	graphqlResp := `{"openIssues":2,"openPullRequests":1,"latestRelease":"v1.0.0","latestReleaseAt":"2025-06-01T12:00:00Z","releaseDownloads":42,"lastDeploymentAt":null,"deploymentEnvironment":"","packages":["widget"]}`
+
+	mock := NewMockExecutor()
+	mock.AddResponse("gh", graphqlArgs, []byte(graphqlResp), nil)
+	mock.AddResponse("gh", pagesArgs, []byte("https://acme.github.io/widget\n"), nil)
+	mock.AddResponse("gh", hooksArgs, []byte("3\n"), nil)
+
+	impact, err := NewClient(mock).FetchArchiveImpact("acme", "widget")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if impact.OpenIssues != 2 || impact.ReleaseDownloads != 42 || impact.LatestRelease != "v1.0.0" ||
+		impact.PagesURL != "https://acme.github.io/widget" || impact.Webhooks != 3 || len(impact.Packages) != 1 {
+		t.Errorf("unexpected impact: %+v", impact)
+	}
+	if !impact.LastDeploymentAt.IsZero() {
+		t.Errorf("expected no deployment, got %v", impact.LastDeploymentAt)
+	}
+
+	// No Pages site and no admin access to webhooks
+	mock.AddResponse("gh", pagesArgs, nil, errors.New("HTTP 404: Not Found"))
+	mock.AddResponse("gh", hooksArgs, nil, errors.New("HTTP 403: Must have admin rights"))
+	impact, err = NewClient(mock).FetchArchiveImpact("acme", "widget")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if impact.PagesURL != "" || impact.Webhooks != -1 {
+		t.Errorf("expected no Pages and unknown webhooks, got %+v", impact)
+	}
+
+	mock.AddResponse("gh", graphqlArgs, nil, errors.New("network timeout"))
+	if _, err := NewClient(mock).FetchArchiveImpact("acme", "widget"); err == nil {
+		t.Error("expected error, got nil")
+	}
+}
+
 func TestNewClient(t *testing.T) {
 	mock := NewMockExecutor()
 	client := NewClient(mock)
@@ -522,3 +675,274 @@ func TestNewDefaultClient(t *testing.T) {
 		t.Error("NewDefaultClient should use RealExecutor")
 	}
 }
+
+func TestClient_FetchRepoSettings(t *testing.T) {
+	args := []string{"repo", "view", "acme/widget", "--json", "id,description,isArchived,pushedAt,repositoryTopics", "--jq", repoSettingsJQ}
+	mock := NewMockExecutor()
+	mock.AddResponse("gh", args, []byte(`{"id":"R_kgDOA","description":"A widget","isArchived":false,"pushedAt":"2026-01-02T03:04:05Z","topics":["go","cli"]}`), nil)
+
+	settings, err := NewClient(mock).FetchRepoSettings("acme", "widget")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if settings.ID != "R_kgDOA" || settings.Description != "A widget" || settings.IsArchived || len(settings.Topics) != 2 || settings.PushedAt.Year() != 2026 {
+		t.Errorf("unexpected settings: %+v", settings)
+	}
+}
+
+func TestClient_ListOpenPullRequests(t *testing.T) {
+	args := []string{"pr", "list", "-R", "acme/widget", "--state", "open", "--limit", "1000", "--json", "number", "--jq", ".[].number"}
+	mock := NewMockExecutor()
+	mock.AddResponse("gh", args, []byte("12\n9\n"), nil)
+
+	numbers, err := NewClient(mock).ListOpenPullRequests("acme", "widget")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(numbers) != 2 || numbers[0] != 12 || numbers[1] != 9 {
+		t.Errorf("expected [12 9], got %v", numbers)
+	}
+
+	mock.AddResponse("gh", args, nil, nil)
+	numbers, err = NewClient(mock).ListOpenPullRequests("acme", "widget")
+	if err != nil || len(numbers) != 0 {
+		t.Errorf("expected no pull requests, got %v (err %v)", numbers, err)
+	}
+}
+
+func TestClient_ClosePullRequest(t *testing.T) {
+	mock := NewMockExecutor()
+	mock.AddResponse("gh", []string{"pr", "close", "12", "-R", "acme/widget", "--comment", "Archiving"}, nil, nil)
+
+	if err := NewClient(mock).ClosePullRequest("acme", "widget", 12, "Archiving"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := NewClient(mock).ClosePullRequest("acme", "widget", 13, "Archiving"); err == nil {
+		t.Error("expected error for unmocked pull request, got nil")
+	}
+}
+
+func TestClient_FetchReadmeFile(t *testing.T) {
+	args := []string{"api", "repos/acme/widget/readme", "--jq", "{path, sha, content}"}
+	mock := NewMockExecutor()
+	mock.AddResponse("gh", args, []byte(`{"path":"README.md","sha":"abc123","content":"IyBXaWRn\nZXQK"}`), nil)
+
+	readme, err := NewClient(mock).FetchReadmeFile("acme", "widget")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if readme == nil || readme.Path != "README.md" || readme.SHA != "abc123" || readme.Content != "# Widget\n" {
+		t.Errorf("unexpected README: %+v", readme)
+	}
+
+	mock.AddResponse("gh", args, nil, errors.New("HTTP 404: Not Found"))
+	readme, err = NewClient(mock).FetchReadmeFile("acme", "widget")
+	if err != nil || readme != nil {
+		t.Errorf("expected no README, got %+v (err %v)", readme, err)
+	}
+}
+
+func TestClient_UpdateFile(t *testing.T) {
+	mock := NewMockExecutor()
+	mock.AddResponse("gh", []string{"api", "-X", "PUT", "repos/acme/widget/contents/README.md",
+		"-f", "message=Add notice", "-f", "content=IyBXaWRnZXQK", "-f", "sha=abc123"}, []byte(`{}`), nil)
+
+	if err := NewClient(mock).UpdateFile("acme", "widget", "README.md", "abc123", "# Widget\n", "Add notice"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestClient_ListIssues(t *testing.T) {
+	args := []string{"issue", "list", "-R", "acme/widget", "--state", "all", "--limit", "1000", "--json", "number,title,state,updatedAt"}
+	mock := NewMockExecutor()
+	mock.AddResponse("gh", args, []byte(`[{"number":2,"title":"Crash on start","state":"OPEN","updatedAt":"2024-05-01T10:00:00Z"},{"number":1,"title":"Typo","state":"CLOSED","updatedAt":"2023-01-01T00:00:00Z"}]`), nil)
+
+	issues, err := NewClient(mock).ListIssues("acme", "widget")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(issues) != 2 || issues[0].Number != 2 || issues[0].State != "OPEN" || issues[1].Title != "Typo" {
+		t.Errorf("unexpected issues: %+v", issues)
+	}
+
+	mock.AddResponse("gh", args, []byte("the 'acme/widget' repository has disabled issues"), errors.New("exit status 1"))
+	issues, err = NewClient(mock).ListIssues("acme", "widget")
+	if err != nil || issues != nil {
+		t.Errorf("expected no issues and no error, got %v, %v", issues, err)
+	}
+}
+
+func TestClient_RemoveTopic(t *testing.T) {
+	mock := NewMockExecutor()
+	mock.AddResponse("gh", []string{"repo", "edit", "acme/widget", "--remove-topic", "deprecated"}, nil, nil)
+
+	if err := NewClient(mock).RemoveTopic("acme", "widget", "deprecated"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := NewClient(mock).RemoveTopic("acme", "widget", ""); err == nil {
+		t.Error("expected error for empty topic, got nil")
+	}
+}
+
+func TestClient_SetVisibility(t *testing.T) {
+	mock := NewMockExecutor()
+	mock.AddResponse("gh", []string{"repo", "edit", "acme/widget", "--visibility", "private", "--accept-visibility-change-consequences"}, nil, nil)
+	mock.AddResponse("gh", []string{"repo", "edit", "acme/widget", "--visibility", "public", "--accept-visibility-change-consequences"},
+		[]byte("HTTP 422: Visibility can't be changed"), errors.New("exit status 1"))
+
+	if err := NewClient(mock).SetVisibility("acme", "widget", true); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := NewClient(mock).SetVisibility("acme", "widget", false); err == nil {
+		t.Error("expected error, got nil")
+	}
+}
+
+func TestClient_DeleteRepository(t *testing.T) {
+	args := []string{"repo", "delete", "acme/widget", "--yes"}
+	mock := NewMockExecutor()
+	mock.AddResponse("gh", args, nil, nil)
+
+	if err := NewClient(mock).DeleteRepository("acme", "widget"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	mock.AddResponse("gh", args, []byte("HTTP 403: Must have admin rights to Repository."), errors.New("exit status 1"))
+	if err := NewClient(mock).DeleteRepository("acme", "widget"); err == nil {
+		t.Error("expected error, got nil")
+	}
+}
+
+func TestClient_TransferRepository(t *testing.T) {
+	args := []string{"api", "-X", "POST", "repos/acme/widget/transfer", "-f", "new_owner=acme-attic", "--silent"}
+	mock := NewMockExecutor()
+	mock.AddResponse("gh", args, nil, nil)
+
+	if err := NewClient(mock).TransferRepository("acme", "widget", "acme-attic"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := NewClient(mock).TransferRepository("acme", "widget", ""); err == nil {
+		t.Error("expected error for empty new owner, got nil")
+	}
+
+	mock.AddResponse("gh", args, []byte("HTTP 422: new_owner is not a valid organization"), errors.New("exit status 1"))
+	if err := NewClient(mock).TransferRepository("acme", "widget", "acme-attic"); err == nil {
+		t.Error("expected error, got nil")
+	}
+}
+
+func TestClient_CreateIssue(t *testing.T) {
+	mock := NewMockExecutor()
+	mock.AddResponse("gh", []string{"api", "repos/acme/widget/issues", "-f", "title=Archiving", "-f", "body=Soon",
+		"--jq", "{number, url: .html_url}"}, []byte(`{"number":12,"url":"https://github.com/acme/widget/issues/12"}`), nil)
+
+	issue, err := NewClient(mock).CreateIssue("acme", "widget", "Archiving", "Soon")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if issue.Number != 12 || issue.URL != "https://github.com/acme/widget/issues/12" {
+		t.Errorf("unexpected issue: %+v", issue)
+	}
+}
+
+func TestClient_FetchIssueComments(t *testing.T) {
+	args := []string{"api", "repos/acme/widget/issues/12/comments", "--paginate", "--jq", issueCommentsJQ}
+	mock := NewMockExecutor()
+	mock.AddResponse("gh", args, []byte(`{"login":"alice","body":"We still use this","createdAt":"2026-03-01T00:00:00Z"}
+{"login":"bob","body":"+1","createdAt":"2026-03-02T00:00:00Z"}
+`), nil)
+
+	comments, err := NewClient(mock).FetchIssueComments("acme", "widget", 12)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(comments) != 2 || comments[0].AuthorLogin != "alice" || comments[1].Body != "+1" {
+		t.Errorf("unexpected comments: %+v", comments)
+	}
+
+	mock.AddResponse("gh", args, nil, nil)
+	comments, err = NewClient(mock).FetchIssueComments("acme", "widget", 12)
+	if err != nil || len(comments) != 0 {
+		t.Errorf("expected no comments, got %v (err %v)", comments, err)
+	}
+}
+
+func TestClient_ReopenIssue(t *testing.T) {
+	mock := NewMockExecutor()
+	mock.AddResponse("gh", []string{"issue", "reopen", "12", "-R", "acme/widget", "--comment", "Archiving failed"}, nil, nil)
+
+	if err := NewClient(mock).ReopenIssue("acme", "widget", 12, "Archiving failed"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := NewClient(mock).ReopenIssue("", "widget", 12, ""); err == nil {
+		t.Error("expected error for empty owner")
+	}
+}
+
+func TestClient_ListWorkflows(t *testing.T) {
+	mock := NewMockExecutor()
+	mock.AddResponse("gh", []string{"api", "repos/acme/widget/actions/workflows", "--paginate", "--jq", ".workflows[] | {id, name, path, state}"},
+		[]byte(`{"id":1,"name":"CI","path":".github/workflows/ci.yml","state":"active"}
+{"id":2,"name":"Nightly","path":".github/workflows/nightly.yml","state":"disabled_manually"}
+`), nil)
+	mock.AddResponse("gh", []string{"api", "repos/acme/gone/actions/workflows", "--paginate", "--jq", ".workflows[] | {id, name, path, state}"},
+		[]byte("HTTP 404: Not Found"), errors.New("exit status 1"))
+
+	workflows, err := NewClient(mock).ListWorkflows("acme", "widget")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(workflows) != 2 || workflows[1].ID != 2 || workflows[1].State != "disabled_manually" {
+		t.Errorf("workflows = %+v", workflows)
+	}
+
+	workflows, err = NewClient(mock).ListWorkflows("acme", "gone")
+	if err != nil || workflows != nil {
+		t.Errorf("ListWorkflows(gone) = %v, %v; want nil, nil", workflows, err)
+	}
+}
+
+func TestClient_FetchLatestWorkflowRun(t *testing.T) {
+	jq := ".workflow_runs[0] // empty | {createdAt: .created_at, status, conclusion}"
+	mock := NewMockExecutor()
+	mock.AddResponse("gh", []string{"api", "repos/acme/widget/actions/workflows/1/runs?per_page=1", "--jq", jq},
+		[]byte(`{"createdAt":"2025-03-01T04:00:00Z","status":"completed","conclusion":"failure"}`), nil)
+	mock.AddResponse("gh", []string{"api", "repos/acme/widget/actions/workflows/2/runs?per_page=1", "--jq", jq}, []byte("\n"), nil)
+
+	run, err := NewClient(mock).FetchLatestWorkflowRun("acme", "widget", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if run == nil || run.Conclusion != "failure" || run.CreatedAt.Year() != 2025 {
+		t.Errorf("run = %+v", run)
+	}
+
+	run, err = NewClient(mock).FetchLatestWorkflowRun("acme", "widget", 2)
+	if err != nil || run != nil {
+		t.Errorf("FetchLatestWorkflowRun(never ran) = %v, %v; want nil, nil", run, err)
+	}
+}
+
+func TestClient_FetchFileContent(t *testing.T) {
+	encoded := base64.StdEncoding.EncodeToString([]byte("on: push\n"))
+	mock := NewMockExecutor()
+	mock.AddResponse("gh", []string{"api", "repos/acme/widget/contents/.github/workflows/ci.yml", "--jq", ".content"},
+		[]byte(encoded[:8]+"\n"+encoded[8:]+"\n"), nil)
+
+	content, err := NewClient(mock).FetchFileContent("acme", "widget", ".github/workflows/ci.yml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(content) != "on: push\n" {
+		t.Errorf("content = %q", content)
+	}
+}
+
+func TestClient_DisableWorkflow(t *testing.T) {
+	mock := NewMockExecutor()
+	mock.AddResponse("gh", []string{"workflow", "disable", "42", "-R", "acme/widget"}, nil, nil)
+
+	if err := NewClient(mock).DisableWorkflow("acme", "widget", 42); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
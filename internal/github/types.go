@@ -5,25 +5,140 @@ package github
 
 import (
 	"encoding/json"
+	"slices"
 	"time"
 )
 
 // Repository represents a GitHub repository with fields matching gh CLI JSON output.
 type Repository struct {
-	Owner             string    `json:"-"` // Populated from ownerJSON
-	Name              string    `json:"name"`
-	Description       string    `json:"description"`
-	PushedAt          time.Time `json:"pushedAt"`
-	CreatedAt         time.Time `json:"createdAt"`
-	StargazerCount    int       `json:"stargazerCount"`
-	ForkCount         int       `json:"forkCount"`
-	IsArchived        bool      `json:"isArchived"`
-	IsFork            bool      `json:"isFork"`
-	IsPrivate         bool      `json:"isPrivate"`
-	PrimaryLanguage   string    `json:"-"` // Populated from primaryLanguageJSON
-	DaysSinceActivity int       `json:"-"` // Calculated field
-	MarkedForArchive  bool      `json:"-"` // UI state
-	ArchiveReason     string    `json:"-"` // UI state
+	Owner             string         `json:"-"` // Populated from ownerJSON
+	Name              string         `json:"name"`
+	Description       string         `json:"description"`
+	PushedAt          time.Time      `json:"pushedAt"`
+	CreatedAt         time.Time      `json:"createdAt"`
+	StargazerCount    int            `json:"stargazerCount"`
+	ForkCount         int            `json:"forkCount"`
+	IsArchived        bool           `json:"isArchived"`
+	IsFork            bool           `json:"isFork"`
+	IsPrivate         bool           `json:"isPrivate"`
+	PrimaryLanguage   string         `json:"-"` // Populated from primaryLanguageJSON
+	Topics            []string       `json:"-"` // Populated from repositoryTopics
+	CronWorkflows     int            `json:"-"` // Active workflows with a schedule trigger, from 'repjan actions'
+	DaysSinceActivity int            `json:"-"` // Calculated field
+	LastHumanActivity time.Time      `json:"-"` // Latest non-bot commit, zero until checked
+	IssueActivity     *IssueActivity `json:"-"` // Open items and latest update, nil until fetched
+	MarkedForArchive  bool           `json:"-"` // UI state
+	ArchiveReason     string         `json:"-"` // UI state
+}
+
+// IssueActivity summarizes the issues, pull requests and discussions of a
+// repository, which show it is in use even when nobody pushes.
+type IssueActivity struct {
+	OpenIssues       int       `json:"openIssues"`
+	OpenPullRequests int       `json:"openPullRequests"`
+	OpenDiscussions  int       `json:"openDiscussions"`
+	LastUpdated      time.Time `json:"lastUpdated"` // latest issue, PR or discussion update; zero when none
+}
+
+// OpenItems returns the number of open issues, pull requests and discussions.
+func (a IssueActivity) OpenItems() int {
+	return a.OpenIssues + a.OpenPullRequests + a.OpenDiscussions
+}
+
+// DaysSinceUpdate returns the days since the latest update, or -1 when
+// nothing has ever been opened.
+func (a IssueActivity) DaysSinceUpdate() int {
+	if a.LastUpdated.IsZero() {
+		return -1
+	}
+	return int(time.Since(a.LastUpdated).Hours() / 24)
+}
+
+// ArchiveImpact describes what archiving a repository would affect: open
+// work that freezes, and releases, deployments, sites, webhooks and packages
+// that depend on it.
+type ArchiveImpact struct {
+	OpenIssues            int       `json:"openIssues"`
+	OpenPullRequests      int       `json:"openPullRequests"`
+	PagesURL              string    `json:"pagesUrl"` // published GitHub Pages site, "" when none
+	LatestRelease         string    `json:"latestRelease"`
+	LatestReleaseAt       time.Time `json:"latestReleaseAt"`
+	ReleaseDownloads      int       `json:"releaseDownloads"` // asset downloads across recent releases
+	LastDeploymentAt      time.Time `json:"lastDeploymentAt"`
+	DeploymentEnvironment string    `json:"deploymentEnvironment"`
+	Webhooks              int       `json:"webhooks"` // active webhooks, -1 when they can't be listed
+	Packages              []string  `json:"packages"`
+}
+
+// RepoSettings is the live ID, description, topics, archive state and last
+// push of a repository, as returned by FetchRepoSettings.
+type RepoSettings struct {
+	ID          string    `json:"id"` // GraphQL node ID, stable across renames and transfers
+	Description string    `json:"description"`
+	Topics      []string  `json:"topics"`
+	IsArchived  bool      `json:"isArchived"`
+	PushedAt    time.Time `json:"pushedAt"`
+}
+
+// ActionsWorkflow is a GitHub Actions workflow of a repository. Triggers and
+// the latest run are filled in by the actions package.
+type ActionsWorkflow struct {
+	ID         int64     `json:"id"`
+	Name       string    `json:"name"`
+	Path       string    `json:"path"`
+	State      string    `json:"state"`      // "active", "disabled_manually", "disabled_inactivity", ...
+	Triggers   []string  `json:"triggers"`   // events in the workflow file's "on" key
+	LastRunAt  time.Time `json:"lastRunAt"`  // zero when it never ran
+	LastStatus string    `json:"lastStatus"` // conclusion of the latest run, or its status while running
+}
+
+// IsScheduled reports whether the workflow is active and runs on a schedule.
+func (w ActionsWorkflow) IsScheduled() bool {
+	return w.State == "active" && slices.Contains(w.Triggers, "schedule")
+}
+
+// WorkflowRun is a single run of a GitHub Actions workflow.
+type WorkflowRun struct {
+	CreatedAt  time.Time `json:"createdAt"`
+	Status     string    `json:"status"`     // "completed", "in_progress", ...
+	Conclusion string    `json:"conclusion"` // "success", "failure", ...; empty until completed
+}
+
+// ReadmeFile is a repository's README with the path and blob SHA needed to
+// update it.
+type ReadmeFile struct {
+	Path    string `json:"path"`
+	SHA     string `json:"sha"`
+	Content string `json:"-"` // decoded content
+}
+
+// Issue identifies an issue created by CreateIssue.
+type Issue struct {
+	Number int    `json:"number"`
+	URL    string `json:"url"`
+}
+
+// IssueSummary is the title and state of an issue, as returned by ListIssues.
+type IssueSummary struct {
+	Number    int       `json:"number"`
+	Title     string    `json:"title"`
+	State     string    `json:"state"` // OPEN or CLOSED
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// IssueComment is a comment on an issue, as returned by FetchIssueComments.
+type IssueComment struct {
+	AuthorLogin string    `json:"login"`
+	Body        string    `json:"body"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// Commit is the author and date of a commit, as returned by FetchRecentCommits.
+type Commit struct {
+	AuthorLogin string    `json:"login"` // GitHub account, empty when the email is not linked
+	AuthorName  string    `json:"name"`
+	AuthorEmail string    `json:"email"`
+	Date        time.Time `json:"date"`
 }
 
 // ownerJSON represents the nested owner object from gh CLI.
@@ -36,6 +151,11 @@ type primaryLanguageJSON struct {
 	Name string `json:"name"`
 }
 
+// topicJSON represents one entry of the repositoryTopics list from gh CLI.
+type topicJSON struct {
+	Name string `json:"name"`
+}
+
 // repositoryJSON is used for unmarshaling the raw gh CLI JSON response.
 type repositoryJSON struct {
 	Owner           *ownerJSON           `json:"owner"`
@@ -49,6 +169,7 @@ type repositoryJSON struct {
 	IsFork          bool                 `json:"isFork"`
 	IsPrivate       bool                 `json:"isPrivate"`
 	PrimaryLanguage *primaryLanguageJSON `json:"primaryLanguage"`
+	Topics          []topicJSON          `json:"repositoryTopics"`
 }
 
 // UnmarshalJSON implements custom JSON unmarshaling to handle gh CLI's nested format.
@@ -76,6 +197,10 @@ func (r *Repository) UnmarshalJSON(data []byte) error {
 		r.PrimaryLanguage = raw.PrimaryLanguage.Name
 	}
 
+	for _, topic := range raw.Topics {
+		r.Topics = append(r.Topics, topic.Name)
+	}
+
 	return nil
 }
 
@@ -84,6 +209,15 @@ func (r *Repository) FullName() string {
 	return r.Owner + "/" + r.Name
 }
 
+// DaysSinceHumanActivity returns the days since the latest commit by a
+// non-bot author, or DaysSinceActivity when human activity is unknown.
+func (r *Repository) DaysSinceHumanActivity() int {
+	if r.LastHumanActivity.IsZero() {
+		return r.DaysSinceActivity
+	}
+	return int(time.Since(r.LastHumanActivity).Hours() / 24)
+}
+
 // CalculateDaysSinceActivity calculates and sets DaysSinceActivity from PushedAt.
 func (r *Repository) CalculateDaysSinceActivity() {
 	if r.PushedAt.IsZero() {
@@ -0,0 +1,239 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package mcp
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+// setupServer creates an MCP server over an in-memory store with a few repos.
+func setupServer(t *testing.T, allowWrites bool) (*Server, *store.Store) {
+	t.Helper()
+
+	s := testutil.NewStore(t)
+	repos := []github.Repository{
+		testutil.NewTestRepo(testutil.WithOwner("me"), testutil.WithName("active"), testutil.WithDaysInactive(5), testutil.WithStars(3)),
+		testutil.NewTestRepo(testutil.WithOwner("me"), testutil.WithName("stale"), testutil.WithDaysInactive(800), testutil.WithStars(0), testutil.WithForks(0)),
+		testutil.NewTestRepo(testutil.WithOwner("me"), testutil.WithName("done"), testutil.WithArchived(true), testutil.WithDaysInactive(900)),
+	}
+	require.NoError(t, s.UpsertRepositories("me", repos))
+
+	return New(s, "me", "test", allowWrites), s
+}
+
+// rpc sends newline-delimited requests through Serve and decodes each response.
+func rpc(t *testing.T, srv *Server, requests ...string) []map[string]any {
+	t.Helper()
+
+	var out bytes.Buffer
+	require.NoError(t, srv.Serve(strings.NewReader(strings.Join(requests, "\n")+"\n"), &out))
+
+	var responses []map[string]any
+	dec := json.NewDecoder(&out)
+	for dec.More() {
+		var resp map[string]any
+		require.NoError(t, dec.Decode(&resp))
+		responses = append(responses, resp)
+	}
+	return responses
+}
+
+// callTool calls a tool and returns its text content and error flag.
+func callTool(t *testing.T, srv *Server, name, args string) (string, bool) {
+	t.Helper()
+
+	req := `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"` + name + `","arguments":` + args + `}}`
+	responses := rpc(t, srv, req)
+	require.Len(t, responses, 1)
+	require.Nil(t, responses[0]["error"], "unexpected JSON-RPC error")
+
+	result := responses[0]["result"].(map[string]any)
+	content := result["content"].([]any)[0].(map[string]any)
+	isError, _ := result["isError"].(bool)
+	return content["text"].(string), isError
+}
+
+func TestServe_InitializeAndPing(t *testing.T) {
+	srv, _ := setupServer(t, false)
+
+	responses := rpc(t, srv,
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2024-11-05","capabilities":{},"clientInfo":{"name":"test"}}}`,
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		`{"jsonrpc":"2.0","id":"two","method":"ping"}`,
+	)
+
+	require.Len(t, responses, 2, "notifications get no response")
+	init := responses[0]["result"].(map[string]any)
+	assert.Equal(t, protocolVersion, init["protocolVersion"])
+	assert.Equal(t, "repjan", init["serverInfo"].(map[string]any)["name"])
+	assert.Equal(t, "two", responses[1]["id"])
+}
+
+func TestServe_Errors(t *testing.T) {
+	srv, _ := setupServer(t, false)
+
+	responses := rpc(t, srv,
+		`{not json`,
+		`{"jsonrpc":"1.0","id":1,"method":"ping"}`,
+		`{"jsonrpc":"2.0","id":2,"method":"resources/list"}`,
+		`{"jsonrpc":"2.0","id":3,"method":"tools/call","params":{"name":"nope"}}`,
+	)
+
+	require.Len(t, responses, 4)
+	codes := make([]float64, len(responses))
+	for i, resp := range responses {
+		codes[i] = resp["error"].(map[string]any)["code"].(float64)
+	}
+	assert.Equal(t, []float64{codeParseError, codeInvalidRequest, codeMethodNotFound, codeInvalidParams}, codes)
+	assert.Nil(t, responses[0]["id"])
+}
+
+func TestToolsList_GatesMutatingTools(t *testing.T) {
+	names := func(srv *Server) []string {
+		responses := rpc(t, srv, `{"jsonrpc":"2.0","id":1,"method":"tools/list"}`)
+		var out []string
+		for _, tl := range responses[0]["result"].(map[string]any)["tools"].([]any) {
+			out = append(out, tl.(map[string]any)["name"].(string))
+		}
+		return out
+	}
+
+	readOnly, _ := setupServer(t, false)
+	assert.Equal(t, []string{"list_repositories", "get_repository", "explain_candidate", "plan_archive"}, names(readOnly))
+
+	writable, _ := setupServer(t, true)
+	assert.Contains(t, names(writable), "mark")
+	assert.Contains(t, names(writable), "unmark")
+
+	text, isError := callTool(t, readOnly, "mark", `{"name":"stale"}`)
+	assert.True(t, isError)
+	assert.Contains(t, text, "--allow-writes")
+}
+
+func TestListRepositories(t *testing.T) {
+	srv, _ := setupServer(t, false)
+
+	text, isError := callTool(t, srv, "list_repositories", `{}`)
+	require.False(t, isError, text)
+	var out struct {
+		Total        int `json:"total"`
+		Repositories []struct {
+			Name string `json:"name"`
+		} `json:"repositories"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(text), &out))
+	assert.Equal(t, 2, out.Total, "archived hidden by default")
+	assert.Equal(t, "stale", out.Repositories[0].Name, "oldest first by default")
+
+	text, isError = callTool(t, srv, "list_repositories", `{"include_archived":true,"limit":1}`)
+	require.False(t, isError, text)
+	require.NoError(t, json.Unmarshal([]byte(text), &out))
+	assert.Equal(t, 3, out.Total)
+	assert.Len(t, out.Repositories, 1)
+
+	text, isError = callTool(t, srv, "list_repositories", `{"filter":"newest"}`)
+	assert.True(t, isError)
+	assert.Contains(t, text, "unknown filter")
+
+	text, isError = callTool(t, srv, "list_repositories", `{"order":"up"}`)
+	assert.True(t, isError)
+	assert.Contains(t, text, "unknown order")
+
+	_, isError = callTool(t, srv, "list_repositories", `{"bogus":1}`)
+	assert.True(t, isError, "unknown arguments are rejected")
+}
+
+func TestExplainCandidate(t *testing.T) {
+	srv, _ := setupServer(t, false)
+
+	text, isError := callTool(t, srv, "explain_candidate", `{"name":"stale"}`)
+	require.False(t, isError, text)
+	var out struct {
+		Candidate bool `json:"candidate"`
+		Score     int  `json:"score"`
+		Reasons   []struct {
+			Code   string `json:"code"`
+			Weight int    `json:"weight"`
+		} `json:"reasons"`
+		Explanation string `json:"explanation"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(text), &out))
+	assert.True(t, out.Candidate)
+	assert.Equal(t, 60, out.Score)
+	require.Len(t, out.Reasons, 2)
+	assert.Equal(t, "inactive_2y", out.Reasons[0].Code)
+	assert.Contains(t, out.Explanation, "score 60/100")
+
+	text, isError = callTool(t, srv, "explain_candidate", `{"name":"missing"}`)
+	assert.True(t, isError)
+	assert.Contains(t, text, "not found")
+
+	_, isError = callTool(t, srv, "explain_candidate", `{}`)
+	assert.True(t, isError, "name is required")
+}
+
+func TestExplainCandidate_StoredSignals(t *testing.T) {
+	srv, s := setupServer(t, false)
+	require.NoError(t, s.SaveReadme("me", "active", "# active\n\nThis project is deprecated.\n"))
+
+	text, isError := callTool(t, srv, "explain_candidate", `{"name":"active"}`)
+	require.False(t, isError, text)
+	var out struct {
+		Candidate bool `json:"candidate"`
+		Reasons   []struct {
+			Code string `json:"code"`
+		} `json:"reasons"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(text), &out))
+	assert.True(t, out.Candidate, "cached README signals count")
+	require.Len(t, out.Reasons, 1)
+	assert.Equal(t, "readme_deprecated", out.Reasons[0].Code)
+}
+
+func TestMarkUnmarkAndPlan(t *testing.T) {
+	srv, s := setupServer(t, true)
+
+	_, isError := callTool(t, srv, "mark", `{"name":"stale"}`)
+	require.False(t, isError)
+	text, isError := callTool(t, srv, "mark", `{"name":"done"}`)
+	assert.True(t, isError)
+	assert.Contains(t, text, "already archived")
+
+	names, err := s.GetMarkedRepos("me")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"stale"}, names)
+
+	text, isError = callTool(t, srv, "get_repository", `{"name":"stale"}`)
+	require.False(t, isError)
+	assert.Contains(t, text, `"marked": true`)
+	assert.Contains(t, text, `"action": "marked"`)
+
+	text, isError = callTool(t, srv, "plan_archive", `{}`)
+	require.False(t, isError)
+	var plan struct {
+		Count int `json:"count"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(text), &plan))
+	assert.Equal(t, 1, plan.Count)
+
+	text, isError = callTool(t, srv, "plan_archive", `{"scope":"candidates"}`)
+	require.False(t, isError)
+	assert.Contains(t, text, "done (already archived)")
+
+	_, isError = callTool(t, srv, "unmark", `{"name":"stale"}`)
+	require.False(t, isError)
+	names, err = s.GetMarkedRepos("me")
+	require.NoError(t, err)
+	assert.Empty(t, names)
+}
@@ -0,0 +1,486 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package mcp
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/llbbl/repjan/internal/analyze"
+	"github.com/llbbl/repjan/internal/export"
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/query"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+// defaultListLimit caps list_repositories results when no limit is given.
+const defaultListLimit = 100
+
+// historyLimit caps the history entries returned for a repository.
+const historyLimit = 20
+
+// tool is an MCP tool definition with its handler.
+type tool struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	InputSchema map[string]any `json:"inputSchema"`
+
+	mutating bool
+	handler  func(args json.RawMessage) (any, error)
+}
+
+// toolResult is the result of tools/call. Tool failures are reported with
+// IsError rather than as JSON-RPC errors, so agents can read the message.
+type toolResult struct {
+	Content []toolContent `json:"content"`
+	IsError bool          `json:"isError,omitempty"`
+}
+
+// toolContent is a single content item in a tool result.
+type toolContent struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// nameSchema is the input schema for tools that take a single repository name.
+var nameSchema = objectSchema(map[string]any{
+	"name": stringProp("Repository name, without the owner"),
+}, "name")
+
+// registerTools returns the tool definitions in the order they are listed.
+func (s *Server) registerTools() []tool {
+	return []tool{
+		{
+			Name:        "list_repositories",
+			Description: "List stored repositories with optional filter, language, name search and sort. Private and archived repositories are hidden unless requested.",
+			InputSchema: objectSchema(map[string]any{
+				"filter":           enumProp("Repository filter", "all", "old", "no-stars", "forks", "bot-only"),
+				"language":         stringProp("Exact primary language, or None for repositories without one"),
+				"query":            stringProp("Case-insensitive substring of the repository name"),
+				"sort":             enumProp("Sort field", "name", "activity", "stars", "language"),
+				"order":            enumProp("Sort order; ascending activity is oldest first", "asc", "desc"),
+				"include_private":  boolProp("Include private repositories"),
+				"include_archived": boolProp("Include archived repositories"),
+				"limit":            map[string]any{"type": "integer", "description": "Maximum repositories to return (default 100)"},
+			}),
+			handler: s.listRepositories,
+		},
+		{
+			Name:        "get_repository",
+			Description: "Get a repository's metadata, archive assessment, mark state and change history.",
+			InputSchema: nameSchema,
+			handler:     s.getRepository,
+		},
+		{
+			Name:        "explain_candidate",
+			Description: "Explain whether a repository is an archive candidate, listing each matching heuristic with its weight and the total score.",
+			InputSchema: nameSchema,
+			handler:     s.explainCandidate,
+		},
+		{
+			Name:        "plan_archive",
+			Description: "Plan an archive run without changing anything: the repositories that would be archived, highest score first, and any that would be skipped.",
+			InputSchema: objectSchema(map[string]any{
+				"scope": enumProp("Repositories to plan for (default marked)", "marked", "candidates"),
+			}),
+			handler: s.planArchive,
+		},
+		{
+			Name:        "mark",
+			Description: "Mark a repository for archiving.",
+			InputSchema: nameSchema,
+			mutating:    true,
+			handler:     s.mark,
+		},
+		{
+			Name:        "unmark",
+			Description: "Remove a repository's archive mark.",
+			InputSchema: nameSchema,
+			mutating:    true,
+			handler:     s.unmark,
+		},
+	}
+}
+
+// listTools returns the tools available to clients. Mutating tools are only
+// listed when writes are allowed.
+func (s *Server) listTools() []tool {
+	tools := make([]tool, 0, len(s.tools))
+	for _, t := range s.tools {
+		if t.mutating && !s.allowWrites {
+			continue
+		}
+		tools = append(tools, t)
+	}
+	return tools
+}
+
+// callTool runs the named tool and wraps its output as a tool result.
+func (s *Server) callTool(name string, args json.RawMessage) (any, *rpcError) {
+	for _, t := range s.tools {
+		if t.Name != name {
+			continue
+		}
+		if t.mutating && !s.allowWrites {
+			return errorResult(fmt.Errorf("tool %s changes state and is disabled; restart with --allow-writes to enable it", name)), nil
+		}
+
+		out, err := t.handler(args)
+		if err != nil {
+			slog.Debug("tool failed", "component", "mcp", "tool", name, "error", err)
+			return errorResult(err), nil
+		}
+
+		text, err := json.MarshalIndent(out, "", "  ")
+		if err != nil {
+			return errorResult(fmt.Errorf("encoding result: %w", err)), nil
+		}
+		return toolResult{Content: []toolContent{{Type: "text", Text: string(text)}}}, nil
+	}
+	return nil, &rpcError{Code: codeInvalidParams, Message: fmt.Sprintf("unknown tool: %s", name)}
+}
+
+// errorResult wraps err as a failed tool result.
+func errorResult(err error) toolResult {
+	return toolResult{Content: []toolContent{{Type: "text", Text: err.Error()}}, IsError: true}
+}
+
+// repoSummary is a repository in tool output.
+type repoSummary struct {
+	export.ExportedRepo
+	Marked bool `json:"marked"`
+}
+
+// historyEntry is a recorded change in tool output.
+type historyEntry struct {
+	Action      string `json:"action"`
+	PerformedAt string `json:"performed_at"`
+	PerformedBy string `json:"performed_by"`
+	Notes       string `json:"notes,omitempty"`
+}
+
+// reasonJSON is a matched heuristic in tool output.
+type reasonJSON struct {
+	Code        analyze.ReasonCode `json:"code"`
+	Description string             `json:"description"`
+	Weight      int                `json:"weight"`
+}
+
+func (s *Server) listRepositories(raw json.RawMessage) (any, error) {
+	var args struct {
+		Filter          string `json:"filter"`
+		Language        string `json:"language"`
+		Query           string `json:"query"`
+		Sort            string `json:"sort"`
+		Order           string `json:"order"`
+		IncludePrivate  bool   `json:"include_private"`
+		IncludeArchived bool   `json:"include_archived"`
+		Limit           int    `json:"limit"`
+	}
+	if err := decodeArgs(raw, &args); err != nil {
+		return nil, err
+	}
+
+	filter, err := query.ParseFilter(args.Filter)
+	if err != nil {
+		return nil, err
+	}
+	if args.Sort == "" {
+		args.Sort = "activity"
+	}
+	sortField, err := query.ParseSortField(args.Sort)
+	if err != nil {
+		return nil, err
+	}
+	ascending, err := query.ParseOrder(args.Order)
+	if err != nil {
+		return nil, err
+	}
+	if args.Limit <= 0 {
+		args.Limit = defaultListLimit
+	}
+
+	repos, marked, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	assessor, err := analyze.LoadAssessor(s.store, s.owner)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := query.Query{
+		Filter:       filter,
+		Language:     args.Language,
+		Search:       args.Query,
+		Sort:         sortField,
+		Ascending:    ascending,
+		ShowPrivate:  args.IncludePrivate,
+		ShowArchived: args.IncludeArchived,
+	}.Apply(repos)
+
+	returned := matched
+	if len(returned) > args.Limit {
+		returned = returned[:args.Limit]
+	}
+
+	return map[string]any{
+		"owner":        s.owner,
+		"total":        len(matched),
+		"returned":     len(returned),
+		"repositories": s.summaries(returned, marked, assessor),
+	}, nil
+}
+
+func (s *Server) getRepository(raw json.RawMessage) (any, error) {
+	repo, err := s.findRepo(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	_, marked, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	assessor, err := analyze.LoadAssessor(s.store, s.owner)
+	if err != nil {
+		return nil, err
+	}
+	changes, err := s.store.GetRepoHistory(s.owner, repo.Name, historyLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]historyEntry, 0, len(changes))
+	for _, c := range changes {
+		history = append(history, historyEntry{
+			Action:      c.Action,
+			PerformedAt: c.PerformedAt.UTC().Format(time.RFC3339),
+			PerformedBy: c.PerformedBy,
+			Notes:       c.Notes,
+		})
+	}
+
+	return map[string]any{
+		"repository": s.summaries([]github.Repository{*repo}, marked, assessor)[0],
+		"history":    history,
+	}, nil
+}
+
+func (s *Server) explainCandidate(raw json.RawMessage) (any, error) {
+	repo, err := s.findRepo(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	assessor, err := analyze.LoadAssessor(s.store, s.owner)
+	if err != nil {
+		return nil, err
+	}
+
+	a := assessor.Assess(*repo)
+	reasons := make([]reasonJSON, 0, len(a.Reasons))
+	for _, r := range a.Reasons {
+		reasons = append(reasons, reasonJSON{Code: r.Code, Description: r.Description, Weight: r.Weight})
+	}
+
+	explanation := fmt.Sprintf("%s is not an archive candidate: no heuristics matched.", repo.FullName())
+	if a.Candidate {
+		explanation = fmt.Sprintf("%s is an archive candidate with score %d/100: %s.", repo.FullName(), a.Score, a.Summary())
+	}
+	if repo.IsArchived {
+		explanation = fmt.Sprintf("%s is already archived.", repo.FullName())
+	}
+
+	return map[string]any{
+		"repository":          repo.FullName(),
+		"candidate":           a.Candidate,
+		"archived":            repo.IsArchived,
+		"score":               a.Score,
+		"reasons":             reasons,
+		"days_since_activity": repo.DaysSinceActivity,
+		"explanation":         explanation,
+	}, nil
+}
+
+func (s *Server) planArchive(raw json.RawMessage) (any, error) {
+	var args struct {
+		Scope string `json:"scope"`
+	}
+	if err := decodeArgs(raw, &args); err != nil {
+		return nil, err
+	}
+	if args.Scope == "" {
+		args.Scope = "marked"
+	}
+
+	repos, marked, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	assessor, err := analyze.LoadAssessor(s.store, s.owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var planned, skipped []github.Repository
+	for _, repo := range repos {
+		switch args.Scope {
+		case "marked":
+			if !marked[repo.Name] {
+				continue
+			}
+		case "candidates":
+			if !assessor.Assess(repo).Candidate {
+				continue
+			}
+		default:
+			return nil, fmt.Errorf("unknown scope %q: must be marked or candidates", args.Scope)
+		}
+
+		if repo.IsArchived {
+			skipped = append(skipped, repo)
+			continue
+		}
+		planned = append(planned, repo)
+	}
+
+	summaries := s.summaries(planned, marked, assessor)
+	sort.SliceStable(summaries, func(i, j int) bool { return summaries[i].Score > summaries[j].Score })
+
+	skippedNames := make([]string, 0, len(skipped))
+	for _, repo := range skipped {
+		skippedNames = append(skippedNames, repo.FullName()+" (already archived)")
+	}
+
+	return map[string]any{
+		"scope":        args.Scope,
+		"count":        len(summaries),
+		"repositories": summaries,
+		"skipped":      skippedNames,
+		"note":         "This is a plan only; nothing was changed. Archive from the repjan TUI after review.",
+	}, nil
+}
+
+func (s *Server) mark(raw json.RawMessage) (any, error) {
+	repo, err := s.findRepo(raw)
+	if err != nil {
+		return nil, err
+	}
+	if repo.IsArchived {
+		return nil, fmt.Errorf("%s is already archived", repo.FullName())
+	}
+
+	if err := s.store.AddMarkedRepo(s.owner, repo.Name); err != nil {
+		return nil, err
+	}
+	if err := s.store.RecordRepoChange(s.owner, repo.Name, "marked", "user", nil, nil, "mcp"); err != nil {
+		slog.Warn("failed to record mark", "component", "mcp", "repo", repo.Name, "error", err)
+	}
+	return map[string]any{"repository": repo.FullName(), "marked": true}, nil
+}
+
+func (s *Server) unmark(raw json.RawMessage) (any, error) {
+	repo, err := s.findRepo(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.store.RemoveMarkedRepo(s.owner, repo.Name); err != nil {
+		return nil, err
+	}
+	if err := s.store.RecordRepoChange(s.owner, repo.Name, "unmarked", "user", nil, nil, "mcp"); err != nil {
+		slog.Warn("failed to record unmark", "component", "mcp", "repo", repo.Name, "error", err)
+	}
+	return map[string]any{"repository": repo.FullName(), "marked": false}, nil
+}
+
+// load returns the owner's repositories and the set of marked repo names.
+func (s *Server) load() ([]github.Repository, map[string]bool, error) {
+	repos, err := s.store.GetRepositories(s.owner)
+	if err != nil {
+		return nil, nil, err
+	}
+	names, err := s.store.GetMarkedRepos(s.owner)
+	if err != nil {
+		return nil, nil, err
+	}
+	marked := make(map[string]bool, len(names))
+	for _, name := range names {
+		marked[name] = true
+	}
+	return repos, marked, nil
+}
+
+// findRepo decodes a {"name": ...} argument and loads that repository.
+func (s *Server) findRepo(raw json.RawMessage) (*github.Repository, error) {
+	var args struct {
+		Name string `json:"name"`
+	}
+	if err := decodeArgs(raw, &args); err != nil {
+		return nil, err
+	}
+	if args.Name == "" {
+		return nil, errors.New("name is required")
+	}
+
+	repo, err := s.store.GetRepository(s.owner, args.Name)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, fmt.Errorf("repository %s/%s not found", s.owner, args.Name)
+	}
+	return repo, err
+}
+
+// summaries converts repositories to tool output using the export data model.
+func (s *Server) summaries(repos []github.Repository, marked map[string]bool, assessor *analyze.Assessor) []repoSummary {
+	data := export.BuildExportData(repos, s.owner, assessor)
+	out := make([]repoSummary, len(data.Repositories))
+	for i, repo := range data.Repositories {
+		out[i] = repoSummary{ExportedRepo: repo, Marked: marked[repo.Name]}
+	}
+	return out
+}
+
+// decodeArgs decodes tool arguments, rejecting unknown fields. Missing
+// arguments decode as the zero value.
+func decodeArgs(raw json.RawMessage, v any) error {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("invalid arguments: %w", err)
+	}
+	return nil
+}
+
+// objectSchema builds a JSON Schema object with the given properties.
+func objectSchema(props map[string]any, required ...string) map[string]any {
+	schema := map[string]any{"type": "object", "properties": props}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// stringProp builds a string property schema.
+func stringProp(description string) map[string]any {
+	return map[string]any{"type": "string", "description": description}
+}
+
+// boolProp builds a boolean property schema.
+func boolProp(description string) map[string]any {
+	return map[string]any{"type": "boolean", "description": description}
+}
+
+// enumProp builds a string enum property schema.
+func enumProp(description string, values ...string) map[string]any {
+	return map[string]any{"type": "string", "description": description, "enum": values}
+}
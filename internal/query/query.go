@@ -0,0 +1,246 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package query filters, searches and sorts repository lists. The TUI, the
+// web dashboard and the MCP server share it so they list repos the same way.
+package query
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/llbbl/repjan/internal/analyze"
+	"github.com/llbbl/repjan/internal/github"
+)
+
+// Filter represents the available repository filter options.
+type Filter int
+
+const (
+	FilterAll Filter = iota
+	FilterOld
+	FilterNoStars
+	FilterForks
+	FilterBotOnly
+)
+
+// SortField represents the available sorting fields.
+type SortField int
+
+const (
+	SortName SortField = iota
+	SortActivity
+	SortStars
+	SortLanguage
+)
+
+// filterOpts contains visibility options for filtering repositories.
+type filterOpts struct {
+	showPrivate  bool
+	showArchived bool
+}
+
+// filterRepos returns a filtered slice of repositories based on the filter type, language, and visibility options.
+// By default (when opts has zero values), private and archived repos are hidden for privacy safety.
+func filterRepos(repos []github.Repository, filter Filter, language string, opts filterOpts) []github.Repository {
+	result := make([]github.Repository, 0, len(repos))
+
+	for _, repo := range repos {
+		// Apply visibility filters first (privacy-safe defaults)
+		if !opts.showArchived && repo.IsArchived {
+			continue
+		}
+		if !opts.showPrivate && repo.IsPrivate {
+			continue
+		}
+
+		// Apply filter type
+		switch filter {
+		case FilterAll:
+			// Include all visible repos
+		case FilterOld:
+			if repo.DaysSinceActivity <= 365 {
+				continue
+			}
+		case FilterNoStars:
+			if repo.StargazerCount != 0 {
+				continue
+			}
+		case FilterForks:
+			if !repo.IsFork {
+				continue
+			}
+		case FilterBotOnly:
+			if !analyze.IsBotOnly(repo) {
+				continue
+			}
+		}
+
+		// Apply language filter if specified
+		if language != "" {
+			// "None" matches repos with empty PrimaryLanguage
+			if language == "None" {
+				if repo.PrimaryLanguage != "" {
+					continue
+				}
+			} else if repo.PrimaryLanguage != language {
+				continue
+			}
+		}
+
+		result = append(result, repo)
+	}
+
+	return result
+}
+
+// sortRepos returns a sorted copy of the repositories slice.
+// Uses stable sort to preserve relative order of equal elements.
+func sortRepos(repos []github.Repository, field SortField, ascending bool) []github.Repository {
+	// Create a copy to avoid mutating the original
+	result := make([]github.Repository, len(repos))
+	copy(result, repos)
+
+	sort.SliceStable(result, func(i, j int) bool {
+		var less bool
+
+		switch field {
+		case SortName:
+			less = strings.ToLower(result[i].Name) < strings.ToLower(result[j].Name)
+		case SortActivity:
+			// Invert: ascending = oldest first (most days since activity)
+			less = result[i].DaysSinceActivity > result[j].DaysSinceActivity
+		case SortStars:
+			less = result[i].StargazerCount < result[j].StargazerCount
+		case SortLanguage:
+			less = strings.ToLower(result[i].PrimaryLanguage) < strings.ToLower(result[j].PrimaryLanguage)
+		default:
+			less = strings.ToLower(result[i].Name) < strings.ToLower(result[j].Name)
+		}
+
+		if ascending {
+			return less
+		}
+		return !less
+	})
+
+	return result
+}
+
+// searchRepos returns a filtered slice of repositories matching the search query.
+// The search is case-insensitive and matches against the repository name.
+func searchRepos(repos []github.Repository, query string) []github.Repository {
+	if query == "" {
+		return repos
+	}
+	query = strings.ToLower(query)
+	var result []github.Repository
+	for _, repo := range repos {
+		if strings.Contains(strings.ToLower(repo.Name), query) {
+			result = append(result, repo)
+		}
+	}
+	return result
+}
+
+// Query describes the filter, search and sort applied to the repository list.
+// It hides private and archived repos unless asked to show them.
+type Query struct {
+	Filter       Filter
+	Language     string // exact language, or "None" for repos without one
+	Search       string // case-insensitive substring of the repo name
+	Sort         SortField
+	Ascending    bool
+	ShowPrivate  bool
+	ShowArchived bool
+
+	// Verdict keeps only repos whose entry in Verdicts has this decision;
+	// "" disables the verdict filter.
+	Verdict  analyze.Decision
+	Verdicts map[string]analyze.Verdict // key: owner/name
+}
+
+// Apply returns the repositories matching the query in sorted order.
+// Filters are applied first, then search, then sort.
+func (q Query) Apply(repos []github.Repository) []github.Repository {
+	opts := filterOpts{
+		showPrivate:  q.ShowPrivate,
+		showArchived: q.ShowArchived,
+	}
+
+	filtered := filterRepos(repos, q.Filter, q.Language, opts)
+	if q.Verdict != "" {
+		filtered = filterByVerdict(filtered, q.Verdict, q.Verdicts)
+	}
+	if q.Search != "" {
+		filtered = searchRepos(filtered, q.Search)
+	}
+	return sortRepos(filtered, q.Sort, q.Ascending)
+}
+
+// filterByVerdict returns the repositories whose AI verdict is decision.
+func filterByVerdict(repos []github.Repository, decision analyze.Decision, verdicts map[string]analyze.Verdict) []github.Repository {
+	var result []github.Repository
+	for _, repo := range repos {
+		if v, ok := verdicts[repo.FullName()]; ok && v.Decision == decision {
+			result = append(result, repo)
+		}
+	}
+	return result
+}
+
+// filterNames maps filter names to filters.
+var filterNames = map[string]Filter{
+	"all":      FilterAll,
+	"old":      FilterOld,
+	"no-stars": FilterNoStars,
+	"forks":    FilterForks,
+	"bot-only": FilterBotOnly,
+}
+
+// sortFieldNames maps sort field names to sort fields.
+var sortFieldNames = map[string]SortField{
+	"name":     SortName,
+	"activity": SortActivity,
+	"stars":    SortStars,
+	"language": SortLanguage,
+}
+
+// ParseFilter returns the filter for a name: all, old, no-stars, forks or bot-only.
+// An empty name is FilterAll.
+func ParseFilter(name string) (Filter, error) {
+	if name == "" {
+		return FilterAll, nil
+	}
+	f, ok := filterNames[strings.ToLower(name)]
+	if !ok {
+		return FilterAll, fmt.Errorf("unknown filter %q: must be one of all, old, no-stars, forks, bot-only", name)
+	}
+	return f, nil
+}
+
+// ParseOrder reports whether an order name, asc or desc, sorts ascending.
+// An empty name is ascending.
+func ParseOrder(name string) (bool, error) {
+	switch strings.ToLower(name) {
+	case "", "asc":
+		return true, nil
+	case "desc":
+		return false, nil
+	}
+	return true, fmt.Errorf("unknown order %q: must be asc or desc", name)
+}
+
+// ParseSortField returns the sort field for a name: name, activity, stars or language.
+// An empty name is SortName.
+func ParseSortField(name string) (SortField, error) {
+	if name == "" {
+		return SortName, nil
+	}
+	f, ok := sortFieldNames[strings.ToLower(name)]
+	if !ok {
+		return SortName, fmt.Errorf("unknown sort field %q: must be one of name, activity, stars, language", name)
+	}
+	return f, nil
+}
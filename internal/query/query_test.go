@@ -0,0 +1,997 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package query
+
+import (
+	"testing"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterRepos(t *testing.T) {
+	tests := []struct {
+		name     string
+		repos    []github.Repository
+		filter   Filter
+		language string
+		wantLen  int
+	}{
+		{
+			name:     "empty repo list returns empty",
+			repos:    []github.Repository{},
+			filter:   FilterAll,
+			language: "",
+			wantLen:  0,
+		},
+		{
+			name: "single repo passes through",
+			repos: []github.Repository{
+				testutil.NewTestRepo(),
+			},
+			filter:   FilterAll,
+			language: "",
+			wantLen:  1,
+		},
+		{
+			name: "all filter excludes archived",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("active")),
+				testutil.NewTestRepo(testutil.WithName("archived"), testutil.WithArchived(true)),
+			},
+			filter:   FilterAll,
+			language: "",
+			wantLen:  1,
+		},
+		{
+			name: "all filter includes all non-archived repos",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("repo1")),
+				testutil.NewTestRepo(testutil.WithName("repo2")),
+				testutil.NewTestRepo(testutil.WithName("repo3")),
+			},
+			filter:   FilterAll,
+			language: "",
+			wantLen:  3,
+		},
+		{
+			name: "old filter includes repos inactive for more than 365 days",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("old"), testutil.WithDaysInactive(366)),
+				testutil.NewTestRepo(testutil.WithName("recent"), testutil.WithDaysInactive(100)),
+			},
+			filter:   FilterOld,
+			language: "",
+			wantLen:  1,
+		},
+		{
+			name: "old filter excludes exactly 365 days",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("boundary"), testutil.WithDaysInactive(365)),
+				testutil.NewTestRepo(testutil.WithName("old"), testutil.WithDaysInactive(400)),
+			},
+			filter:   FilterOld,
+			language: "",
+			wantLen:  1,
+		},
+		{
+			name: "old filter excludes archived even if old",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("old-archived"), testutil.WithDaysInactive(500), testutil.WithArchived(true)),
+				testutil.NewTestRepo(testutil.WithName("old-active"), testutil.WithDaysInactive(400)),
+			},
+			filter:   FilterOld,
+			language: "",
+			wantLen:  1,
+		},
+		{
+			name: "no stars filter includes only zero star repos",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("popular"), testutil.WithStars(100)),
+				testutil.NewTestRepo(testutil.WithName("unpopular"), testutil.WithStars(0)),
+			},
+			filter:   FilterNoStars,
+			language: "",
+			wantLen:  1,
+		},
+		{
+			name: "no stars filter excludes archived zero star repos",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("no-stars-archived"), testutil.WithStars(0), testutil.WithArchived(true)),
+				testutil.NewTestRepo(testutil.WithName("no-stars-active"), testutil.WithStars(0)),
+			},
+			filter:   FilterNoStars,
+			language: "",
+			wantLen:  1,
+		},
+		{
+			name: "forks filter includes only forks",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("original"), testutil.WithFork(false)),
+				testutil.NewTestRepo(testutil.WithName("forked"), testutil.WithFork(true)),
+			},
+			filter:   FilterForks,
+			language: "",
+			wantLen:  1,
+		},
+		{
+			name: "forks filter excludes archived forks",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("fork-archived"), testutil.WithFork(true), testutil.WithArchived(true)),
+				testutil.NewTestRepo(testutil.WithName("fork-active"), testutil.WithFork(true)),
+			},
+			filter:   FilterForks,
+			language: "",
+			wantLen:  1,
+		},
+		{
+			name: "language filter filters by language",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("go-repo"), testutil.WithLanguage("Go")),
+				testutil.NewTestRepo(testutil.WithName("rust-repo"), testutil.WithLanguage("Rust")),
+				testutil.NewTestRepo(testutil.WithName("python-repo"), testutil.WithLanguage("Python")),
+			},
+			filter:   FilterAll,
+			language: "Go",
+			wantLen:  1,
+		},
+		{
+			name: "language filter is case sensitive",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("go-repo"), testutil.WithLanguage("Go")),
+				testutil.NewTestRepo(testutil.WithName("GO-repo"), testutil.WithLanguage("GO")),
+			},
+			filter:   FilterAll,
+			language: "Go",
+			wantLen:  1,
+		},
+		{
+			name: "language filter combined with type filter",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("go-fork"), testutil.WithLanguage("Go"), testutil.WithFork(true)),
+				testutil.NewTestRepo(testutil.WithName("go-original"), testutil.WithLanguage("Go"), testutil.WithFork(false)),
+				testutil.NewTestRepo(testutil.WithName("rust-fork"), testutil.WithLanguage("Rust"), testutil.WithFork(true)),
+			},
+			filter:   FilterForks,
+			language: "Go",
+			wantLen:  1,
+		},
+		{
+			name: "bot-only filter needs a year without human commits",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("bots"), testutil.WithHumanInactive(400)),
+				testutil.NewTestRepo(testutil.WithName("humans"), testutil.WithHumanInactive(10)),
+				testutil.NewTestRepo(testutil.WithName("unchecked")),
+			},
+			filter:   FilterBotOnly,
+			language: "",
+			wantLen:  1,
+		},
+		{
+			name: "empty language filter includes all languages",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("go-repo"), testutil.WithLanguage("Go")),
+				testutil.NewTestRepo(testutil.WithName("rust-repo"), testutil.WithLanguage("Rust")),
+				testutil.NewTestRepo(testutil.WithName("no-lang"), testutil.WithLanguage("")),
+			},
+			filter:   FilterAll,
+			language: "",
+			wantLen:  3,
+		},
+		{
+			name: "all repos archived returns empty",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("archived1"), testutil.WithArchived(true)),
+				testutil.NewTestRepo(testutil.WithName("archived2"), testutil.WithArchived(true)),
+			},
+			filter:   FilterAll,
+			language: "",
+			wantLen:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := filterRepos(tt.repos, tt.filter, tt.language, filterOpts{})
+			assert.Len(t, result, tt.wantLen)
+		})
+	}
+}
+
+func TestFilterRepos_PreservesRepoData(t *testing.T) {
+	// Verify that filtering doesn't mutate or lose repository data
+	original := testutil.NewTestRepo(
+		testutil.WithName("test-repo"),
+		testutil.WithOwner("test-owner"),
+		testutil.WithStars(42),
+		testutil.WithLanguage("Go"),
+		testutil.WithDescription("Test description"),
+	)
+
+	repos := []github.Repository{original}
+	result := filterRepos(repos, FilterAll, "", filterOpts{})
+
+	require.Len(t, result, 1)
+	assert.Equal(t, "test-repo", result[0].Name)
+	assert.Equal(t, "test-owner", result[0].Owner)
+	assert.Equal(t, 42, result[0].StargazerCount)
+	assert.Equal(t, "Go", result[0].PrimaryLanguage)
+	assert.Equal(t, "Test description", result[0].Description)
+}
+
+func TestSortRepos(t *testing.T) {
+	tests := []struct {
+		name      string
+		repos     []github.Repository
+		field     SortField
+		ascending bool
+		wantFirst string
+		wantLast  string
+	}{
+		{
+			name:      "empty repo list returns empty",
+			repos:     []github.Repository{},
+			field:     SortName,
+			ascending: true,
+			wantFirst: "",
+			wantLast:  "",
+		},
+		{
+			name: "single repo returns same repo",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("only")),
+			},
+			field:     SortName,
+			ascending: true,
+			wantFirst: "only",
+			wantLast:  "only",
+		},
+		{
+			name: "sort by name ascending",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("zebra")),
+				testutil.NewTestRepo(testutil.WithName("alpha")),
+				testutil.NewTestRepo(testutil.WithName("mike")),
+			},
+			field:     SortName,
+			ascending: true,
+			wantFirst: "alpha",
+			wantLast:  "zebra",
+		},
+		{
+			name: "sort by name descending",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("zebra")),
+				testutil.NewTestRepo(testutil.WithName("alpha")),
+				testutil.NewTestRepo(testutil.WithName("mike")),
+			},
+			field:     SortName,
+			ascending: false,
+			wantFirst: "zebra",
+			wantLast:  "alpha",
+		},
+		{
+			name: "sort by name is case insensitive",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("Zebra")),
+				testutil.NewTestRepo(testutil.WithName("alpha")),
+				testutil.NewTestRepo(testutil.WithName("MIKE")),
+			},
+			field:     SortName,
+			ascending: true,
+			wantFirst: "alpha",
+			wantLast:  "Zebra",
+		},
+		{
+			name: "sort by activity ascending (oldest first)",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("old"), testutil.WithDaysInactive(100)),
+				testutil.NewTestRepo(testutil.WithName("recent"), testutil.WithDaysInactive(10)),
+				testutil.NewTestRepo(testutil.WithName("ancient"), testutil.WithDaysInactive(500)),
+			},
+			field:     SortActivity,
+			ascending: true,
+			wantFirst: "ancient",
+			wantLast:  "recent",
+		},
+		{
+			name: "sort by activity descending (most recent first)",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("old"), testutil.WithDaysInactive(100)),
+				testutil.NewTestRepo(testutil.WithName("recent"), testutil.WithDaysInactive(10)),
+				testutil.NewTestRepo(testutil.WithName("ancient"), testutil.WithDaysInactive(500)),
+			},
+			field:     SortActivity,
+			ascending: false,
+			wantFirst: "recent",
+			wantLast:  "ancient",
+		},
+		{
+			name: "sort by stars ascending",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("popular"), testutil.WithStars(100)),
+				testutil.NewTestRepo(testutil.WithName("unpopular"), testutil.WithStars(0)),
+				testutil.NewTestRepo(testutil.WithName("medium"), testutil.WithStars(50)),
+			},
+			field:     SortStars,
+			ascending: true,
+			wantFirst: "unpopular",
+			wantLast:  "popular",
+		},
+		{
+			name: "sort by stars descending",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("popular"), testutil.WithStars(100)),
+				testutil.NewTestRepo(testutil.WithName("unpopular"), testutil.WithStars(0)),
+				testutil.NewTestRepo(testutil.WithName("medium"), testutil.WithStars(50)),
+			},
+			field:     SortStars,
+			ascending: false,
+			wantFirst: "popular",
+			wantLast:  "unpopular",
+		},
+		{
+			name: "sort by language ascending",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("rust-repo"), testutil.WithLanguage("Rust")),
+				testutil.NewTestRepo(testutil.WithName("go-repo"), testutil.WithLanguage("Go")),
+				testutil.NewTestRepo(testutil.WithName("python-repo"), testutil.WithLanguage("Python")),
+			},
+			field:     SortLanguage,
+			ascending: true,
+			wantFirst: "go-repo",
+			wantLast:  "rust-repo",
+		},
+		{
+			name: "sort by language descending",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("rust-repo"), testutil.WithLanguage("Rust")),
+				testutil.NewTestRepo(testutil.WithName("go-repo"), testutil.WithLanguage("Go")),
+				testutil.NewTestRepo(testutil.WithName("python-repo"), testutil.WithLanguage("Python")),
+			},
+			field:     SortLanguage,
+			ascending: false,
+			wantFirst: "rust-repo",
+			wantLast:  "go-repo",
+		},
+		{
+			name: "sort by language is case insensitive",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("rust-repo"), testutil.WithLanguage("RUST")),
+				testutil.NewTestRepo(testutil.WithName("go-repo"), testutil.WithLanguage("go")),
+				testutil.NewTestRepo(testutil.WithName("python-repo"), testutil.WithLanguage("Python")),
+			},
+			field:     SortLanguage,
+			ascending: true,
+			wantFirst: "go-repo",
+			wantLast:  "rust-repo",
+		},
+		{
+			name: "unknown sort field defaults to name",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("zebra")),
+				testutil.NewTestRepo(testutil.WithName("alpha")),
+			},
+			field:     SortField(99), // invalid field
+			ascending: true,
+			wantFirst: "alpha",
+			wantLast:  "zebra",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sortRepos(tt.repos, tt.field, tt.ascending)
+
+			if len(tt.repos) == 0 {
+				assert.Empty(t, result)
+				return
+			}
+
+			assert.Equal(t, tt.wantFirst, result[0].Name)
+			assert.Equal(t, tt.wantLast, result[len(result)-1].Name)
+		})
+	}
+}
+
+func TestSortRepos_StableSort(t *testing.T) {
+	// Verify that stable sort preserves relative order of equal elements
+	repos := []github.Repository{
+		testutil.NewTestRepo(testutil.WithName("first"), testutil.WithStars(10)),
+		testutil.NewTestRepo(testutil.WithName("second"), testutil.WithStars(10)),
+		testutil.NewTestRepo(testutil.WithName("third"), testutil.WithStars(10)),
+	}
+
+	result := sortRepos(repos, SortStars, true)
+
+	// With stable sort, order should be preserved when values are equal
+	require.Len(t, result, 3)
+	assert.Equal(t, "first", result[0].Name)
+	assert.Equal(t, "second", result[1].Name)
+	assert.Equal(t, "third", result[2].Name)
+}
+
+func TestSortRepos_DoesNotMutateOriginal(t *testing.T) {
+	original := []github.Repository{
+		testutil.NewTestRepo(testutil.WithName("zebra")),
+		testutil.NewTestRepo(testutil.WithName("alpha")),
+	}
+
+	// Store original order
+	firstName := original[0].Name
+	secondName := original[1].Name
+
+	_ = sortRepos(original, SortName, true)
+
+	// Verify original slice is unchanged
+	assert.Equal(t, firstName, original[0].Name)
+	assert.Equal(t, secondName, original[1].Name)
+}
+
+func TestFilterRepos_VisibilityOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		repos   []github.Repository
+		opts    filterOpts
+		wantLen int
+	}{
+		{
+			name: "default hides private repos",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("public"), testutil.WithPrivate(false)),
+				testutil.NewTestRepo(testutil.WithName("private"), testutil.WithPrivate(true)),
+			},
+			opts:    filterOpts{showPrivate: false, showArchived: false},
+			wantLen: 1,
+		},
+		{
+			name: "showPrivate includes private repos",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("public"), testutil.WithPrivate(false)),
+				testutil.NewTestRepo(testutil.WithName("private"), testutil.WithPrivate(true)),
+			},
+			opts:    filterOpts{showPrivate: true, showArchived: false},
+			wantLen: 2,
+		},
+		{
+			name: "default hides archived repos",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("active")),
+				testutil.NewTestRepo(testutil.WithName("archived"), testutil.WithArchived(true)),
+			},
+			opts:    filterOpts{showPrivate: false, showArchived: false},
+			wantLen: 1,
+		},
+		{
+			name: "showArchived includes archived repos",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("active")),
+				testutil.NewTestRepo(testutil.WithName("archived"), testutil.WithArchived(true)),
+			},
+			opts:    filterOpts{showPrivate: false, showArchived: true},
+			wantLen: 2,
+		},
+		{
+			name: "all visibility options show all repos",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("public-active")),
+				testutil.NewTestRepo(testutil.WithName("private-active"), testutil.WithPrivate(true)),
+				testutil.NewTestRepo(testutil.WithName("public-archived"), testutil.WithArchived(true)),
+				testutil.NewTestRepo(testutil.WithName("private-archived"), testutil.WithPrivate(true), testutil.WithArchived(true)),
+			},
+			opts:    filterOpts{showPrivate: true, showArchived: true},
+			wantLen: 4,
+		},
+		{
+			name: "visibility works with content filters",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("old-public"), testutil.WithDaysInactive(400)),
+				testutil.NewTestRepo(testutil.WithName("old-private"), testutil.WithDaysInactive(400), testutil.WithPrivate(true)),
+				testutil.NewTestRepo(testutil.WithName("recent-public"), testutil.WithDaysInactive(100)),
+			},
+			opts:    filterOpts{showPrivate: false, showArchived: false},
+			wantLen: 1, // Only old-public matches (old filter + hidden private)
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Default to FilterAll for visibility tests, except for the combined test
+			filter := FilterAll
+			if tt.name == "visibility works with content filters" {
+				filter = FilterOld
+			}
+			result := filterRepos(tt.repos, filter, "", tt.opts)
+			assert.Len(t, result, tt.wantLen)
+		})
+	}
+}
+
+// TestFilterRepos_AllPrivate verifies that when all repos are private,
+// the result is empty with default visibility settings.
+func TestFilterRepos_AllPrivate(t *testing.T) {
+	repos := []github.Repository{
+		testutil.NewTestRepo(testutil.WithName("private1"), testutil.WithPrivate(true)),
+		testutil.NewTestRepo(testutil.WithName("private2"), testutil.WithPrivate(true)),
+		testutil.NewTestRepo(testutil.WithName("private3"), testutil.WithPrivate(true)),
+	}
+
+	// Default opts (showPrivate=false) should return empty
+	result := filterRepos(repos, FilterAll, "", filterOpts{showPrivate: false, showArchived: false})
+	assert.Empty(t, result, "all private repos should be hidden with default visibility")
+
+	// With showPrivate=true, all should be visible
+	result = filterRepos(repos, FilterAll, "", filterOpts{showPrivate: true, showArchived: false})
+	assert.Len(t, result, 3, "all private repos should be visible when showPrivate=true")
+}
+
+// TestFilterRepos_LanguageWithVisibility verifies that language filter
+// works correctly in combination with visibility options.
+func TestFilterRepos_LanguageWithVisibility(t *testing.T) {
+	repos := []github.Repository{
+		testutil.NewTestRepo(testutil.WithName("public-go"), testutil.WithLanguage("Go"), testutil.WithPrivate(false)),
+		testutil.NewTestRepo(testutil.WithName("private-go"), testutil.WithLanguage("Go"), testutil.WithPrivate(true)),
+		testutil.NewTestRepo(testutil.WithName("public-rust"), testutil.WithLanguage("Rust"), testutil.WithPrivate(false)),
+		testutil.NewTestRepo(testutil.WithName("archived-go"), testutil.WithLanguage("Go"), testutil.WithArchived(true)),
+	}
+
+	tests := []struct {
+		name     string
+		opts     filterOpts
+		language string
+		wantLen  int
+		wantName string // expected first repo name
+	}{
+		{
+			name:     "language filter with default visibility",
+			opts:     filterOpts{showPrivate: false, showArchived: false},
+			language: "Go",
+			wantLen:  1,
+			wantName: "public-go",
+		},
+		{
+			name:     "language filter with private visible",
+			opts:     filterOpts{showPrivate: true, showArchived: false},
+			language: "Go",
+			wantLen:  2,
+			wantName: "private-go", // alphabetically first
+		},
+		{
+			name:     "language filter with archived visible",
+			opts:     filterOpts{showPrivate: false, showArchived: true},
+			language: "Go",
+			wantLen:  2,
+			wantName: "archived-go", // alphabetically first
+		},
+		{
+			name:     "language filter with all visible",
+			opts:     filterOpts{showPrivate: true, showArchived: true},
+			language: "Go",
+			wantLen:  3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := filterRepos(repos, FilterAll, tt.language, tt.opts)
+			assert.Len(t, result, tt.wantLen)
+			if tt.wantName != "" && len(result) > 0 {
+				// Results are not sorted by filterRepos, so just check the name exists
+				names := make([]string, len(result))
+				for i, r := range result {
+					names[i] = r.Name
+				}
+				assert.Contains(t, names, tt.wantName)
+			}
+		})
+	}
+}
+
+// TestFilterRepos_ContentFiltersWithVisibility verifies that content filters
+// (Old, NoStars, Forks) work correctly on top of visibility filters.
+func TestFilterRepos_ContentFiltersWithVisibility(t *testing.T) {
+	repos := []github.Repository{
+		// Public, active repos for content filter testing
+		testutil.NewTestRepo(testutil.WithName("old-public"), testutil.WithDaysInactive(400)),
+		testutil.NewTestRepo(testutil.WithName("recent-public"), testutil.WithDaysInactive(100)),
+		testutil.NewTestRepo(testutil.WithName("nostar-public"), testutil.WithStars(0)),
+		testutil.NewTestRepo(testutil.WithName("starred-public"), testutil.WithStars(50)),
+		testutil.NewTestRepo(testutil.WithName("fork-public"), testutil.WithFork(true)),
+		testutil.NewTestRepo(testutil.WithName("original-public"), testutil.WithFork(false)),
+		// Private versions
+		testutil.NewTestRepo(testutil.WithName("old-private"), testutil.WithDaysInactive(400), testutil.WithPrivate(true)),
+		testutil.NewTestRepo(testutil.WithName("nostar-private"), testutil.WithStars(0), testutil.WithPrivate(true)),
+		testutil.NewTestRepo(testutil.WithName("fork-private"), testutil.WithFork(true), testutil.WithPrivate(true)),
+	}
+
+	tests := []struct {
+		name    string
+		filter  Filter
+		opts    filterOpts
+		wantLen int
+	}{
+		{
+			name:    "old filter with default visibility",
+			filter:  FilterOld,
+			opts:    filterOpts{showPrivate: false, showArchived: false},
+			wantLen: 1, // only old-public
+		},
+		{
+			name:    "old filter with private visible",
+			filter:  FilterOld,
+			opts:    filterOpts{showPrivate: true, showArchived: false},
+			wantLen: 2, // old-public + old-private
+		},
+		{
+			name:    "nostar filter with default visibility",
+			filter:  FilterNoStars,
+			opts:    filterOpts{showPrivate: false, showArchived: false},
+			wantLen: 1, // only nostar-public
+		},
+		{
+			name:    "nostar filter with private visible",
+			filter:  FilterNoStars,
+			opts:    filterOpts{showPrivate: true, showArchived: false},
+			wantLen: 2, // nostar-public + nostar-private
+		},
+		{
+			name:    "forks filter with default visibility",
+			filter:  FilterForks,
+			opts:    filterOpts{showPrivate: false, showArchived: false},
+			wantLen: 1, // only fork-public
+		},
+		{
+			name:    "forks filter with private visible",
+			filter:  FilterForks,
+			opts:    filterOpts{showPrivate: true, showArchived: false},
+			wantLen: 2, // fork-public + fork-private
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := filterRepos(repos, tt.filter, "", tt.opts)
+			assert.Len(t, result, tt.wantLen)
+		})
+	}
+}
+
+// TestFilterRepos_NoneLanguageWithVisibility verifies "None" language filter
+// (repos with no language) works with visibility options.
+func TestFilterRepos_NoneLanguageWithVisibility(t *testing.T) {
+	repos := []github.Repository{
+		testutil.NewTestRepo(testutil.WithName("public-no-lang"), testutil.WithLanguage("")),
+		testutil.NewTestRepo(testutil.WithName("private-no-lang"), testutil.WithLanguage(""), testutil.WithPrivate(true)),
+		testutil.NewTestRepo(testutil.WithName("public-go"), testutil.WithLanguage("Go")),
+	}
+
+	// Default visibility: only public no-lang
+	result := filterRepos(repos, FilterAll, "None", filterOpts{showPrivate: false, showArchived: false})
+	require.Len(t, result, 1)
+	assert.Equal(t, "public-no-lang", result[0].Name)
+
+	// With private visible: both no-lang repos
+	result = filterRepos(repos, FilterAll, "None", filterOpts{showPrivate: true, showArchived: false})
+	assert.Len(t, result, 2)
+}
+
+func TestSearchRepos(t *testing.T) {
+	tests := []struct {
+		name     string
+		repos    []github.Repository
+		query    string
+		wantLen  int
+		wantName string // expected first match name (if any)
+	}{
+		{
+			name: "empty query returns all repos",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("repo1")),
+				testutil.NewTestRepo(testutil.WithName("repo2")),
+				testutil.NewTestRepo(testutil.WithName("repo3")),
+			},
+			query:   "",
+			wantLen: 3,
+		},
+		{
+			name: "no matches returns empty slice",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("alpha")),
+				testutil.NewTestRepo(testutil.WithName("beta")),
+			},
+			query:   "zebra",
+			wantLen: 0,
+		},
+		{
+			name: "partial name match",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("my-awesome-project")),
+				testutil.NewTestRepo(testutil.WithName("another-project")),
+				testutil.NewTestRepo(testutil.WithName("unrelated")),
+			},
+			query:    "project",
+			wantLen:  2,
+			wantName: "my-awesome-project",
+		},
+		{
+			name: "case insensitive lowercase query",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("MyRepo")),
+				testutil.NewTestRepo(testutil.WithName("OTHER")),
+			},
+			query:    "myrepo",
+			wantLen:  1,
+			wantName: "MyRepo",
+		},
+		{
+			name: "case insensitive uppercase query",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("myrepo")),
+				testutil.NewTestRepo(testutil.WithName("other")),
+			},
+			query:    "MYREPO",
+			wantLen:  1,
+			wantName: "myrepo",
+		},
+		{
+			name: "case insensitive mixed case query",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("MyAwesomeRepo")),
+				testutil.NewTestRepo(testutil.WithName("other")),
+			},
+			query:    "mYaWeSoMe",
+			wantLen:  1,
+			wantName: "MyAwesomeRepo",
+		},
+		{
+			name: "multiple matches",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("test-one")),
+				testutil.NewTestRepo(testutil.WithName("test-two")),
+				testutil.NewTestRepo(testutil.WithName("test-three")),
+				testutil.NewTestRepo(testutil.WithName("other")),
+			},
+			query:   "test",
+			wantLen: 3,
+		},
+		{
+			name: "exact name match",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("exact")),
+				testutil.NewTestRepo(testutil.WithName("exactlylong")),
+				testutil.NewTestRepo(testutil.WithName("other")),
+			},
+			query:    "exact",
+			wantLen:  2, // Both "exact" and "exactlylong" contain "exact"
+			wantName: "exact",
+		},
+		{
+			name: "single character search",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("a")),
+				testutil.NewTestRepo(testutil.WithName("ab")),
+				testutil.NewTestRepo(testutil.WithName("abc")),
+				testutil.NewTestRepo(testutil.WithName("xyz")),
+			},
+			query:   "a",
+			wantLen: 3,
+		},
+		{
+			name:    "empty repo list returns empty",
+			repos:   []github.Repository{},
+			query:   "test",
+			wantLen: 0,
+		},
+		{
+			name: "search with hyphen",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("my-repo")),
+				testutil.NewTestRepo(testutil.WithName("myrepo")),
+				testutil.NewTestRepo(testutil.WithName("my_repo")),
+			},
+			query:    "my-",
+			wantLen:  1,
+			wantName: "my-repo",
+		},
+		{
+			name: "search with underscore",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("my_repo")),
+				testutil.NewTestRepo(testutil.WithName("myrepo")),
+				testutil.NewTestRepo(testutil.WithName("my-repo")),
+			},
+			query:    "_repo",
+			wantLen:  1,
+			wantName: "my_repo",
+		},
+		{
+			name: "search with numbers",
+			repos: []github.Repository{
+				testutil.NewTestRepo(testutil.WithName("project123")),
+				testutil.NewTestRepo(testutil.WithName("project456")),
+				testutil.NewTestRepo(testutil.WithName("other")),
+			},
+			query:    "123",
+			wantLen:  1,
+			wantName: "project123",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := searchRepos(tt.repos, tt.query)
+			assert.Len(t, result, tt.wantLen)
+
+			if tt.wantLen > 0 && tt.wantName != "" {
+				assert.Equal(t, tt.wantName, result[0].Name)
+			}
+		})
+	}
+}
+
+func TestSearchRepos_PreservesOrder(t *testing.T) {
+	repos := []github.Repository{
+		testutil.NewTestRepo(testutil.WithName("aaa-test")),
+		testutil.NewTestRepo(testutil.WithName("bbb-test")),
+		testutil.NewTestRepo(testutil.WithName("ccc-test")),
+	}
+
+	result := searchRepos(repos, "test")
+
+	require.Len(t, result, 3)
+	// Order should be preserved from input
+	assert.Equal(t, "aaa-test", result[0].Name)
+	assert.Equal(t, "bbb-test", result[1].Name)
+	assert.Equal(t, "ccc-test", result[2].Name)
+}
+
+func TestSearchRepos_DoesNotMutateOriginal(t *testing.T) {
+	original := []github.Repository{
+		testutil.NewTestRepo(testutil.WithName("alpha")),
+		testutil.NewTestRepo(testutil.WithName("beta")),
+	}
+
+	// Store original names
+	firstName := original[0].Name
+	secondName := original[1].Name
+
+	_ = searchRepos(original, "alpha")
+
+	// Original should be unchanged
+	assert.Equal(t, firstName, original[0].Name)
+	assert.Equal(t, secondName, original[1].Name)
+	assert.Len(t, original, 2)
+}
+
+func TestSearchRepos_PreservesRepoData(t *testing.T) {
+	original := testutil.NewTestRepo(
+		testutil.WithName("test-repo"),
+		testutil.WithOwner("test-owner"),
+		testutil.WithStars(42),
+		testutil.WithLanguage("Go"),
+		testutil.WithDescription("Test description"),
+	)
+
+	repos := []github.Repository{original}
+	result := searchRepos(repos, "test")
+
+	require.Len(t, result, 1)
+	assert.Equal(t, "test-repo", result[0].Name)
+	assert.Equal(t, "test-owner", result[0].Owner)
+	assert.Equal(t, 42, result[0].StargazerCount)
+	assert.Equal(t, "Go", result[0].PrimaryLanguage)
+	assert.Equal(t, "Test description", result[0].Description)
+}
+
+func TestSearchRepos_SpecialCharacters(t *testing.T) {
+	tests := []struct {
+		name     string
+		repoName string
+		query    string
+		matches  bool
+	}{
+		{
+			name:     "dot in name",
+			repoName: "my.repo",
+			query:    ".",
+			matches:  true,
+		},
+		{
+			name:     "at sign in name",
+			repoName: "my@repo",
+			query:    "@",
+			matches:  true,
+		},
+		{
+			name:     "plus in name",
+			repoName: "cpp+plus",
+			query:    "+",
+			matches:  true,
+		},
+		{
+			name:     "parentheses in search",
+			repoName: "func()",
+			query:    "()",
+			matches:  true,
+		},
+		{
+			name:     "brackets in name",
+			repoName: "array[0]",
+			query:    "[0]",
+			matches:  true,
+		},
+		{
+			name:     "space in search query",
+			repoName: "my repo",
+			query:    "my ",
+			matches:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repos := []github.Repository{
+				testutil.NewTestRepo(testutil.WithName(tt.repoName)),
+			}
+			result := searchRepos(repos, tt.query)
+			if tt.matches {
+				assert.Len(t, result, 1, "expected match for query %q in name %q", tt.query, tt.repoName)
+			} else {
+				assert.Empty(t, result, "expected no match for query %q in name %q", tt.query, tt.repoName)
+			}
+		})
+	}
+}
+
+func TestQueryApply(t *testing.T) {
+	repos := []github.Repository{
+		testutil.NewTestRepo(testutil.WithName("beta"), testutil.WithDaysInactive(400)),
+		testutil.NewTestRepo(testutil.WithName("alpha"), testutil.WithDaysInactive(500)),
+		testutil.NewTestRepo(testutil.WithName("hidden"), testutil.WithDaysInactive(600), testutil.WithPrivate(true)),
+		testutil.NewTestRepo(testutil.WithName("recent"), testutil.WithDaysInactive(3)),
+	}
+
+	q := Query{Filter: FilterOld, Sort: SortName, Ascending: true}
+	got := q.Apply(repos)
+	assert.Equal(t, []string{"alpha", "beta"}, []string{got[0].Name, got[1].Name})
+
+	q.ShowPrivate = true
+	q.Search = "HID"
+	got = q.Apply(repos)
+	require.Len(t, got, 1)
+	assert.Equal(t, "hidden", got[0].Name)
+}
+
+func TestParseFilterAndSortField(t *testing.T) {
+	filters := map[string]Filter{"": FilterAll, "all": FilterAll, "old": FilterOld, "No-Stars": FilterNoStars, "forks": FilterForks}
+	for name, want := range filters {
+		got, err := ParseFilter(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, want, got, name)
+	}
+	_, err := ParseFilter("new")
+	assert.Error(t, err)
+
+	fields := map[string]SortField{"": SortName, "name": SortName, "activity": SortActivity, "stars": SortStars, "Language": SortLanguage}
+	for name, want := range fields {
+		got, err := ParseSortField(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, want, got, name)
+	}
+	_, err = ParseSortField("size")
+	assert.Error(t, err)
+}
+
+func TestParseOrder(t *testing.T) {
+	orders := map[string]bool{"": true, "asc": true, "DESC": false}
+	for name, want := range orders {
+		got, err := ParseOrder(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, want, got, name)
+	}
+	_, err := ParseOrder("up")
+	assert.Error(t, err)
+}
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package report
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+)
+
+// htmlTemplate is a standalone HTML report with inline styles.
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"percent": percent,
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Portfolio Report: {{.R.Owner}}</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2rem; color: #222; max-width: 60rem; }
+h1, h2 { color: #7D56F4; }
+table { border-collapse: collapse; font-size: 0.9rem; margin-bottom: 1rem; }
+th, td { border: 1px solid #ddd; padding: 0.4rem 0.6rem; text-align: left; }
+th { background: #f4f1fe; }
+.bar { background: #7D56F4; height: 0.8rem; }
+.bars td:last-child { width: 20rem; }
+.meta { color: #626262; }
+</style>
+</head>
+<body>
+<h1>Portfolio Report: {{.R.Owner}}</h1>
+<p class="meta">Generated {{.R.GeneratedAt.Format "2006-01-02 15:04 MST"}}. Period: {{.R.Since.Format "2006-01-02"}} to {{.R.GeneratedAt.Format "2006-01-02"}}.</p>
+
+<h2>Summary</h2>
+<table>
+<tr><th>Total</th><td>{{.R.Total}}</td></tr>
+<tr><th>Active</th><td>{{.R.Active}}</td></tr>
+<tr><th>Archived</th><td>{{.R.Archived}}</td></tr>
+<tr><th>Archive candidates</th><td>{{.R.Candidates}} ({{percent .R.Candidates .R.Active}}% of active)</td></tr>
+<tr><th>Forks</th><td>{{.R.Forks}}</td></tr>
+<tr><th>Private</th><td>{{.R.Private}}</td></tr>
+</table>
+
+<h2>Languages</h2>
+{{template "histogram" .Languages}}
+
+<h2>Time Since Last Push</h2>
+{{template "histogram" .Ages}}
+
+<h2>Archive Candidates by Reason</h2>
+{{range .R.CandidatesByReason}}<h3>{{.Description}} ({{len .Repos}})</h3>
+<ul>{{range .Repos}}<li>{{.}}</li>{{end}}</ul>
+{{else}}<p>No archive candidates.</p>
+{{end}}
+<h2>Archived This Period</h2>
+{{if .R.ArchivedInPeriod}}<table>
+<tr><th>Repository</th><th>Archived</th><th>By</th></tr>
+{{range .R.ArchivedInPeriod}}<tr><td>{{.Repo}}</td><td>{{.PerformedAt.Format "2006-01-02"}}</td><td>{{.PerformedBy}}</td></tr>
+{{end}}</table>
+{{else}}<p>No repositories archived in this period.</p>
+{{end}}
+<h2>Sync Trend</h2>
+{{if .R.Syncs}}{{if .HasTrend}}<p>Repository count changed by {{.TrendText}} over {{len .R.Syncs}} syncs.</p>
+{{end}}<table>
+<tr><th>Synced</th><th>Fetched</th><th>New</th><th>Updated</th></tr>
+{{range .R.Syncs}}<tr><td>{{.At.Format "2006-01-02 15:04"}}</td><td>{{.Fetched}}</td><td>{{.Inserted}}</td><td>{{.Updated}}</td></tr>
+{{end}}</table>
+{{else}}<p>No successful syncs in this period.</p>
+{{end}}</body>
+</html>
+{{define "histogram"}}{{if .Counts}}<table class="bars">
+{{$max := .Max}}{{range .Counts}}<tr><td>{{.Label}}</td><td>{{.Count}}</td><td><div class="bar" style="width: {{percent .Count $max}}%"></div></td></tr>
+{{end}}</table>{{else}}<p>No data.</p>{{end}}{{end}}`))
+
+// histogram is the template data for one histogram table.
+type histogram struct {
+	Counts []Count
+	Max    int
+}
+
+// newHistogram wraps counts with their maximum for bar scaling.
+func newHistogram(counts []Count) histogram {
+	h := histogram{Counts: counts}
+	for _, c := range counts {
+		h.Max = max(h.Max, c.Count)
+	}
+	return h
+}
+
+// writeHTML renders the report as a standalone HTML page.
+func writeHTML(w io.Writer, r Report) error {
+	delta, hasTrend := r.Trend()
+	data := struct {
+		R         Report
+		Languages histogram
+		Ages      histogram
+		HasTrend  bool
+		TrendText string
+	}{
+		R:         r,
+		Languages: newHistogram(r.Languages),
+		Ages:      newHistogram(r.Ages),
+		HasTrend:  hasTrend,
+		TrendText: fmt.Sprintf("%+d", delta),
+	}
+	return htmlTemplate.Execute(w, data)
+}
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package report
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// barWidth is the width in characters of the longest histogram bar.
+const barWidth = 30
+
+// writeMarkdown renders the report as a Markdown document.
+func writeMarkdown(w io.Writer, r Report) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# Portfolio Report: %s\n\n", r.Owner)
+	fmt.Fprintf(&b, "Generated %s. Period: %s to %s.\n\n",
+		r.GeneratedAt.Format("2006-01-02 15:04 MST"), r.Since.Format("2006-01-02"), r.GeneratedAt.Format("2006-01-02"))
+
+	b.WriteString("## Summary\n\n")
+	b.WriteString("| Status | Repositories |\n| --- | --- |\n")
+	fmt.Fprintf(&b, "| Total | %d |\n", r.Total)
+	fmt.Fprintf(&b, "| Active | %d |\n", r.Active)
+	fmt.Fprintf(&b, "| Archived | %d |\n", r.Archived)
+	fmt.Fprintf(&b, "| Archive candidates | %d (%d%% of active) |\n", r.Candidates, percent(r.Candidates, r.Active))
+	fmt.Fprintf(&b, "| Forks | %d |\n", r.Forks)
+	fmt.Fprintf(&b, "| Private | %d |\n\n", r.Private)
+
+	b.WriteString("## Languages\n\n")
+	writeMarkdownHistogram(&b, "Language", r.Languages)
+
+	b.WriteString("## Time Since Last Push\n\n")
+	writeMarkdownHistogram(&b, "Age", r.Ages)
+
+	b.WriteString("## Archive Candidates by Reason\n\n")
+	if len(r.CandidatesByReason) == 0 {
+		b.WriteString("No archive candidates.\n\n")
+	}
+	for _, g := range r.CandidatesByReason {
+		fmt.Fprintf(&b, "### %s (%d)\n\n", g.Description, len(g.Repos))
+		for _, name := range g.Repos {
+			fmt.Fprintf(&b, "- %s\n", name)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("## Archived This Period\n\n")
+	if len(r.ArchivedInPeriod) == 0 {
+		b.WriteString("No repositories archived in this period.\n\n")
+	} else {
+		b.WriteString("| Repository | Archived | By |\n| --- | --- | --- |\n")
+		for _, c := range r.ArchivedInPeriod {
+			fmt.Fprintf(&b, "| %s | %s | %s |\n", c.Repo, c.PerformedAt.Format("2006-01-02"), c.PerformedBy)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("## Sync Trend\n\n")
+	if len(r.Syncs) == 0 {
+		b.WriteString("No successful syncs in this period.\n")
+	} else {
+		if delta, ok := r.Trend(); ok {
+			fmt.Fprintf(&b, "Repository count changed by %+d over %d syncs.\n\n", delta, len(r.Syncs))
+		}
+		b.WriteString("| Synced | Fetched | New | Updated |\n| --- | --- | --- | --- |\n")
+		for _, s := range r.Syncs {
+			fmt.Fprintf(&b, "| %s | %d | %d | %d |\n", s.At.Format("2006-01-02 15:04"), s.Fetched, s.Inserted, s.Updated)
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeMarkdownHistogram writes counts as a table with a text bar column.
+func writeMarkdownHistogram(b *strings.Builder, heading string, counts []Count) {
+	if len(counts) == 0 {
+		b.WriteString("No data.\n\n")
+		return
+	}
+
+	fmt.Fprintf(b, "| %s | Repositories | |\n| --- | --- | --- |\n", heading)
+	maxCount := 0
+	for _, c := range counts {
+		maxCount = max(maxCount, c.Count)
+	}
+	for _, c := range counts {
+		fmt.Fprintf(b, "| %s | %d | %s |\n", c.Label, c.Count, bar(c.Count, maxCount))
+	}
+	b.WriteString("\n")
+}
+
+// bar returns a text bar for n scaled so maxCount fills barWidth.
+func bar(n, maxCount int) string {
+	if maxCount == 0 || n == 0 {
+		return ""
+	}
+	width := max(n*barWidth/maxCount, 1)
+	return strings.Repeat("█", width)
+}
@@ -0,0 +1,257 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package report builds portfolio health reports from stored repository data.
+package report
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/llbbl/repjan/internal/analyze"
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+// Report formats.
+const (
+	FormatMarkdown = "markdown"
+	FormatHTML     = "html"
+)
+
+// Report is a point-in-time summary of an owner's repository portfolio.
+type Report struct {
+	Owner       string
+	GeneratedAt time.Time
+	Since       time.Time // start of the reporting period
+
+	Total      int
+	Active     int // not archived
+	Archived   int
+	Candidates int // active repos matching archive heuristics
+	Forks      int
+	Private    int
+
+	Languages          []Count       // active repos by language, most common first
+	Ages               []Count       // active repos by time since last push
+	CandidatesByReason []ReasonGroup // candidates grouped by heuristic
+	ArchivedInPeriod   []Change      // archive changes recorded since Since
+	Syncs              []SyncPoint   // successful syncs since Since, oldest first
+}
+
+// Count is a labelled count for distributions and histograms.
+type Count struct {
+	Label string
+	Count int
+}
+
+// ReasonGroup lists the archive candidates that matched one heuristic.
+type ReasonGroup struct {
+	Code        analyze.ReasonCode
+	Description string
+	Repos       []string // full names, highest score first
+}
+
+// Change is a recorded repository change included in the report.
+type Change struct {
+	Repo        string
+	PerformedAt time.Time
+	PerformedBy string
+}
+
+// SyncPoint is one sync in the repository count trend.
+type SyncPoint struct {
+	At       time.Time
+	Fetched  int
+	Inserted int
+	Updated  int
+}
+
+// ageBucket is an upper bound on days since last push and its label.
+type ageBucket struct {
+	maxDays int
+	label   string
+}
+
+// ageBuckets are the age histogram buckets in order; the last has no upper bound.
+var ageBuckets = []ageBucket{
+	{90, "< 3 months"},
+	{180, "3-6 months"},
+	{365, "6-12 months"},
+	{730, "1-2 years"},
+	{-1, "2+ years"},
+}
+
+// unknownLanguage labels repositories without a detected language.
+const unknownLanguage = "(none)"
+
+// Build summarizes repos, archive changes and sync history for the period
+// starting at since. The assessor adds stored README and AI verdict signals
+// to the candidate heuristics; nil uses repository metadata only.
+func Build(owner string, repos []github.Repository, assessor *analyze.Assessor, changes []store.RepoChange, syncs []store.SyncRecord, since, now time.Time) Report {
+	r := Report{
+		Owner:       owner,
+		GeneratedAt: now,
+		Since:       since,
+		Total:       len(repos),
+	}
+
+	languages := map[string]int{}
+	ages := make([]int, len(ageBuckets))
+	groups := map[analyze.ReasonCode]*ReasonGroup{}
+	var order []analyze.ReasonCode
+	var candidates []analyze.Assessment
+	var candidateNames []string
+
+	for _, repo := range repos {
+		if repo.IsFork {
+			r.Forks++
+		}
+		if repo.IsPrivate {
+			r.Private++
+		}
+		if repo.IsArchived {
+			r.Archived++
+			continue
+		}
+
+		r.Active++
+		lang := repo.PrimaryLanguage
+		if lang == "" {
+			lang = unknownLanguage
+		}
+		languages[lang]++
+		ages[ageBucketIndex(repo.DaysSinceActivity)]++
+
+		if a := assessor.Assess(repo); a.Candidate {
+			candidates = append(candidates, a)
+			candidateNames = append(candidateNames, repo.FullName())
+		}
+	}
+	r.Candidates = len(candidates)
+
+	// Highest scores first so each group lists the strongest candidates first
+	idx := make([]int, len(candidates))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(a, b int) bool {
+		return candidates[idx[a]].Score > candidates[idx[b]].Score
+	})
+	for _, i := range idx {
+		for _, reason := range candidates[i].Reasons {
+			g, ok := groups[reason.Code]
+			if !ok {
+				g = &ReasonGroup{Code: reason.Code, Description: reason.Description}
+				groups[reason.Code] = g
+				order = append(order, reason.Code)
+			}
+			g.Repos = append(g.Repos, candidateNames[i])
+		}
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return len(groups[order[a]].Repos) > len(groups[order[b]].Repos)
+	})
+	for _, code := range order {
+		r.CandidatesByReason = append(r.CandidatesByReason, *groups[code])
+	}
+
+	r.Languages = sortedCounts(languages)
+	for i, b := range ageBuckets {
+		r.Ages = append(r.Ages, Count{Label: b.label, Count: ages[i]})
+	}
+
+	for _, c := range changes {
+		if c.Action != "archived" || c.PerformedAt.Before(since) {
+			continue
+		}
+		r.ArchivedInPeriod = append(r.ArchivedInPeriod, Change{
+			Repo:        c.Owner + "/" + c.RepoName,
+			PerformedAt: c.PerformedAt,
+			PerformedBy: c.PerformedBy,
+		})
+	}
+	sort.Slice(r.ArchivedInPeriod, func(a, b int) bool {
+		return r.ArchivedInPeriod[a].PerformedAt.Before(r.ArchivedInPeriod[b].PerformedAt)
+	})
+
+	for _, s := range syncs {
+		if s.Status != "success" || s.StartedAt.Before(since) {
+			continue
+		}
+		r.Syncs = append(r.Syncs, SyncPoint{
+			At:       s.StartedAt,
+			Fetched:  s.ReposFetched,
+			Inserted: s.ReposInserted,
+			Updated:  s.ReposUpdated,
+		})
+	}
+	sort.Slice(r.Syncs, func(a, b int) bool { return r.Syncs[a].At.Before(r.Syncs[b].At) })
+
+	return r
+}
+
+// Trend returns the change in fetched repository count between the first and
+// last sync of the period, and false when there are fewer than two syncs.
+func (r Report) Trend() (int, bool) {
+	if len(r.Syncs) < 2 {
+		return 0, false
+	}
+	return r.Syncs[len(r.Syncs)-1].Fetched - r.Syncs[0].Fetched, true
+}
+
+// Write renders the report in the given format.
+func (r Report) Write(w io.Writer, format string) error {
+	switch strings.ToLower(format) {
+	case FormatMarkdown:
+		return writeMarkdown(w, r)
+	case FormatHTML:
+		return writeHTML(w, r)
+	}
+	return fmt.Errorf("unknown report format %q: must be one of markdown, html", format)
+}
+
+// Extension returns the file extension for a report format.
+func Extension(format string) string {
+	if strings.ToLower(format) == FormatHTML {
+		return "html"
+	}
+	return "md"
+}
+
+// ageBucketIndex returns the age bucket for a number of days since last push.
+func ageBucketIndex(days int) int {
+	for i, b := range ageBuckets {
+		if b.maxDays < 0 || days < b.maxDays {
+			return i
+		}
+	}
+	return len(ageBuckets) - 1
+}
+
+// sortedCounts converts a count map into counts sorted by count descending,
+// then label ascending.
+func sortedCounts(m map[string]int) []Count {
+	counts := make([]Count, 0, len(m))
+	for label, n := range m {
+		counts = append(counts, Count{Label: label, Count: n})
+	}
+	sort.Slice(counts, func(a, b int) bool {
+		if counts[a].Count != counts[b].Count {
+			return counts[a].Count > counts[b].Count
+		}
+		return counts[a].Label < counts[b].Label
+	})
+	return counts
+}
+
+// percent returns n as a whole-number percentage of total.
+func percent(n, total int) int {
+	if total == 0 {
+		return 0
+	}
+	return n * 100 / total
+}
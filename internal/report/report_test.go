@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package report
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/analyze"
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+var (
+	testNow   = time.Date(2026, 4, 1, 12, 0, 0, 0, time.UTC)
+	testSince = testNow.AddDate(0, 0, -90)
+)
+
+// sampleReport builds a report over a small mixed portfolio.
+func sampleReport() Report {
+	repos := []github.Repository{
+		testutil.NewTestRepo(testutil.WithName("fresh"), testutil.WithLanguage("Go"), testutil.WithDaysInactive(10), testutil.WithStars(5)),
+		testutil.NewTestRepo(testutil.WithName("stale"), testutil.WithLanguage("Go"), testutil.WithDaysInactive(800), testutil.WithStars(0), testutil.WithForks(0)),
+		testutil.NewTestRepo(testutil.WithName("old-php"), testutil.WithLanguage("PHP"), testutil.WithDaysInactive(400), testutil.WithStars(2)),
+		testutil.NewTestRepo(testutil.WithName("gone"), testutil.WithArchived(true), testutil.WithFork(true)),
+	}
+	changes := []store.RepoChange{
+		{Owner: "testowner", RepoName: "gone", Action: "archived", PerformedBy: "user", PerformedAt: testNow.AddDate(0, 0, -5)},
+		{Owner: "testowner", RepoName: "ancient", Action: "archived", PerformedBy: "user", PerformedAt: testNow.AddDate(-1, 0, 0)},
+	}
+	syncs := []store.SyncRecord{
+		{Status: "success", StartedAt: testNow.AddDate(0, 0, -1), ReposFetched: 4, ReposUpdated: 1},
+		{Status: "error", StartedAt: testNow.AddDate(0, 0, -20), ReposFetched: 0},
+		{Status: "success", StartedAt: testNow.AddDate(0, 0, -60), ReposFetched: 6, ReposInserted: 6},
+		{Status: "success", StartedAt: testNow.AddDate(0, 0, -200), ReposFetched: 9},
+	}
+	return Build("testowner", repos, nil, changes, syncs, testSince, testNow)
+}
+
+func TestBuild(t *testing.T) {
+	r := sampleReport()
+
+	assert.Equal(t, 4, r.Total)
+	assert.Equal(t, 3, r.Active)
+	assert.Equal(t, 1, r.Archived)
+	assert.Equal(t, 2, r.Candidates)
+	assert.Equal(t, 1, r.Forks)
+
+	assert.Equal(t, []Count{{"Go", 2}, {"PHP", 1}}, r.Languages)
+	assert.Equal(t, []Count{
+		{"< 3 months", 1},
+		{"3-6 months", 0},
+		{"6-12 months", 0},
+		{"1-2 years", 1},
+		{"2+ years", 1},
+	}, r.Ages)
+
+	require.NotEmpty(t, r.CandidatesByReason)
+	byCode := map[analyze.ReasonCode][]string{}
+	for _, g := range r.CandidatesByReason {
+		byCode[g.Code] = g.Repos
+	}
+	assert.Equal(t, []string{"testowner/stale"}, byCode[analyze.ReasonInactive2Years])
+	assert.Equal(t, []string{"testowner/old-php"}, byCode[analyze.ReasonLegacyLanguage])
+
+	require.Len(t, r.ArchivedInPeriod, 1)
+	assert.Equal(t, "testowner/gone", r.ArchivedInPeriod[0].Repo)
+
+	require.Len(t, r.Syncs, 2, "only successful syncs in the period")
+	assert.True(t, r.Syncs[0].At.Before(r.Syncs[1].At), "syncs should be oldest first")
+	delta, ok := r.Trend()
+	assert.True(t, ok)
+	assert.Equal(t, -2, delta)
+}
+
+func TestBuild_Empty(t *testing.T) {
+	r := Build("nobody", nil, nil, nil, nil, testSince, testNow)
+
+	assert.Zero(t, r.Total)
+	assert.Empty(t, r.Languages)
+	assert.Empty(t, r.CandidatesByReason)
+	_, ok := r.Trend()
+	assert.False(t, ok)
+
+	for _, format := range []string{FormatMarkdown, FormatHTML} {
+		var b strings.Builder
+		require.NoError(t, r.Write(&b, format))
+		assert.Contains(t, b.String(), "No archive candidates")
+	}
+}
+
+func TestWrite_Markdown(t *testing.T) {
+	var b strings.Builder
+	require.NoError(t, sampleReport().Write(&b, FormatMarkdown))
+	out := b.String()
+
+	assert.Contains(t, out, "# Portfolio Report: testowner")
+	assert.Contains(t, out, "| Archive candidates | 2 (66% of active) |")
+	assert.Contains(t, out, "| Go | 2 | "+strings.Repeat("█", barWidth)+" |")
+	assert.Contains(t, out, "### No activity in 2+ years (1)")
+	assert.Contains(t, out, "| testowner/gone |")
+	assert.Contains(t, out, "Repository count changed by -2 over 2 syncs.")
+}
+
+func TestWrite_HTML(t *testing.T) {
+	var b strings.Builder
+	require.NoError(t, sampleReport().Write(&b, "HTML"))
+	out := b.String()
+
+	assert.True(t, strings.HasPrefix(out, "<!DOCTYPE html>"))
+	assert.Contains(t, out, "<title>Portfolio Report: testowner</title>")
+	assert.Contains(t, out, `style="width: 100%"`)
+	assert.Contains(t, out, "<li>testowner/stale</li>")
+	assert.Contains(t, out, "changed by -2 over 2 syncs")
+}
+
+func TestWrite_UnknownFormat(t *testing.T) {
+	err := sampleReport().Write(&strings.Builder{}, "pdf")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown report format")
+}
+
+func TestAgeBucketIndex(t *testing.T) {
+	tests := []struct {
+		days int
+		want int
+	}{
+		{0, 0},
+		{89, 0},
+		{90, 1},
+		{364, 2},
+		{365, 3},
+		{729, 3},
+		{730, 4},
+		{5000, 4},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, ageBucketIndex(tt.days), "days=%d", tt.days)
+	}
+}
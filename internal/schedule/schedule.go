@@ -0,0 +1,342 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package schedule announces archives ahead of time with a notice issue and
+// archives repositories once their grace period passes without objection.
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/llbbl/repjan/internal/analyze"
+	"github.com/llbbl/repjan/internal/backup"
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+// DefaultGraceDays is how long after the announcement a repository is archived.
+const DefaultGraceDays = 30
+
+// dateFormat is how archive dates are written in notices and reports.
+const dateFormat = "2006-01-02"
+
+// approvals are comments that agree with the archive rather than object to it.
+var approvals = []string{"+1", "👍", "lgtm", "sgtm", "ok", "okay", "agreed", "approve", "approved", "go ahead", "fine by me"}
+
+// Client is the GitHub access scheduling and applying archives needs.
+type Client interface {
+	GetAuthenticatedUser() (string, error)
+	FetchRepoSettings(owner, name string) (github.RepoSettings, error)
+	CreateIssue(owner, name, title, body string) (github.Issue, error)
+	FetchIssueComments(owner, name string, number int) ([]github.IssueComment, error)
+	CloseIssue(owner, name string, number int, comment string) error
+	ReopenIssue(owner, name string, number int, comment string) error
+	ArchiveRepository(owner, name string) error
+}
+
+// Outcome is what ApplyDue did with a scheduled archive.
+type Outcome int
+
+const (
+	// OutcomePending means the archive date has not passed yet.
+	OutcomePending Outcome = iota
+	// OutcomeDue means the archive is due and unopposed, but this was a dry run.
+	OutcomeDue
+	// OutcomeArchived means the repository was archived.
+	OutcomeArchived
+	// OutcomeSkipped means the archive was cancelled by an objection or a push.
+	OutcomeSkipped
+	// OutcomeFailed means checking or archiving the repository failed; the
+	// schedule is kept and retried on the next run.
+	OutcomeFailed
+)
+
+// Decision is the outcome of one scheduled archive.
+type Decision struct {
+	Schedule store.ArchiveSchedule
+	Outcome  Outcome
+	Reason   string // why the archive is pending or was skipped
+	Err      error
+}
+
+// Scheduler schedules archives and applies the due ones.
+type Scheduler struct {
+	client Client
+	store  *store.Store
+	bots   *analyze.BotMatcher
+	backer *backup.Backer
+
+	mu    sync.Mutex
+	login string // authenticated user, fetched once
+}
+
+// New creates a scheduler. Comments by authors matching the default bot
+// patterns or botPatterns never count as objections.
+func New(client Client, s *store.Store, botPatterns []string) *Scheduler {
+	return &Scheduler{client: client, store: s, bots: analyze.NewBotMatcher(botPatterns)}
+}
+
+// SetBackup makes ApplyDue back each repository up before archiving it.
+func (s *Scheduler) SetBackup(b *backup.Backer) {
+	s.backer = b
+}
+
+// ArchiveDate returns the archive date for an announcement made at now.
+func ArchiveDate(now time.Time, graceDays int) time.Time {
+	y, m, d := now.AddDate(0, 0, graceDays).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// Schedule opens a notice issue on repo announcing its archive on archiveOn
+// and records the schedule. A repository that is already scheduled keeps its
+// existing notice and date.
+func (s *Scheduler) Schedule(repo github.Repository, archiveOn time.Time) (store.ArchiveSchedule, error) {
+	existing, err := s.store.GetArchiveSchedules(repo.Owner)
+	if err != nil {
+		return store.ArchiveSchedule{}, err
+	}
+	for _, sched := range existing {
+		if sched.RepoName == repo.Name {
+			return sched, nil
+		}
+	}
+
+	login, err := s.announcer()
+	if err != nil {
+		return store.ArchiveSchedule{}, err
+	}
+	settings, err := s.client.FetchRepoSettings(repo.Owner, repo.Name)
+	if err != nil {
+		return store.ArchiveSchedule{}, err
+	}
+	if settings.IsArchived {
+		return store.ArchiveSchedule{}, errors.New("repository is already archived")
+	}
+
+	issue, err := s.client.CreateIssue(repo.Owner, repo.Name, noticeTitle(archiveOn), noticeBody(archiveOn))
+	if err != nil {
+		return store.ArchiveSchedule{}, err
+	}
+	sched := store.ArchiveSchedule{
+		Owner:       repo.Owner,
+		RepoName:    repo.Name,
+		ArchiveOn:   archiveOn,
+		IssueNumber: issue.Number,
+		IssueURL:    issue.URL,
+		AnnouncedBy: login,
+		PushedAt:    settings.PushedAt,
+		AnnouncedAt: time.Now(),
+	}
+	if err := s.store.SaveArchiveSchedule(sched); err != nil {
+		return store.ArchiveSchedule{}, err
+	}
+	note := fmt.Sprintf("archive on %s, notice #%d", archiveOn.Format(dateFormat), issue.Number)
+	if err := s.store.RecordRepoChange(repo.Owner, repo.Name, "scheduled", "user", nil, nil, note); err != nil {
+		slog.Warn("failed to record archive schedule", "component", "schedule", "repo", repo.FullName(), "error", err)
+	}
+	return sched, nil
+}
+
+// announcer returns the authenticated user, whose comments on notices are
+// not objections.
+func (s *Scheduler) announcer() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.login == "" {
+		login, err := s.client.GetAuthenticatedUser()
+		if err != nil {
+			return "", err
+		}
+		s.login = login
+	}
+	return s.login, nil
+}
+
+// noticeTitle returns the title of the notice issue.
+func noticeTitle(archiveOn time.Time) string {
+	return "This repository will be archived on " + archiveOn.Format(dateFormat)
+}
+
+// noticeBody returns the body of the notice issue.
+func noticeBody(archiveOn time.Time) string {
+	return fmt.Sprintf(`This repository looks inactive and will be archived (made read-only) on **%s** unless someone objects.
+
+If you still use it, comment on this issue before then and it will not be archived. New pushes also cancel the archive.`,
+		archiveOn.Format(dateFormat))
+}
+
+// Objections returns the comments that object to an archive announced by
+// announcer: any comment by someone else that is not from a bot and not a
+// plain approval such as "+1" or "LGTM".
+func (s *Scheduler) Objections(comments []github.IssueComment, announcer string) []github.IssueComment {
+	var objections []github.IssueComment
+	for _, c := range comments {
+		if strings.EqualFold(c.AuthorLogin, announcer) || s.bots.IsBot(github.Commit{AuthorLogin: c.AuthorLogin}) {
+			continue
+		}
+		if isApproval(c.Body) {
+			continue
+		}
+		objections = append(objections, c)
+	}
+	return objections
+}
+
+// isApproval reports whether a comment only agrees with the archive.
+func isApproval(body string) bool {
+	body = strings.ToLower(strings.TrimRight(strings.TrimSpace(body), ".! "))
+	return slices.Contains(approvals, body)
+}
+
+// ApplyDue archives the scheduled repositories of owner whose date is not
+// after now, unless someone objected on the notice issue or pushed since the
+// announcement; those archives are cancelled and their notices closed. With
+// dryRun nothing is changed. Decisions are returned earliest date first.
+func (s *Scheduler) ApplyDue(owner string, now time.Time, dryRun bool) ([]Decision, error) {
+	schedules, err := s.store.GetArchiveSchedules(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	decisions := make([]Decision, 0, len(schedules))
+	for _, sched := range schedules {
+		decisions = append(decisions, s.apply(sched, now, dryRun))
+	}
+	return decisions, nil
+}
+
+// apply decides and, unless dryRun, carries out one scheduled archive.
+func (s *Scheduler) apply(sched store.ArchiveSchedule, now time.Time, dryRun bool) Decision {
+	d := Decision{Schedule: sched}
+	if sched.ArchiveOn.After(now) {
+		d.Outcome = OutcomePending
+		d.Reason = "due " + sched.ArchiveOn.Format(dateFormat)
+		return d
+	}
+
+	reason, alreadyArchived, err := s.check(sched)
+	if err != nil {
+		d.Outcome = OutcomeFailed
+		d.Err = err
+		return d
+	}
+	if reason != "" {
+		d.Outcome = OutcomeSkipped
+		d.Reason = reason
+		if !dryRun {
+			d.Err = s.cancel(sched, reason, alreadyArchived)
+		}
+		return d
+	}
+
+	if dryRun {
+		d.Outcome = OutcomeDue
+		return d
+	}
+	if err := s.archive(sched); err != nil {
+		d.Outcome = OutcomeFailed
+		d.Err = err
+		return d
+	}
+	d.Outcome = OutcomeArchived
+	return d
+}
+
+// check returns why a due archive must not go ahead, or "" when it may.
+func (s *Scheduler) check(sched store.ArchiveSchedule) (reason string, alreadyArchived bool, err error) {
+	settings, err := s.client.FetchRepoSettings(sched.Owner, sched.RepoName)
+	if err != nil {
+		return "", false, err
+	}
+	if settings.IsArchived {
+		return "already archived", true, nil
+	}
+	if settings.PushedAt.After(sched.PushedAt) {
+		return "pushed on " + settings.PushedAt.Format(dateFormat) + " after the notice", false, nil
+	}
+
+	comments, err := s.client.FetchIssueComments(sched.Owner, sched.RepoName, sched.IssueNumber)
+	if err != nil {
+		return "", false, err
+	}
+	if objections := s.Objections(comments, sched.AnnouncedBy); len(objections) > 0 {
+		var who []string
+		for _, c := range objections {
+			if login := "@" + c.AuthorLogin; !slices.Contains(who, login) {
+				who = append(who, login)
+			}
+		}
+		return fmt.Sprintf("objection on #%d by %s", sched.IssueNumber, strings.Join(who, ", ")), false, nil
+	}
+	return "", false, nil
+}
+
+// cancel closes the notice of a skipped archive and forgets its schedule.
+func (s *Scheduler) cancel(sched store.ArchiveSchedule, reason string, alreadyArchived bool) error {
+	if !alreadyArchived {
+		comment := "The scheduled archive is cancelled: " + reason + "."
+		if err := s.client.CloseIssue(sched.Owner, sched.RepoName, sched.IssueNumber, comment); err != nil {
+			return err
+		}
+		if err := s.store.RecordRepoChange(sched.Owner, sched.RepoName, "unscheduled", "system", nil, nil, reason); err != nil {
+			slog.Warn("failed to record cancelled archive", "component", "schedule", "repo", sched.RepoName, "error", err)
+		}
+	}
+	return s.store.DeleteArchiveSchedule(sched.Owner, sched.RepoName)
+}
+
+// archive backs the repository up when configured, closes the notice,
+// archives the repository and records it. The notice is closed first because
+// an archived repository is read-only; it is reopened if archiving fails, and
+// the schedule is kept so the next run retries.
+func (s *Scheduler) archive(sched store.ArchiveSchedule) error {
+	note := fmt.Sprintf("scheduled archive, notice #%d", sched.IssueNumber)
+	if s.backer != nil {
+		repo := github.Repository{Owner: sched.Owner, Name: sched.RepoName, PushedAt: sched.PushedAt}
+		if stored, err := s.store.GetRepository(sched.Owner, sched.RepoName); err == nil {
+			repo = *stored
+		}
+		meta, err := s.backer.Backup(repo)
+		if err != nil {
+			return fmt.Errorf("backing up: %w", err)
+		}
+		note += ", backup " + meta.Path
+	}
+
+	comment := "No objections were raised, so this repository is now being archived."
+	if err := s.client.CloseIssue(sched.Owner, sched.RepoName, sched.IssueNumber, comment); err != nil {
+		return err
+	}
+	if err := s.client.ArchiveRepository(sched.Owner, sched.RepoName); err != nil {
+		comment := "Archiving this repository failed, so it stays active; the archive will be retried."
+		if reopenErr := s.client.ReopenIssue(sched.Owner, sched.RepoName, sched.IssueNumber, comment); reopenErr != nil {
+			slog.Warn("failed to reopen notice", "component", "schedule", "repo", sched.RepoName, "error", reopenErr)
+		}
+		return err
+	}
+	if err := s.store.DeleteArchiveSchedule(sched.Owner, sched.RepoName); err != nil {
+		return err
+	}
+
+	if err := s.store.RecordRepoChange(sched.Owner, sched.RepoName, "archived", "user", nil, nil, note); err != nil {
+		slog.Warn("failed to record archive", "component", "schedule", "repo", sched.RepoName, "error", err)
+	}
+	if err := s.store.RemoveMarkedRepo(sched.Owner, sched.RepoName); err != nil {
+		slog.Warn("failed to unmark archived repo", "component", "schedule", "repo", sched.RepoName, "error", err)
+	}
+	repo, err := s.store.GetRepository(sched.Owner, sched.RepoName)
+	if err == nil {
+		repo.IsArchived = true
+		err = s.store.UpdateRepository(*repo)
+	}
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		slog.Warn("failed to update archived repo", "component", "schedule", "repo", sched.RepoName, "error", err)
+	}
+	return nil
+}
@@ -0,0 +1,253 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package schedule
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/backup"
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+var lastPush = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+// fakeRepo is the GitHub state of one repository.
+type fakeRepo struct {
+	settings   github.RepoSettings
+	comments   []github.IssueComment
+	closed     map[int]string // issue number -> closing comment
+	reopened   map[int]string // issue number -> reopening comment
+	archiveErr error
+}
+
+// fakeClient is an in-memory GitHub.
+type fakeClient struct {
+	repos  map[string]*fakeRepo
+	issues int
+}
+
+func newFakeClient(names ...string) *fakeClient {
+	c := &fakeClient{repos: make(map[string]*fakeRepo)}
+	for _, name := range names {
+		c.repos[name] = &fakeRepo{settings: github.RepoSettings{PushedAt: lastPush}, closed: make(map[int]string), reopened: make(map[int]string)}
+	}
+	return c
+}
+
+func (c *fakeClient) GetAuthenticatedUser() (string, error) { return "janitor", nil }
+
+func (c *fakeClient) FetchRepoSettings(owner, name string) (github.RepoSettings, error) {
+	return c.repos[name].settings, nil
+}
+
+func (c *fakeClient) CreateIssue(owner, name, title, body string) (github.Issue, error) {
+	c.issues++
+	return github.Issue{Number: c.issues, URL: "https://github.com/acme/" + name + "/issues/1"}, nil
+}
+
+func (c *fakeClient) FetchIssueComments(owner, name string, number int) ([]github.IssueComment, error) {
+	return c.repos[name].comments, nil
+}
+
+func (c *fakeClient) CloseIssue(owner, name string, number int, comment string) error {
+	c.repos[name].closed[number] = comment
+	return nil
+}
+
+func (c *fakeClient) ReopenIssue(owner, name string, number int, comment string) error {
+	delete(c.repos[name].closed, number)
+	c.repos[name].reopened[number] = comment
+	return nil
+}
+
+func (c *fakeClient) ArchiveRepository(owner, name string) error {
+	if err := c.repos[name].archiveErr; err != nil {
+		return err
+	}
+	c.repos[name].settings.IsArchived = true
+	return nil
+}
+
+func (c *fakeClient) ListIssues(owner, name string) ([]github.IssueSummary, error) {
+	return nil, nil
+}
+
+func repo(name string) github.Repository {
+	return github.Repository{Owner: "acme", Name: name, PushedAt: lastPush}
+}
+
+func TestSchedule(t *testing.T) {
+	client := newFakeClient("widget")
+	s := testutil.NewStore(t)
+	scheduler := New(client, s, nil)
+	archiveOn := ArchiveDate(time.Date(2026, 10, 18, 15, 0, 0, 0, time.UTC), 14)
+	assert.Equal(t, time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC), archiveOn)
+
+	sched, err := scheduler.Schedule(repo("widget"), archiveOn)
+	require.NoError(t, err)
+	assert.Equal(t, 1, sched.IssueNumber)
+	assert.Equal(t, "janitor", sched.AnnouncedBy)
+	assert.Equal(t, lastPush, sched.PushedAt)
+
+	// Scheduling again keeps the existing notice
+	again, err := scheduler.Schedule(repo("widget"), archiveOn.AddDate(0, 0, 7))
+	require.NoError(t, err)
+	assert.Equal(t, 1, again.IssueNumber)
+	assert.Equal(t, archiveOn, again.ArchiveOn)
+	assert.Equal(t, 1, client.issues)
+
+	changes, err := s.GetChangesByAction("acme", "scheduled", 10)
+	require.NoError(t, err)
+	assert.Len(t, changes, 1)
+}
+
+func TestObjections(t *testing.T) {
+	scheduler := New(newFakeClient(), nil, []string{"acme-ci"})
+	comments := []github.IssueComment{
+		{AuthorLogin: "janitor", Body: "Reminder: archiving soon"},
+		{AuthorLogin: "dependabot[bot]", Body: "Bumps lodash"},
+		{AuthorLogin: "acme-ci", Body: "Build failed"},
+		{AuthorLogin: "bob", Body: "+1"},
+		{AuthorLogin: "carol", Body: "LGTM!"},
+		{AuthorLogin: "alice", Body: "We still deploy this, please keep it"},
+	}
+
+	objections := scheduler.Objections(comments, "janitor")
+	require.Len(t, objections, 1)
+	assert.Equal(t, "alice", objections[0].AuthorLogin)
+}
+
+func TestApplyDue(t *testing.T) {
+	client := newFakeClient("quiet", "objected", "pushed", "later")
+	s := testutil.NewStore(t)
+	require.NoError(t, s.UpsertRepositories("acme", []github.Repository{repo("quiet")}))
+	require.NoError(t, s.AddMarkedRepo("acme", "quiet"))
+	scheduler := New(client, s, nil)
+
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	due := now.AddDate(0, 0, -1)
+	for _, name := range []string{"quiet", "objected", "pushed"} {
+		_, err := scheduler.Schedule(repo(name), due)
+		require.NoError(t, err)
+	}
+	_, err := scheduler.Schedule(repo("later"), now.AddDate(0, 0, 10))
+	require.NoError(t, err)
+
+	client.repos["objected"].comments = []github.IssueComment{{AuthorLogin: "alice", Body: "Still in use"}}
+	client.repos["pushed"].settings.PushedAt = lastPush.AddDate(2, 0, 0)
+
+	// A dry run changes nothing
+	decisions, err := scheduler.ApplyDue("acme", now, true)
+	require.NoError(t, err)
+	outcomes := make(map[string]Outcome)
+	for _, d := range decisions {
+		outcomes[d.Schedule.RepoName] = d.Outcome
+	}
+	assert.Equal(t, map[string]Outcome{"quiet": OutcomeDue, "objected": OutcomeSkipped, "pushed": OutcomeSkipped, "later": OutcomePending}, outcomes)
+	assert.False(t, client.repos["quiet"].settings.IsArchived)
+
+	decisions, err = scheduler.ApplyDue("acme", now, false)
+	require.NoError(t, err)
+	reasons := make(map[string]string)
+	for _, d := range decisions {
+		require.NoError(t, d.Err)
+		outcomes[d.Schedule.RepoName] = d.Outcome
+		reasons[d.Schedule.RepoName] = d.Reason
+	}
+	assert.Equal(t, OutcomeArchived, outcomes["quiet"])
+	assert.Equal(t, "objection on #2 by @alice", reasons["objected"])
+	assert.Contains(t, reasons["pushed"], "pushed on 2026-03-01")
+
+	assert.True(t, client.repos["quiet"].settings.IsArchived)
+	assert.Contains(t, client.repos["quiet"].closed, 1)
+	assert.False(t, client.repos["objected"].settings.IsArchived)
+	assert.Contains(t, client.repos["objected"].closed[2], "cancelled")
+
+	// Only the pending schedule remains
+	schedules, err := s.GetArchiveSchedules("acme")
+	require.NoError(t, err)
+	require.Len(t, schedules, 1)
+	assert.Equal(t, "later", schedules[0].RepoName)
+
+	stored, err := s.GetRepository("acme", "quiet")
+	require.NoError(t, err)
+	assert.True(t, stored.IsArchived)
+	marked, err := s.GetMarkedRepos("acme")
+	require.NoError(t, err)
+	assert.Empty(t, marked)
+	archived, err := s.GetChangesByAction("acme", "archived", 10)
+	require.NoError(t, err)
+	assert.Len(t, archived, 1)
+}
+
+func TestApplyDue_ArchiveFails(t *testing.T) {
+	client := newFakeClient("widget")
+	client.repos["widget"].archiveErr = errors.New("permission denied")
+	s := testutil.NewStore(t)
+	scheduler := New(client, s, nil)
+
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	_, err := scheduler.Schedule(repo("widget"), now)
+	require.NoError(t, err)
+
+	decisions, err := scheduler.ApplyDue("acme", now, false)
+	require.NoError(t, err)
+	require.Len(t, decisions, 1)
+	assert.Equal(t, OutcomeFailed, decisions[0].Outcome)
+	assert.ErrorContains(t, decisions[0].Err, "permission denied")
+
+	// The notice is reopened and the schedule kept for the next run
+	assert.Empty(t, client.repos["widget"].closed)
+	assert.Contains(t, client.repos["widget"].reopened[1], "failed")
+	schedules, err := s.GetArchiveSchedules("acme")
+	require.NoError(t, err)
+	assert.Len(t, schedules, 1)
+}
+
+func TestApplyDue_Backup(t *testing.T) {
+	remote := testutil.NewGitRemote(t)
+	client := newFakeClient("widget")
+	s := testutil.NewStore(t)
+	scheduler := New(client, s, nil)
+	backer := backup.NewBacker(client, t.TempDir(), backup.FormatBundle)
+	backer.SetRemoteURL(func(owner, name string) string { return remote })
+	scheduler.SetBackup(backer)
+
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	_, err := scheduler.Schedule(repo("widget"), now)
+	require.NoError(t, err)
+
+	decisions, err := scheduler.ApplyDue("acme", now, false)
+	require.NoError(t, err)
+	require.Len(t, decisions, 1)
+	require.NoError(t, decisions[0].Err)
+	assert.Equal(t, OutcomeArchived, decisions[0].Outcome)
+
+	meta, err := backup.Load(backer.Dir(), "acme", "widget")
+	require.NoError(t, err)
+	assert.Equal(t, backup.FormatBundle, meta.Format)
+	archived, err := s.GetChangesByAction("acme", "archived", 10)
+	require.NoError(t, err)
+	require.Len(t, archived, 1)
+	assert.Contains(t, archived[0].Notes, "backup "+meta.Path)
+
+	// A failed backup keeps the repository and its schedule
+	client = newFakeClient("gadget")
+	scheduler = New(client, s, nil)
+	backer.SetRemoteURL(func(owner, name string) string { return remote + ".missing" })
+	scheduler.SetBackup(backer)
+	_, err = scheduler.Schedule(repo("gadget"), now)
+	require.NoError(t, err)
+	decisions, err = scheduler.ApplyDue("acme", now, false)
+	require.NoError(t, err)
+	assert.Equal(t, OutcomeFailed, decisions[0].Outcome)
+	assert.False(t, client.repos["gadget"].settings.IsArchived)
+	assert.Empty(t, client.repos["gadget"].closed)
+}
@@ -0,0 +1,469 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package server provides the local web dashboard and its JSON API.
+package server
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/llbbl/repjan/internal/analyze"
+	"github.com/llbbl/repjan/internal/export"
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/query"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+//go:embed static/index.html
+var indexHTML []byte
+
+// historyLimit caps the history and sync records returned by the API.
+const historyLimit = 50
+
+// Server serves the JSON API and web UI for one owner's stored repositories.
+// All endpoints are read-only except marking, which updates marked repos.
+type Server struct {
+	store *store.Store
+	owner string
+	mux   *http.ServeMux
+}
+
+// New creates a Server over the given store for owner.
+func New(s *store.Store, owner string) *Server {
+	srv := &Server{store: s, owner: owner, mux: http.NewServeMux()}
+
+	srv.mux.HandleFunc("GET /{$}", srv.handleIndex)
+	srv.mux.HandleFunc("GET /api/repos", srv.handleRepos)
+	srv.mux.HandleFunc("GET /api/repos/{name}", srv.handleRepo)
+	srv.mux.HandleFunc("GET /api/repos/{name}/history", srv.handleRepoHistory)
+	srv.mux.HandleFunc("GET /api/languages", srv.handleLanguages)
+	srv.mux.HandleFunc("GET /api/sync", srv.handleSync)
+	srv.mux.HandleFunc("GET /api/marks", srv.handleMarks)
+	srv.mux.HandleFunc("PUT /api/marks/{name}", srv.handleMark)
+	srv.mux.HandleFunc("DELETE /api/marks/{name}", srv.handleUnmark)
+	srv.mux.HandleFunc("GET /api/export", srv.handleExport)
+
+	return srv
+}
+
+// ServeHTTP implements http.Handler. Requests that change state are rejected
+// when they come from another origin, so other sites cannot mark repos.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead && !sameOrigin(r) {
+		writeError(w, http.StatusForbidden, "cross-origin request rejected")
+		return
+	}
+	slog.Debug("http request", "component", "server", "method", r.Method, "path", r.URL.Path)
+	s.mux.ServeHTTP(w, r)
+}
+
+// repoJSON is a repository in API responses.
+type repoJSON struct {
+	export.ExportedRepo
+	Marked bool `json:"marked"`
+}
+
+// reposResponse is the response body for GET /api/repos.
+type reposResponse struct {
+	Owner        string     `json:"owner"`
+	Total        int        `json:"total"`
+	Repositories []repoJSON `json:"repositories"`
+}
+
+// repoResponse is the response body for GET /api/repos/{name}.
+type repoResponse struct {
+	Repository repoJSON      `json:"repository"`
+	History    []historyJSON `json:"history"`
+}
+
+// historyJSON is a recorded repository change in API responses.
+type historyJSON struct {
+	Action      string    `json:"action"`
+	PerformedAt time.Time `json:"performed_at"`
+	PerformedBy string    `json:"performed_by"`
+	Notes       string    `json:"notes,omitempty"`
+}
+
+// syncJSON is a sync history record in API responses.
+type syncJSON struct {
+	StartedAt     time.Time  `json:"started_at"`
+	CompletedAt   *time.Time `json:"completed_at"`
+	Status        string     `json:"status"`
+	ReposFetched  int        `json:"repos_fetched"`
+	ReposInserted int        `json:"repos_inserted"`
+	ReposUpdated  int        `json:"repos_updated"`
+	ErrorMessage  string     `json:"error_message,omitempty"`
+	DurationMs    int64      `json:"duration_ms"`
+}
+
+// syncResponse is the response body for GET /api/sync.
+type syncResponse struct {
+	LastSync *time.Time `json:"last_sync"`
+	History  []syncJSON `json:"history"`
+}
+
+func (s *Server) handleIndex(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = w.Write(indexHTML)
+}
+
+func (s *Server) handleRepos(w http.ResponseWriter, r *http.Request) {
+	q, err := parseQuery(r.URL.Query())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	repos, marked, err := s.load()
+	if err != nil {
+		writeServerError(w, err)
+		return
+	}
+
+	resp, err := s.reposResponse(q.Apply(repos), marked)
+	if err != nil {
+		writeServerError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) handleRepo(w http.ResponseWriter, r *http.Request) {
+	repo, ok := s.findRepo(w, r.PathValue("name"))
+	if !ok {
+		return
+	}
+
+	marked, err := s.markedSet()
+	if err != nil {
+		writeServerError(w, err)
+		return
+	}
+	history, err := s.history(repo.Name)
+	if err != nil {
+		writeServerError(w, err)
+		return
+	}
+
+	resp, err := s.reposResponse([]github.Repository{*repo}, marked)
+	if err != nil {
+		writeServerError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, repoResponse{Repository: resp.Repositories[0], History: history})
+}
+
+func (s *Server) handleRepoHistory(w http.ResponseWriter, r *http.Request) {
+	repo, ok := s.findRepo(w, r.PathValue("name"))
+	if !ok {
+		return
+	}
+
+	history, err := s.history(repo.Name)
+	if err != nil {
+		writeServerError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, history)
+}
+
+func (s *Server) handleLanguages(w http.ResponseWriter, r *http.Request) {
+	repos, err := s.store.GetRepositories(s.owner)
+	if err != nil {
+		writeServerError(w, err)
+		return
+	}
+
+	counts := map[string]int{}
+	for _, repo := range repos {
+		if repo.PrimaryLanguage != "" {
+			counts[repo.PrimaryLanguage]++
+		}
+	}
+	writeJSON(w, http.StatusOK, counts)
+}
+
+func (s *Server) handleSync(w http.ResponseWriter, r *http.Request) {
+	records, err := s.store.GetSyncHistory(s.owner, historyLimit)
+	if err != nil {
+		writeServerError(w, err)
+		return
+	}
+
+	resp := syncResponse{History: make([]syncJSON, 0, len(records))}
+	for _, rec := range records {
+		resp.History = append(resp.History, syncJSON{
+			StartedAt:     rec.StartedAt,
+			CompletedAt:   rec.CompletedAt,
+			Status:        rec.Status,
+			ReposFetched:  rec.ReposFetched,
+			ReposInserted: rec.ReposInserted,
+			ReposUpdated:  rec.ReposUpdated,
+			ErrorMessage:  rec.ErrorMessage,
+			DurationMs:    rec.DurationMs,
+		})
+	}
+
+	last, err := s.store.GetLastSyncTime(s.owner)
+	if err == nil && !last.IsZero() {
+		resp.LastSync = &last
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) handleMarks(w http.ResponseWriter, r *http.Request) {
+	names, err := s.store.GetMarkedRepos(s.owner)
+	if err != nil {
+		writeServerError(w, err)
+		return
+	}
+	if names == nil {
+		names = []string{}
+	}
+	writeJSON(w, http.StatusOK, names)
+}
+
+func (s *Server) handleMark(w http.ResponseWriter, r *http.Request) {
+	repo, ok := s.findRepo(w, r.PathValue("name"))
+	if !ok {
+		return
+	}
+	if repo.IsArchived {
+		writeError(w, http.StatusConflict, fmt.Sprintf("%s is already archived", repo.FullName()))
+		return
+	}
+
+	if err := s.store.AddMarkedRepo(s.owner, repo.Name); err != nil {
+		writeServerError(w, err)
+		return
+	}
+	if err := s.store.RecordRepoChange(s.owner, repo.Name, "marked", "user", nil, nil, "web dashboard"); err != nil {
+		slog.Warn("failed to record mark", "component", "server", "repo", repo.Name, "error", err)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleUnmark(w http.ResponseWriter, r *http.Request) {
+	repo, ok := s.findRepo(w, r.PathValue("name"))
+	if !ok {
+		return
+	}
+
+	if err := s.store.RemoveMarkedRepo(s.owner, repo.Name); err != nil {
+		writeServerError(w, err)
+		return
+	}
+	if err := s.store.RecordRepoChange(s.owner, repo.Name, "unmarked", "user", nil, nil, "web dashboard"); err != nil {
+		slog.Warn("failed to record unmark", "component", "server", "repo", repo.Name, "error", err)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleExport downloads repositories in an export format. The scope parameter
+// selects marked repos (default) or the repos matching the same query
+// parameters as GET /api/repos (scope=visible).
+func (s *Server) handleExport(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	format := params.Get("format")
+	if format == "" {
+		format = "json"
+	}
+	exporter, err := export.NewExporter(format)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	repos, marked, err := s.load()
+	if err != nil {
+		writeServerError(w, err)
+		return
+	}
+
+	var selected []github.Repository
+	switch scope := params.Get("scope"); scope {
+	case "", "marked":
+		for _, repo := range repos {
+			if marked[repo.Name] {
+				selected = append(selected, repo)
+			}
+		}
+	case "visible":
+		q, err := parseQuery(params)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		selected = q.Apply(repos)
+	default:
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("unknown scope %q: must be marked or visible", scope))
+		return
+	}
+
+	assessor, err := analyze.LoadAssessor(s.store, s.owner)
+	if err != nil {
+		writeServerError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", export.DefaultFilename(exporter)))
+	if err := exporter.Write(w, export.BuildExportData(selected, s.owner, assessor)); err != nil {
+		slog.Error("export failed", "component", "server", "format", format, "error", err)
+	}
+}
+
+// load returns the owner's repositories and the set of marked repo names.
+func (s *Server) load() ([]github.Repository, map[string]bool, error) {
+	repos, err := s.store.GetRepositories(s.owner)
+	if err != nil {
+		return nil, nil, err
+	}
+	marked, err := s.markedSet()
+	if err != nil {
+		return nil, nil, err
+	}
+	return repos, marked, nil
+}
+
+// markedSet returns the owner's marked repo names as a set.
+func (s *Server) markedSet() (map[string]bool, error) {
+	names, err := s.store.GetMarkedRepos(s.owner)
+	if err != nil {
+		return nil, err
+	}
+	marked := make(map[string]bool, len(names))
+	for _, name := range names {
+		marked[name] = true
+	}
+	return marked, nil
+}
+
+// findRepo loads a repository by name, writing a 404 and returning false if
+// it does not exist.
+func (s *Server) findRepo(w http.ResponseWriter, name string) (*github.Repository, bool) {
+	repo, err := s.store.GetRepository(s.owner, name)
+	if errors.Is(err, store.ErrNotFound) {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("repository %s/%s not found", s.owner, name))
+		return nil, false
+	}
+	if err != nil {
+		writeServerError(w, err)
+		return nil, false
+	}
+	return repo, true
+}
+
+// history returns the recorded changes for a repository, newest first.
+func (s *Server) history(name string) ([]historyJSON, error) {
+	changes, err := s.store.GetRepoHistory(s.owner, name, historyLimit)
+	if err != nil {
+		return nil, err
+	}
+	history := make([]historyJSON, 0, len(changes))
+	for _, c := range changes {
+		history = append(history, historyJSON{
+			Action:      c.Action,
+			PerformedAt: c.PerformedAt,
+			PerformedBy: c.PerformedBy,
+			Notes:       c.Notes,
+		})
+	}
+	return history, nil
+}
+
+// reposResponse converts repositories to the API shape used by the export
+// package, scored with the stored README and AI verdict signals.
+func (s *Server) reposResponse(repos []github.Repository, marked map[string]bool) (reposResponse, error) {
+	assessor, err := analyze.LoadAssessor(s.store, s.owner)
+	if err != nil {
+		return reposResponse{}, err
+	}
+	data := export.BuildExportData(repos, s.owner, assessor)
+	resp := reposResponse{
+		Owner:        s.owner,
+		Total:        len(repos),
+		Repositories: make([]repoJSON, len(data.Repositories)),
+	}
+	for i, repo := range data.Repositories {
+		resp.Repositories[i] = repoJSON{ExportedRepo: repo, Marked: marked[repo.Name]}
+	}
+	return resp, nil
+}
+
+// parseQuery builds a repository query from URL parameters: filter, language, q,
+// sort, order (asc or desc), private and archived.
+func parseQuery(params url.Values) (query.Query, error) {
+	filter, err := query.ParseFilter(params.Get("filter"))
+	if err != nil {
+		return query.Query{}, err
+	}
+
+	sortName := params.Get("sort")
+	if sortName == "" {
+		sortName = "activity"
+	}
+	sortField, err := query.ParseSortField(sortName)
+	if err != nil {
+		return query.Query{}, err
+	}
+
+	ascending, err := query.ParseOrder(params.Get("order"))
+	if err != nil {
+		return query.Query{}, err
+	}
+
+	return query.Query{
+		Filter:       filter,
+		Language:     params.Get("language"),
+		Search:       params.Get("q"),
+		Sort:         sortField,
+		Ascending:    ascending,
+		ShowPrivate:  boolParam(params, "private"),
+		ShowArchived: boolParam(params, "archived"),
+	}, nil
+}
+
+// boolParam reports whether a URL parameter is set to a true value.
+func boolParam(params url.Values, key string) bool {
+	v, err := strconv.ParseBool(params.Get(key))
+	return err == nil && v
+}
+
+// sameOrigin reports whether a request's Origin header, if any, matches its host.
+func sameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	return err == nil && strings.EqualFold(u.Host, r.Host)
+}
+
+// writeJSON writes v as a JSON response with the given status.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		slog.Error("failed to write response", "component", "server", "error", err)
+	}
+}
+
+// writeError writes a JSON error response.
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]string{"error": msg})
+}
+
+// writeServerError logs err and writes a 500 response.
+func writeServerError(w http.ResponseWriter, err error) {
+	slog.Error("request failed", "component", "server", "error", err)
+	writeError(w, http.StatusInternalServerError, "internal error")
+}
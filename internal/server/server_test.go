@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+// setupServer creates a server over an in-memory store with a few repos.
+func setupServer(t *testing.T) (*Server, *store.Store) {
+	t.Helper()
+
+	s := testutil.NewStore(t)
+	repos := []github.Repository{
+		testutil.NewTestRepo(testutil.WithOwner("me"), testutil.WithName("active"), testutil.WithLanguage("Go"), testutil.WithDaysInactive(5), testutil.WithStars(3)),
+		testutil.NewTestRepo(testutil.WithOwner("me"), testutil.WithName("stale"), testutil.WithLanguage("PHP"), testutil.WithDaysInactive(800), testutil.WithStars(0), testutil.WithForks(0)),
+		testutil.NewTestRepo(testutil.WithOwner("me"), testutil.WithName("secret"), testutil.WithPrivate(true)),
+		testutil.NewTestRepo(testutil.WithOwner("me"), testutil.WithName("done"), testutil.WithArchived(true)),
+	}
+	require.NoError(t, s.UpsertRepositories("me", repos))
+
+	return New(s, "me"), s
+}
+
+// do performs a request against the server and returns the recorder.
+func do(t *testing.T, srv *Server, method, target string) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, httptest.NewRequest(method, target, nil))
+	return rec
+}
+
+// repoNames decodes a repos response and returns the repository names.
+func repoNames(t *testing.T, rec *httptest.ResponseRecorder) []string {
+	t.Helper()
+	var resp reposResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	names := make([]string, len(resp.Repositories))
+	for i, r := range resp.Repositories {
+		names[i] = r.Name
+	}
+	return names
+}
+
+func TestHandleIndex(t *testing.T) {
+	srv, _ := setupServer(t)
+
+	rec := do(t, srv, http.MethodGet, "/")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get("Content-Type"), "text/html")
+	assert.Contains(t, rec.Body.String(), "<title>repjan</title>")
+
+	assert.Equal(t, http.StatusNotFound, do(t, srv, http.MethodGet, "/missing").Code)
+}
+
+func TestHandleRepos(t *testing.T) {
+	srv, _ := setupServer(t)
+
+	tests := []struct {
+		name   string
+		target string
+		want   []string
+	}{
+		{"defaults hide private and archived, oldest first", "/api/repos", []string{"stale", "active"}},
+		{"show private and archived", "/api/repos?private=true&archived=1&sort=name", []string{"active", "done", "secret", "stale"}},
+		{"old filter", "/api/repos?filter=old", []string{"stale"}},
+		{"language filter", "/api/repos?language=Go", []string{"active"}},
+		{"search", "/api/repos?q=STA", []string{"stale"}},
+		{"sort descending", "/api/repos?sort=name&order=desc", []string{"stale", "active"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := do(t, srv, http.MethodGet, tt.target)
+			require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+			assert.Equal(t, tt.want, repoNames(t, rec))
+		})
+	}
+
+	for _, bad := range []string{"/api/repos?filter=new", "/api/repos?sort=size", "/api/repos?order=up"} {
+		t.Run("bad request "+bad, func(t *testing.T) {
+			rec := do(t, srv, http.MethodGet, bad)
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Contains(t, rec.Body.String(), `"error"`)
+		})
+	}
+}
+
+func TestHandleRepos_IncludesScoreAndMark(t *testing.T) {
+	srv, s := setupServer(t)
+	require.NoError(t, s.AddMarkedRepo("me", "stale"))
+
+	rec := do(t, srv, http.MethodGet, "/api/repos?filter=old")
+	var resp reposResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Len(t, resp.Repositories, 1)
+
+	repo := resp.Repositories[0]
+	assert.True(t, repo.Marked)
+	assert.Equal(t, "me/stale", repo.FullName)
+	assert.Contains(t, repo.ReasonCodes, "inactive_2y")
+	assert.Positive(t, repo.Score)
+}
+
+func TestMarkAndUnmark(t *testing.T) {
+	srv, s := setupServer(t)
+
+	rec := do(t, srv, http.MethodPut, "/api/marks/stale")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = do(t, srv, http.MethodGet, "/api/marks")
+	assert.JSONEq(t, `["stale"]`, rec.Body.String())
+
+	rec = do(t, srv, http.MethodGet, "/api/repos/stale")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var detail repoResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &detail))
+	assert.True(t, detail.Repository.Marked)
+	require.Len(t, detail.History, 1)
+	assert.Equal(t, "marked", detail.History[0].Action)
+
+	rec = do(t, srv, http.MethodDelete, "/api/marks/stale")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	names, err := s.GetMarkedRepos("me")
+	require.NoError(t, err)
+	assert.Empty(t, names)
+
+	rec = do(t, srv, http.MethodGet, "/api/repos/stale/history")
+	var history []historyJSON
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &history))
+	assert.Len(t, history, 2)
+}
+
+func TestMark_Errors(t *testing.T) {
+	srv, _ := setupServer(t)
+
+	assert.Equal(t, http.StatusNotFound, do(t, srv, http.MethodPut, "/api/marks/missing").Code)
+	assert.Equal(t, http.StatusConflict, do(t, srv, http.MethodPut, "/api/marks/done").Code)
+	assert.Equal(t, http.StatusMethodNotAllowed, do(t, srv, http.MethodPost, "/api/repos").Code)
+
+	req := httptest.NewRequest(http.MethodPut, "/api/marks/stale", nil)
+	req.Header.Set("Origin", "https://evil.example")
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestHandleLanguagesAndSync(t *testing.T) {
+	srv, s := setupServer(t)
+
+	rec := do(t, srv, http.MethodGet, "/api/languages")
+	var langs map[string]int
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &langs))
+	assert.Equal(t, 3, langs["Go"], "default test repos are Go")
+	assert.Equal(t, 1, langs["PHP"])
+
+	id, err := s.RecordSyncStart("me")
+	require.NoError(t, err)
+	require.NoError(t, s.RecordSyncComplete(id, "success", 4, 4, 0, ""))
+
+	rec = do(t, srv, http.MethodGet, "/api/sync")
+	var sync syncResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &sync))
+	require.NotNil(t, sync.LastSync)
+	require.Len(t, sync.History, 1)
+	assert.Equal(t, "success", sync.History[0].Status)
+	assert.Equal(t, 4, sync.History[0].ReposFetched)
+}
+
+func TestHandleExport(t *testing.T) {
+	srv, s := setupServer(t)
+	require.NoError(t, s.AddMarkedRepo("me", "stale"))
+
+	rec := do(t, srv, http.MethodGet, "/api/export?format=csv")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get("Content-Disposition"), ".csv")
+	lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.True(t, strings.HasPrefix(lines[1], "stale,"))
+
+	rec = do(t, srv, http.MethodGet, "/api/export?format=json&scope=visible&language=Go")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"name": "active"`)
+
+	assert.Equal(t, http.StatusBadRequest, do(t, srv, http.MethodGet, "/api/export?format=xml").Code)
+	assert.Equal(t, http.StatusBadRequest, do(t, srv, http.MethodGet, "/api/export?scope=everything").Code)
+}
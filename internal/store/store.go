@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/llbbl/repjan/internal/github"
@@ -31,8 +32,15 @@ func New(db *sql.DB) *Store {
 // UpsertRepositories bulk upserts repos from GitHub fetch.
 // Uses INSERT OR REPLACE for efficiency within a transaction.
 func (s *Store) UpsertRepositories(owner string, repos []github.Repository) error {
+	_, _, err := s.SyncRepositories(owner, repos)
+	return err
+}
+
+// SyncRepositories upserts repos like UpsertRepositories and returns how many
+// were new and how many were already stored.
+func (s *Store) SyncRepositories(owner string, repos []github.Repository) (inserted, updated int, err error) {
 	if len(repos) == 0 {
-		return nil
+		return 0, 0, nil
 	}
 
 	slog.Debug("upserting repositories", "component", "store", "owner", owner, "count", len(repos))
@@ -40,19 +48,25 @@ func (s *Store) UpsertRepositories(owner string, repos []github.Repository) erro
 	tx, err := s.db.Begin()
 	if err != nil {
 		slog.Error("failed to begin transaction for upsert", "component", "store", "owner", owner, "error", err)
-		return fmt.Errorf("beginning transaction: %w", err)
+		return 0, 0, fmt.Errorf("beginning transaction: %w", err)
 	}
 	defer tx.Rollback() //nolint:errcheck // Rollback is no-op after commit
 
+	exists, err := tx.Prepare(`SELECT COUNT(*) FROM repositories WHERE owner = ? AND name = ?`)
+	if err != nil {
+		return 0, 0, fmt.Errorf("preparing statement: %w", err)
+	}
+	defer exists.Close()
+
 	stmt, err := tx.Prepare(`
 		INSERT OR REPLACE INTO repositories (
 			owner, name, full_name, description, stars, forks,
 			is_archived, is_fork, is_private, primary_language,
-			pushed_at, created_at, days_since_activity, synced_at
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			pushed_at, created_at, days_since_activity, topics, synced_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`)
 	if err != nil {
-		return fmt.Errorf("preparing statement: %w", err)
+		return 0, 0, fmt.Errorf("preparing statement: %w", err)
 	}
 	defer stmt.Close()
 
@@ -72,6 +86,16 @@ func (s *Store) UpsertRepositories(owner string, repos []github.Repository) erro
 
 		fullName := repoOwner + "/" + repo.Name
 
+		var count int
+		if err := exists.QueryRow(repoOwner, repo.Name).Scan(&count); err != nil {
+			return 0, 0, fmt.Errorf("checking repository %s: %w", fullName, err)
+		}
+		if count > 0 {
+			updated++
+		} else {
+			inserted++
+		}
+
 		_, err := stmt.Exec(
 			repoOwner,
 			repo.Name,
@@ -86,30 +110,66 @@ func (s *Store) UpsertRepositories(owner string, repos []github.Repository) erro
 			formatTimeForSQLite(repo.PushedAt),
 			formatTimeForSQLite(repo.CreatedAt),
 			daysSinceActivity,
+			nullString(strings.Join(repo.Topics, ",")),
 			now,
 		)
 		if err != nil {
 			slog.Error("failed to insert repository", "component", "store", "owner", repoOwner, "repo", repo.Name, "error", err)
-			return fmt.Errorf("inserting repository %s: %w", fullName, err)
+			return 0, 0, fmt.Errorf("inserting repository %s: %w", fullName, err)
 		}
 	}
 
 	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("committing transaction: %w", err)
+		return 0, 0, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	if err := s.SaveIssueActivity(owner, repos); err != nil {
+		return 0, 0, err
 	}
+	return inserted, updated, nil
+}
 
+// SaveIssueActivity stores the issue activity of repos that have it. Repos
+// without it keep their previously stored activity.
+func (s *Store) SaveIssueActivity(owner string, repos []github.Repository) error {
+	stmt, err := s.db.Prepare(`
+		INSERT OR REPLACE INTO repo_issue_activity (
+			owner, repo_name, open_issues, open_pull_requests, open_discussions, last_updated, checked_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?)
+	`)
+	if err != nil {
+		return fmt.Errorf("preparing statement: %w", err)
+	}
+	defer stmt.Close()
+
+	now := formatTimeForSQLite(time.Now())
+	for _, repo := range repos {
+		a := repo.IssueActivity
+		if a == nil {
+			continue
+		}
+		repoOwner := repo.Owner
+		if repoOwner == "" {
+			repoOwner = owner
+		}
+		_, err := stmt.Exec(repoOwner, repo.Name, a.OpenIssues, a.OpenPullRequests, a.OpenDiscussions,
+			formatTimeForSQLite(a.LastUpdated), now)
+		if err != nil {
+			return fmt.Errorf("saving issue activity for %s/%s: %w", repoOwner, repo.Name, err)
+		}
+	}
 	return nil
 }
 
 // GetRepositories loads all repos for an owner from the database.
 func (s *Store) GetRepositories(owner string) ([]github.Repository, error) {
 	rows, err := s.db.Query(`
-		SELECT owner, name, description, stars, forks,
-			   is_archived, is_fork, is_private, primary_language,
-			   pushed_at, created_at, days_since_activity
-		FROM repositories
-		WHERE owner = ?
-		ORDER BY name
+		SELECT `+repositoryColumns+`
+		FROM repositories r
+		LEFT JOIN repo_activity a ON a.owner = r.owner AND a.repo_name = r.name
+		LEFT JOIN repo_issue_activity i ON i.owner = r.owner AND i.repo_name = r.name
+		WHERE r.owner = ?
+		ORDER BY r.name
 	`, owner)
 	if err != nil {
 		return nil, fmt.Errorf("querying repositories: %w", err)
@@ -136,11 +196,11 @@ func (s *Store) GetRepositories(owner string) ([]github.Repository, error) {
 // Returns ErrNotFound if the repository does not exist.
 func (s *Store) GetRepository(owner, name string) (*github.Repository, error) {
 	row := s.db.QueryRow(`
-		SELECT owner, name, description, stars, forks,
-			   is_archived, is_fork, is_private, primary_language,
-			   pushed_at, created_at, days_since_activity
-		FROM repositories
-		WHERE owner = ? AND name = ?
+		SELECT `+repositoryColumns+`
+		FROM repositories r
+		LEFT JOIN repo_activity a ON a.owner = r.owner AND a.repo_name = r.name
+		LEFT JOIN repo_issue_activity i ON i.owner = r.owner AND i.repo_name = r.name
+		WHERE r.owner = ? AND r.name = ?
 	`, owner, name)
 
 	repo, err := scanRepositoryRow(row)
@@ -168,6 +228,7 @@ func (s *Store) UpdateRepository(repo github.Repository) error {
 			pushed_at = ?,
 			created_at = ?,
 			days_since_activity = ?,
+			topics = ?,
 			synced_at = ?
 		WHERE owner = ? AND name = ?
 	`,
@@ -181,6 +242,7 @@ func (s *Store) UpdateRepository(repo github.Repository) error {
 		formatTimeForSQLite(repo.PushedAt),
 		formatTimeForSQLite(repo.CreatedAt),
 		repo.DaysSinceActivity,
+		nullString(strings.Join(repo.Topics, ",")),
 		formatTimeForSQLite(time.Now()),
 		repo.Owner,
 		repo.Name,
@@ -245,6 +307,16 @@ func (s *Store) GetLastSyncTime(owner string) (time.Time, error) {
 	return t, nil
 }
 
+// repositoryColumns are the columns scanRepo reads, from repositories joined
+// as r with repo_activity as a and repo_issue_activity as i.
+const repositoryColumns = `r.owner, r.name, r.description, r.stars, r.forks,
+			   r.is_archived, r.is_fork, r.is_private, r.primary_language,
+			   r.pushed_at, r.created_at, r.days_since_activity, r.topics, a.last_human_activity,
+			   i.open_issues, i.open_pull_requests, i.open_discussions, i.last_updated,
+			   (SELECT COUNT(*) FROM repo_workflows w
+			    WHERE w.owner = r.owner AND w.repo_name = r.name AND w.state = 'active'
+			      AND ',' || w.triggers || ',' LIKE '%,schedule,%')`
+
 // scanner is an interface for both *sql.Row and *sql.Rows.
 type scanner interface {
 	Scan(dest ...any) error
@@ -263,7 +335,8 @@ func scanRepositoryRow(row *sql.Row) (github.Repository, error) {
 // scanRepo handles the common scanning logic.
 func scanRepo(s scanner) (github.Repository, error) {
 	var repo github.Repository
-	var description, primaryLanguage, pushedAt, createdAt sql.NullString
+	var description, primaryLanguage, pushedAt, createdAt, topics, lastHuman, issuesUpdated sql.NullString
+	var openIssues, openPullRequests, openDiscussions sql.NullInt64
 
 	err := s.Scan(
 		&repo.Owner,
@@ -278,6 +351,13 @@ func scanRepo(s scanner) (github.Repository, error) {
 		&pushedAt,
 		&createdAt,
 		&repo.DaysSinceActivity,
+		&topics,
+		&lastHuman,
+		&openIssues,
+		&openPullRequests,
+		&openDiscussions,
+		&issuesUpdated,
+		&repo.CronWorkflows,
 	)
 	if err != nil {
 		return github.Repository{}, err
@@ -289,6 +369,9 @@ func scanRepo(s scanner) (github.Repository, error) {
 	if primaryLanguage.Valid {
 		repo.PrimaryLanguage = primaryLanguage.String
 	}
+	if topics.Valid && topics.String != "" {
+		repo.Topics = strings.Split(topics.String, ",")
+	}
 	if pushedAt.Valid && pushedAt.String != "" {
 		t, err := parseTimeFromSQLite(pushedAt.String)
 		if err != nil {
@@ -303,6 +386,25 @@ func scanRepo(s scanner) (github.Repository, error) {
 		}
 		repo.CreatedAt = t
 	}
+	if lastHuman.Valid && lastHuman.String != "" {
+		t, err := parseTimeFromSQLite(lastHuman.String)
+		if err != nil {
+			return github.Repository{}, fmt.Errorf("parsing last_human_activity: %w", err)
+		}
+		repo.LastHumanActivity = t
+	}
+	if openIssues.Valid {
+		lastUpdated, err := parseTimeFromSQLite(issuesUpdated.String)
+		if err != nil {
+			return github.Repository{}, fmt.Errorf("parsing last_updated: %w", err)
+		}
+		repo.IssueActivity = &github.IssueActivity{
+			OpenIssues:       int(openIssues.Int64),
+			OpenPullRequests: int(openPullRequests.Int64),
+			OpenDiscussions:  int(openDiscussions.Int64),
+			LastUpdated:      lastUpdated,
+		}
+	}
 
 	return repo, nil
 }
@@ -449,7 +551,7 @@ type RepoChange struct {
 	ID            int64
 	Owner         string
 	RepoName      string
-	Action        string // archived, marked, unmarked, deleted, synced
+	Action        string // archived, unarchived, marked, unmarked, deleted, synced
 	PerformedAt   time.Time
 	PerformedBy   string // user, system, sync
 	PreviousState string // JSON string
@@ -537,6 +639,33 @@ func (s *Store) GetChangesByAction(owner, action string, limit int) ([]RepoChang
 	return scanRepoChanges(rows)
 }
 
+// GetChangesByActions returns every change for an owner whose action is one
+// of actions, oldest first.
+func (s *Store) GetChangesByActions(owner string, actions []string) ([]RepoChange, error) {
+	if len(actions) == 0 {
+		return nil, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(actions)), ", ")
+	args := []any{owner}
+	for _, a := range actions {
+		args = append(args, a)
+	}
+
+	rows, err := s.db.Query(`
+		SELECT id, owner, repo_name, action, performed_at, performed_by, previous_state, new_state, notes
+		FROM repo_changes
+		WHERE owner = ? AND action IN (`+placeholders+`)
+		ORDER BY performed_at, id
+	`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying changes by actions: %w", err)
+	}
+	defer rows.Close()
+
+	return scanRepoChanges(rows)
+}
+
 // scanRepoChanges scans rows into a slice of RepoChange.
 func scanRepoChanges(rows *sql.Rows) ([]RepoChange, error) {
 	var changes []RepoChange
@@ -807,3 +936,629 @@ func scanSync(s scanner) (SyncRecord, error) {
 
 	return record, nil
 }
+
+// CachedReadme is a README fetched from GitHub and stored for offline analysis.
+type CachedReadme struct {
+	Owner     string
+	RepoName  string
+	Content   string // empty when the repository has no README
+	FetchedAt time.Time
+}
+
+// SaveReadme stores or replaces the cached README for a repository.
+func (s *Store) SaveReadme(owner, repoName, content string) error {
+	_, err := s.db.Exec(`
+		INSERT OR REPLACE INTO readme_cache (owner, repo_name, content, fetched_at)
+		VALUES (?, ?, ?, ?)
+	`, owner, repoName, content, formatTimeForSQLite(time.Now()))
+	if err != nil {
+		return fmt.Errorf("saving readme: %w", err)
+	}
+	return nil
+}
+
+// GetReadme returns the cached README for a repository.
+// Returns ErrNotFound if it has never been fetched.
+func (s *Store) GetReadme(owner, repoName string) (*CachedReadme, error) {
+	row := s.db.QueryRow(`
+		SELECT owner, repo_name, content, fetched_at
+		FROM readme_cache
+		WHERE owner = ? AND repo_name = ?
+	`, owner, repoName)
+
+	readme, err := scanReadme(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &readme, nil
+}
+
+// GetReadmes returns all cached READMEs for an owner.
+func (s *Store) GetReadmes(owner string) ([]CachedReadme, error) {
+	rows, err := s.db.Query(`
+		SELECT owner, repo_name, content, fetched_at
+		FROM readme_cache
+		WHERE owner = ?
+		ORDER BY repo_name
+	`, owner)
+	if err != nil {
+		return nil, fmt.Errorf("querying readmes: %w", err)
+	}
+	defer rows.Close()
+
+	var readmes []CachedReadme
+	for rows.Next() {
+		readme, err := scanReadme(rows)
+		if err != nil {
+			return nil, err
+		}
+		readmes = append(readmes, readme)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating rows: %w", err)
+	}
+
+	return readmes, nil
+}
+
+// scanReadme scans a cached README from a row.
+func scanReadme(s scanner) (CachedReadme, error) {
+	var readme CachedReadme
+	var fetchedAt string
+	if err := s.Scan(&readme.Owner, &readme.RepoName, &readme.Content, &fetchedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return CachedReadme{}, err
+		}
+		return CachedReadme{}, fmt.Errorf("scanning readme: %w", err)
+	}
+
+	t, err := parseTimeFromSQLite(fetchedAt)
+	if err != nil {
+		return CachedReadme{}, fmt.Errorf("parsing fetched_at: %w", err)
+	}
+	readme.FetchedAt = t
+
+	return readme, nil
+}
+
+// AIAnalysis is a cached AI analysis result for a repository at a given push.
+type AIAnalysis struct {
+	Owner      string
+	RepoName   string
+	PushedAt   time.Time
+	Backend    string
+	Result     string
+	AnalyzedAt time.Time
+}
+
+// SaveAIAnalysis stores the analysis result for a repository as of pushedAt.
+func (s *Store) SaveAIAnalysis(owner, repoName string, pushedAt time.Time, backend, result string) error {
+	_, err := s.db.Exec(`
+		INSERT OR REPLACE INTO ai_analyses (owner, repo_name, pushed_at, backend, result, analyzed_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`, owner, repoName, pushedAt.UTC().Format(sqliteTimeFormat), backend, result, formatTimeForSQLite(time.Now()))
+	if err != nil {
+		return fmt.Errorf("saving ai analysis: %w", err)
+	}
+	return nil
+}
+
+// GetAIAnalysis returns the cached analysis for a repository as of pushedAt.
+// Returns ErrNotFound if the repository has not been analyzed since that push.
+func (s *Store) GetAIAnalysis(owner, repoName string, pushedAt time.Time, backend string) (*AIAnalysis, error) {
+	var a AIAnalysis
+	var analyzedAt string
+	err := s.db.QueryRow(`
+		SELECT owner, repo_name, backend, result, analyzed_at
+		FROM ai_analyses
+		WHERE owner = ? AND repo_name = ? AND pushed_at = ? AND backend = ?
+	`, owner, repoName, pushedAt.UTC().Format(sqliteTimeFormat), backend).Scan(&a.Owner, &a.RepoName, &a.Backend, &a.Result, &analyzedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("querying ai analysis: %w", err)
+	}
+
+	a.PushedAt = pushedAt
+	if a.AnalyzedAt, err = parseTimeFromSQLite(analyzedAt); err != nil {
+		return nil, fmt.Errorf("parsing analyzed_at: %w", err)
+	}
+	return &a, nil
+}
+
+// RepoVerdict is the latest structured AI recommendation for a repository.
+type RepoVerdict struct {
+	Owner       string
+	RepoName    string
+	Verdict     string // archive, keep, needs-owner-review
+	Confidence  float64
+	Rationale   string
+	Replacement string
+	Backend     string
+	AnalyzedAt  time.Time
+}
+
+// SaveVerdict stores a repository's verdict, replacing any earlier one.
+func (s *Store) SaveVerdict(v RepoVerdict) error {
+	_, err := s.db.Exec(`
+		INSERT OR REPLACE INTO repo_verdicts (owner, repo_name, verdict, confidence, rationale, replacement, backend, analyzed_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`, v.Owner, v.RepoName, v.Verdict, v.Confidence, v.Rationale, v.Replacement, v.Backend, formatTimeForSQLite(time.Now()))
+	if err != nil {
+		return fmt.Errorf("saving verdict: %w", err)
+	}
+	return nil
+}
+
+// GetVerdicts returns the stored verdicts for an owner.
+func (s *Store) GetVerdicts(owner string) ([]RepoVerdict, error) {
+	rows, err := s.db.Query(`
+		SELECT owner, repo_name, verdict, confidence, rationale, replacement, backend, analyzed_at
+		FROM repo_verdicts
+		WHERE owner = ?
+		ORDER BY repo_name
+	`, owner)
+	if err != nil {
+		return nil, fmt.Errorf("querying verdicts: %w", err)
+	}
+	defer rows.Close()
+
+	var verdicts []RepoVerdict
+	for rows.Next() {
+		var v RepoVerdict
+		var analyzedAt string
+		if err := rows.Scan(&v.Owner, &v.RepoName, &v.Verdict, &v.Confidence, &v.Rationale, &v.Replacement, &v.Backend, &analyzedAt); err != nil {
+			return nil, fmt.Errorf("scanning verdict: %w", err)
+		}
+		if v.AnalyzedAt, err = parseTimeFromSQLite(analyzedAt); err != nil {
+			return nil, fmt.Errorf("parsing analyzed_at: %w", err)
+		}
+		verdicts = append(verdicts, v)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating rows: %w", err)
+	}
+
+	return verdicts, nil
+}
+
+// ArchiveModel is a trained archive classifier stored for an owner.
+type ArchiveModel struct {
+	Owner     string
+	Model     string   // JSON encoded by the analyze package
+	Examples  int      // labeled repos used for training
+	Accuracy  *float64 // held-out accuracy, nil when nothing was held out
+	TrainedAt time.Time
+}
+
+// SaveArchiveModel stores an owner's archive classifier, replacing any earlier one.
+func (s *Store) SaveArchiveModel(m ArchiveModel) error {
+	var accuracy sql.NullFloat64
+	if m.Accuracy != nil {
+		accuracy = sql.NullFloat64{Float64: *m.Accuracy, Valid: true}
+	}
+
+	_, err := s.db.Exec(`
+		INSERT OR REPLACE INTO archive_models (owner, model, examples, accuracy, trained_at)
+		VALUES (?, ?, ?, ?, ?)
+	`, m.Owner, m.Model, m.Examples, accuracy, formatTimeForSQLite(time.Now()))
+	if err != nil {
+		return fmt.Errorf("saving archive model: %w", err)
+	}
+	return nil
+}
+
+// GetArchiveModel returns an owner's archive classifier, or ErrNotFound if
+// none has been trained.
+func (s *Store) GetArchiveModel(owner string) (*ArchiveModel, error) {
+	m := ArchiveModel{Owner: owner}
+	var accuracy sql.NullFloat64
+	var trainedAt string
+	err := s.db.QueryRow(`
+		SELECT model, examples, accuracy, trained_at
+		FROM archive_models
+		WHERE owner = ?
+	`, owner).Scan(&m.Model, &m.Examples, &accuracy, &trainedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("querying archive model: %w", err)
+	}
+
+	if accuracy.Valid {
+		m.Accuracy = &accuracy.Float64
+	}
+	if m.TrainedAt, err = parseTimeFromSQLite(trainedAt); err != nil {
+		return nil, fmt.Errorf("parsing trained_at: %w", err)
+	}
+	return &m, nil
+}
+
+// RepoActivity records who has recently committed to a repository, so that
+// pushes by bots are not mistaken for maintenance.
+type RepoActivity struct {
+	Owner             string
+	RepoName          string
+	LastHumanActivity time.Time // zero when no human commit was seen
+	LastBotActivity   time.Time // zero when no bot commit was seen
+	CheckedAt         time.Time
+}
+
+// SaveRepoActivity stores or replaces the commit activity for a repository.
+func (s *Store) SaveRepoActivity(a RepoActivity) error {
+	_, err := s.db.Exec(`
+		INSERT OR REPLACE INTO repo_activity (owner, repo_name, last_human_activity, last_bot_activity, checked_at)
+		VALUES (?, ?, ?, ?, ?)
+	`, a.Owner, a.RepoName, formatTimeForSQLite(a.LastHumanActivity),
+		formatTimeForSQLite(a.LastBotActivity), formatTimeForSQLite(time.Now()))
+	if err != nil {
+		return fmt.Errorf("saving repo activity: %w", err)
+	}
+	return nil
+}
+
+// GetRepoActivities returns the recorded commit activity for an owner's
+// repositories, keyed by repository name.
+func (s *Store) GetRepoActivities(owner string) (map[string]RepoActivity, error) {
+	rows, err := s.db.Query(`
+		SELECT repo_name, last_human_activity, last_bot_activity, checked_at
+		FROM repo_activity
+		WHERE owner = ?
+	`, owner)
+	if err != nil {
+		return nil, fmt.Errorf("querying repo activity: %w", err)
+	}
+	defer rows.Close()
+
+	activities := make(map[string]RepoActivity)
+	for rows.Next() {
+		a := RepoActivity{Owner: owner}
+		var human, bot sql.NullString
+		var checkedAt string
+		if err := rows.Scan(&a.RepoName, &human, &bot, &checkedAt); err != nil {
+			return nil, fmt.Errorf("scanning repo activity: %w", err)
+		}
+		if a.LastHumanActivity, err = parseTimeFromSQLite(human.String); err != nil {
+			return nil, fmt.Errorf("parsing last_human_activity: %w", err)
+		}
+		if a.LastBotActivity, err = parseTimeFromSQLite(bot.String); err != nil {
+			return nil, fmt.Errorf("parsing last_bot_activity: %w", err)
+		}
+		if a.CheckedAt, err = parseTimeFromSQLite(checkedAt); err != nil {
+			return nil, fmt.Errorf("parsing checked_at: %w", err)
+		}
+		activities[a.RepoName] = a
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating rows: %w", err)
+	}
+
+	return activities, nil
+}
+
+// SaveWorkflows replaces the stored Actions workflows of a repository.
+func (s *Store) SaveWorkflows(owner, repoName string, workflows []github.ActionsWorkflow) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback() //nolint:errcheck // Rollback is no-op after commit
+
+	if _, err := tx.Exec(`DELETE FROM repo_workflows WHERE owner = ? AND repo_name = ?`, owner, repoName); err != nil {
+		return fmt.Errorf("clearing workflows: %w", err)
+	}
+	now := formatTimeForSQLite(time.Now())
+	for _, w := range workflows {
+		_, err := tx.Exec(`
+			INSERT INTO repo_workflows (
+				owner, repo_name, workflow_id, name, path, state, triggers, last_run_at, last_status, checked_at
+			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		`, owner, repoName, w.ID, w.Name, w.Path, w.State, nullString(strings.Join(w.Triggers, ",")),
+			formatTimeForSQLite(w.LastRunAt), nullString(w.LastStatus), now)
+		if err != nil {
+			return fmt.Errorf("saving workflow %s: %w", w.Path, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+// GetWorkflows returns the stored Actions workflows of an owner's
+// repositories, keyed by repository name and ordered by path.
+func (s *Store) GetWorkflows(owner string) (map[string][]github.ActionsWorkflow, error) {
+	rows, err := s.db.Query(`
+		SELECT repo_name, workflow_id, name, path, state, triggers, last_run_at, last_status
+		FROM repo_workflows
+		WHERE owner = ?
+		ORDER BY repo_name, path
+	`, owner)
+	if err != nil {
+		return nil, fmt.Errorf("querying workflows: %w", err)
+	}
+	defer rows.Close()
+
+	workflows := make(map[string][]github.ActionsWorkflow)
+	for rows.Next() {
+		var repoName string
+		var w github.ActionsWorkflow
+		var triggers, lastRunAt, lastStatus sql.NullString
+		if err := rows.Scan(&repoName, &w.ID, &w.Name, &w.Path, &w.State, &triggers, &lastRunAt, &lastStatus); err != nil {
+			return nil, fmt.Errorf("scanning workflow: %w", err)
+		}
+		if triggers.String != "" {
+			w.Triggers = strings.Split(triggers.String, ",")
+		}
+		if w.LastRunAt, err = parseTimeFromSQLite(lastRunAt.String); err != nil {
+			return nil, fmt.Errorf("parsing last_run_at: %w", err)
+		}
+		w.LastStatus = lastStatus.String
+		workflows[repoName] = append(workflows[repoName], w)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating rows: %w", err)
+	}
+
+	return workflows, nil
+}
+
+// CachedImpact is an archive impact fetched from GitHub and stored so the
+// confirm step doesn't refetch it on every attempt.
+type CachedImpact struct {
+	Owner     string
+	RepoName  string
+	Impact    string // JSON encoded by the analyze package
+	FetchedAt time.Time
+}
+
+// SaveArchiveImpact stores or replaces the cached archive impact for a repository.
+func (s *Store) SaveArchiveImpact(owner, repoName, impact string) error {
+	_, err := s.db.Exec(`
+		INSERT OR REPLACE INTO archive_impacts (owner, repo_name, impact, fetched_at)
+		VALUES (?, ?, ?, ?)
+	`, owner, repoName, impact, formatTimeForSQLite(time.Now()))
+	if err != nil {
+		return fmt.Errorf("saving archive impact: %w", err)
+	}
+	return nil
+}
+
+// GetArchiveImpact returns the cached archive impact for a repository.
+// Returns ErrNotFound if it has never been fetched.
+func (s *Store) GetArchiveImpact(owner, repoName string) (*CachedImpact, error) {
+	c := CachedImpact{Owner: owner, RepoName: repoName}
+	var fetchedAt string
+	err := s.db.QueryRow(`
+		SELECT impact, fetched_at
+		FROM archive_impacts
+		WHERE owner = ? AND repo_name = ?
+	`, owner, repoName).Scan(&c.Impact, &fetchedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("querying archive impact: %w", err)
+	}
+
+	if c.FetchedAt, err = parseTimeFromSQLite(fetchedAt); err != nil {
+		return nil, fmt.Errorf("parsing fetched_at: %w", err)
+	}
+	return &c, nil
+}
+
+// CompleteWorkflowStep records that an archive workflow step finished for a
+// repository, so an interrupted workflow resumes after it.
+func (s *Store) CompleteWorkflowStep(owner, repoName, step string) error {
+	_, err := s.db.Exec(`
+		INSERT OR REPLACE INTO workflow_steps (owner, repo_name, step, completed_at)
+		VALUES (?, ?, ?, ?)
+	`, owner, repoName, step, formatTimeForSQLite(time.Now()))
+	if err != nil {
+		return fmt.Errorf("saving workflow step: %w", err)
+	}
+	return nil
+}
+
+// GetCompletedWorkflowSteps returns the workflow steps completed for a repository.
+func (s *Store) GetCompletedWorkflowSteps(owner, repoName string) (map[string]bool, error) {
+	rows, err := s.db.Query(`
+		SELECT step FROM workflow_steps WHERE owner = ? AND repo_name = ?
+	`, owner, repoName)
+	if err != nil {
+		return nil, fmt.Errorf("querying workflow steps: %w", err)
+	}
+	defer rows.Close()
+
+	steps := make(map[string]bool)
+	for rows.Next() {
+		var step string
+		if err := rows.Scan(&step); err != nil {
+			return nil, fmt.Errorf("scanning workflow step: %w", err)
+		}
+		steps[step] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating workflow steps: %w", err)
+	}
+	return steps, nil
+}
+
+// ClearWorkflowSteps forgets the completed workflow steps of a repository
+// once its workflow has finished.
+func (s *Store) ClearWorkflowSteps(owner, repoName string) error {
+	_, err := s.db.Exec(`DELETE FROM workflow_steps WHERE owner = ? AND repo_name = ?`, owner, repoName)
+	if err != nil {
+		return fmt.Errorf("clearing workflow steps: %w", err)
+	}
+	return nil
+}
+
+// ArchiveSchedule is an announced archive of a repository, pending until
+// its date passes.
+type ArchiveSchedule struct {
+	Owner       string
+	RepoName    string
+	ArchiveOn   time.Time
+	IssueNumber int
+	IssueURL    string
+	AnnouncedBy string    // login that opened the notice issue
+	PushedAt    time.Time // last push when announced
+	AnnouncedAt time.Time
+}
+
+// SaveArchiveSchedule stores or replaces the archive schedule of a repository.
+func (s *Store) SaveArchiveSchedule(a ArchiveSchedule) error {
+	announcedAt := a.AnnouncedAt
+	if announcedAt.IsZero() {
+		announcedAt = time.Now()
+	}
+	_, err := s.db.Exec(`
+		INSERT OR REPLACE INTO archive_schedules
+			(owner, repo_name, archive_on, issue_number, issue_url, announced_by, pushed_at, announced_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`, a.Owner, a.RepoName, formatTimeForSQLite(a.ArchiveOn), a.IssueNumber, a.IssueURL, a.AnnouncedBy,
+		formatTimeForSQLite(a.PushedAt), formatTimeForSQLite(announcedAt))
+	if err != nil {
+		return fmt.Errorf("saving archive schedule: %w", err)
+	}
+	return nil
+}
+
+// GetArchiveSchedules returns an owner's scheduled archives, earliest first.
+func (s *Store) GetArchiveSchedules(owner string) ([]ArchiveSchedule, error) {
+	rows, err := s.db.Query(`
+		SELECT repo_name, archive_on, issue_number, issue_url, announced_by, pushed_at, announced_at
+		FROM archive_schedules
+		WHERE owner = ?
+		ORDER BY archive_on, repo_name
+	`, owner)
+	if err != nil {
+		return nil, fmt.Errorf("querying archive schedules: %w", err)
+	}
+	defer rows.Close()
+
+	var schedules []ArchiveSchedule
+	for rows.Next() {
+		a := ArchiveSchedule{Owner: owner}
+		var archiveOn, announcedAt string
+		var pushedAt sql.NullString
+		if err := rows.Scan(&a.RepoName, &archiveOn, &a.IssueNumber, &a.IssueURL, &a.AnnouncedBy, &pushedAt, &announcedAt); err != nil {
+			return nil, fmt.Errorf("scanning archive schedule: %w", err)
+		}
+		if a.ArchiveOn, err = parseTimeFromSQLite(archiveOn); err != nil {
+			return nil, fmt.Errorf("parsing archive_on: %w", err)
+		}
+		if a.PushedAt, err = parseTimeFromSQLite(pushedAt.String); err != nil {
+			return nil, fmt.Errorf("parsing pushed_at: %w", err)
+		}
+		if a.AnnouncedAt, err = parseTimeFromSQLite(announcedAt); err != nil {
+			return nil, fmt.Errorf("parsing announced_at: %w", err)
+		}
+		schedules = append(schedules, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating rows: %w", err)
+	}
+
+	return schedules, nil
+}
+
+// DeleteArchiveSchedule removes the archive schedule of a repository.
+func (s *Store) DeleteArchiveSchedule(owner, repoName string) error {
+	_, err := s.db.Exec(`DELETE FROM archive_schedules WHERE owner = ? AND repo_name = ?`, owner, repoName)
+	if err != nil {
+		return fmt.Errorf("deleting archive schedule: %w", err)
+	}
+	return nil
+}
+
+// movedTables are the per-repository tables whose rows follow a repository
+// to its new owner. Marks and scheduled archives are dropped instead.
+var movedTables = []string{
+	"repo_changes",
+	"readme_cache",
+	"ai_analyses",
+	"repo_verdicts",
+	"repo_activity",
+	"repo_issue_activity",
+	"archive_impacts",
+	"workflow_steps",
+	"repo_workflows",
+}
+
+// MoveRepository re-files a repository transferred to newOwner, keeping its
+// change history, cached analyses and activity. Its mark and any scheduled
+// archive under the old owner are removed.
+func (s *Store) MoveRepository(owner, repoName, newOwner string) error {
+	slog.Debug("moving repository", "component", "store", "owner", owner, "repo", repoName, "newOwner", newOwner)
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback() //nolint:errcheck // Rollback is no-op after commit
+
+	if _, err := tx.Exec(`
+		UPDATE OR REPLACE repositories SET owner = ?, full_name = ?
+		WHERE owner = ? AND name = ?
+	`, newOwner, newOwner+"/"+repoName, owner, repoName); err != nil {
+		return fmt.Errorf("moving repository: %w", err)
+	}
+	for _, table := range movedTables {
+		query := "UPDATE OR REPLACE " + table + " SET owner = ? WHERE owner = ? AND repo_name = ?"
+		if _, err := tx.Exec(query, newOwner, owner, repoName); err != nil {
+			return fmt.Errorf("moving %s: %w", table, err)
+		}
+	}
+	for _, table := range []string{"marked_repos", "archive_schedules"} {
+		query := "DELETE FROM " + table + " WHERE owner = ? AND repo_name = ?"
+		if _, err := tx.Exec(query, owner, repoName); err != nil {
+			return fmt.Errorf("clearing %s: %w", table, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+// DeleteRepository forgets a repository deleted on GitHub together with its
+// mark, scheduled archive and workflow progress. Its change history is kept
+// so the deletion and its backup remain on record.
+func (s *Store) DeleteRepository(owner, repoName string) error {
+	slog.Debug("deleting repository", "component", "store", "owner", owner, "repo", repoName)
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback() //nolint:errcheck // Rollback is no-op after commit
+
+	if _, err := tx.Exec(`DELETE FROM repositories WHERE owner = ? AND name = ?`, owner, repoName); err != nil {
+		return fmt.Errorf("deleting repository: %w", err)
+	}
+	for _, table := range []string{"marked_repos", "archive_schedules", "workflow_steps", "repo_workflows"} {
+		query := "DELETE FROM " + table + " WHERE owner = ? AND repo_name = ?"
+		if _, err := tx.Exec(query, owner, repoName); err != nil {
+			return fmt.Errorf("clearing %s: %w", table, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
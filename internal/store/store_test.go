@@ -4,6 +4,7 @@
 package store
 
 import (
+	"database/sql"
 	"testing"
 	"time"
 
@@ -76,6 +77,26 @@ func TestUpsertRepositories_InsertsNewRepos(t *testing.T) {
 	assert.Equal(t, "Go", repo1.PrimaryLanguage)
 }
 
+func TestSyncRepositories_Counts(t *testing.T) {
+	store := setupTestStore(t)
+	owner := "testowner"
+
+	inserted, updated, err := store.SyncRepositories(owner, []github.Repository{testRepo(owner, "repo1")})
+	require.NoError(t, err)
+	assert.Equal(t, 1, inserted)
+	assert.Equal(t, 0, updated)
+
+	inserted, updated, err = store.SyncRepositories(owner, []github.Repository{testRepo(owner, "repo1"), testRepo(owner, "repo2")})
+	require.NoError(t, err)
+	assert.Equal(t, 1, inserted)
+	assert.Equal(t, 1, updated)
+
+	// Repos without topics store NULL
+	var topics sql.NullString
+	require.NoError(t, store.db.QueryRow(`SELECT topics FROM repositories WHERE name = 'repo2'`).Scan(&topics))
+	assert.False(t, topics.Valid)
+}
+
 func TestUpsertRepositories_UpdatesExistingRepos(t *testing.T) {
 	store := setupTestStore(t)
 	owner := "testowner"
@@ -264,6 +285,13 @@ func TestUpdateRepository_Success(t *testing.T) {
 	assert.Equal(t, 999, result.StargazerCount)
 	assert.Equal(t, "Updated via UpdateRepository", result.Description)
 	assert.True(t, result.IsArchived)
+	assert.Empty(t, result.Topics)
+
+	repo.Topics = []string{"cli", "deprecated"}
+	require.NoError(t, store.UpdateRepository(repo))
+	result, err = store.GetRepository(owner, "updateme")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cli", "deprecated"}, result.Topics)
 }
 
 func TestUpdateRepository_NotFound(t *testing.T) {
@@ -1335,3 +1363,319 @@ func TestGetLastSuccessfulSync_FiltersByOwner(t *testing.T) {
 	assert.Equal(t, "owner2", record.Owner)
 	assert.Equal(t, 100, record.ReposFetched)
 }
+
+func TestReadmeCache(t *testing.T) {
+	store := setupTestStore(t)
+
+	_, err := store.GetReadme("owner", "repo1")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, store.SaveReadme("owner", "repo1", "# repo1"))
+	require.NoError(t, store.SaveReadme("owner", "repo2", ""))
+	require.NoError(t, store.SaveReadme("other", "repo3", "# repo3"))
+
+	readme, err := store.GetReadme("owner", "repo1")
+	require.NoError(t, err)
+	assert.Equal(t, "# repo1", readme.Content)
+	assert.WithinDuration(t, time.Now(), readme.FetchedAt, time.Minute)
+
+	// Saving again replaces the cached content
+	require.NoError(t, store.SaveReadme("owner", "repo1", "# updated"))
+	readme, err = store.GetReadme("owner", "repo1")
+	require.NoError(t, err)
+	assert.Equal(t, "# updated", readme.Content)
+
+	readmes, err := store.GetReadmes("owner")
+	require.NoError(t, err)
+	require.Len(t, readmes, 2)
+	assert.Equal(t, "repo1", readmes[0].RepoName)
+	assert.Equal(t, "", readmes[1].Content)
+}
+
+func TestAIAnalysisCache(t *testing.T) {
+	store := setupTestStore(t)
+	pushedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	_, err := store.GetAIAnalysis("owner", "repo1", pushedAt, "fabric")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, store.SaveAIAnalysis("owner", "repo1", pushedAt, "fabric", "ARCHIVE"))
+
+	a, err := store.GetAIAnalysis("owner", "repo1", pushedAt, "fabric")
+	require.NoError(t, err)
+	assert.Equal(t, "ARCHIVE", a.Result)
+	assert.True(t, pushedAt.Equal(a.PushedAt))
+
+	// Results are keyed by push and backend
+	_, err = store.GetAIAnalysis("owner", "repo1", pushedAt.Add(time.Hour), "fabric")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = store.GetAIAnalysis("owner", "repo1", pushedAt, "ollama")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// Saving again replaces the result
+	require.NoError(t, store.SaveAIAnalysis("owner", "repo1", pushedAt, "fabric", "KEEP"))
+	a, err = store.GetAIAnalysis("owner", "repo1", pushedAt, "fabric")
+	require.NoError(t, err)
+	assert.Equal(t, "KEEP", a.Result)
+}
+
+func TestGetChangesByActions(t *testing.T) {
+	store := setupTestStore(t)
+
+	require.NoError(t, store.RecordRepoChange("owner", "repo1", "marked", "user", nil, nil, ""))
+	require.NoError(t, store.RecordRepoChange("owner", "repo1", "archived", "user", nil, nil, ""))
+	require.NoError(t, store.RecordRepoChange("owner", "repo2", "unmarked", "user", nil, nil, ""))
+	require.NoError(t, store.RecordRepoChange("other", "repo3", "archived", "user", nil, nil, ""))
+
+	changes, err := store.GetChangesByActions("owner", []string{"archived", "unmarked"})
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	assert.Equal(t, "repo1", changes[0].RepoName)
+	assert.Equal(t, "repo2", changes[1].RepoName)
+
+	changes, err = store.GetChangesByActions("owner", nil)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestArchiveModel(t *testing.T) {
+	store := setupTestStore(t)
+
+	_, err := store.GetArchiveModel("owner")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, store.SaveArchiveModel(ArchiveModel{Owner: "owner", Model: `{"bias": 1}`, Examples: 40}))
+	m, err := store.GetArchiveModel("owner")
+	require.NoError(t, err)
+	assert.Equal(t, `{"bias": 1}`, m.Model)
+	assert.Equal(t, 40, m.Examples)
+	assert.Nil(t, m.Accuracy)
+	assert.False(t, m.TrainedAt.IsZero())
+
+	// Retraining replaces the model
+	accuracy := 0.85
+	require.NoError(t, store.SaveArchiveModel(ArchiveModel{Owner: "owner", Model: `{"bias": 2}`, Examples: 50, Accuracy: &accuracy}))
+	m, err = store.GetArchiveModel("owner")
+	require.NoError(t, err)
+	assert.Equal(t, `{"bias": 2}`, m.Model)
+	require.NotNil(t, m.Accuracy)
+	assert.InDelta(t, 0.85, *m.Accuracy, 0.001)
+}
+
+func TestRepoActivity(t *testing.T) {
+	store := setupTestStore(t)
+	human := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	bot := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	repos := []github.Repository{testRepo("owner", "repo1"), testRepo("owner", "repo2")}
+	require.NoError(t, store.UpsertRepositories("owner", repos))
+	require.NoError(t, store.SaveRepoActivity(RepoActivity{Owner: "owner", RepoName: "repo1", LastHumanActivity: human, LastBotActivity: bot}))
+
+	activities, err := store.GetRepoActivities("owner")
+	require.NoError(t, err)
+	require.Len(t, activities, 1)
+	assert.True(t, human.Equal(activities["repo1"].LastHumanActivity))
+	assert.True(t, bot.Equal(activities["repo1"].LastBotActivity))
+	assert.False(t, activities["repo1"].CheckedAt.IsZero())
+
+	// Loaded repositories carry the last human activity, which survives a re-sync
+	require.NoError(t, store.UpsertRepositories("owner", repos))
+	loaded, err := store.GetRepositories("owner")
+	require.NoError(t, err)
+	require.Len(t, loaded, 2)
+	assert.True(t, human.Equal(loaded[0].LastHumanActivity))
+	assert.True(t, loaded[1].LastHumanActivity.IsZero())
+
+	repo, err := store.GetRepository("owner", "repo1")
+	require.NoError(t, err)
+	assert.True(t, human.Equal(repo.LastHumanActivity))
+}
+
+func TestIssueActivity(t *testing.T) {
+	store := setupTestStore(t)
+	updated := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	repo1 := testRepo("owner", "repo1")
+	repo1.IssueActivity = &github.IssueActivity{OpenIssues: 3, OpenPullRequests: 1, OpenDiscussions: 2, LastUpdated: updated}
+	repo2 := testRepo("owner", "repo2")
+	repo2.IssueActivity = &github.IssueActivity{}
+	require.NoError(t, store.UpsertRepositories("owner", []github.Repository{repo1, repo2, testRepo("owner", "repo3")}))
+
+	repos, err := store.GetRepositories("owner")
+	require.NoError(t, err)
+	require.Len(t, repos, 3)
+	require.NotNil(t, repos[0].IssueActivity)
+	assert.Equal(t, 6, repos[0].IssueActivity.OpenItems())
+	assert.True(t, updated.Equal(repos[0].IssueActivity.LastUpdated))
+	require.NotNil(t, repos[1].IssueActivity)
+	assert.True(t, repos[1].IssueActivity.LastUpdated.IsZero())
+	assert.Nil(t, repos[2].IssueActivity)
+
+	// A sync without issue activity keeps what was stored
+	require.NoError(t, store.UpsertRepositories("owner", []github.Repository{testRepo("owner", "repo1")}))
+	repo, err := store.GetRepository("owner", "repo1")
+	require.NoError(t, err)
+	require.NotNil(t, repo.IssueActivity)
+	assert.Equal(t, 3, repo.IssueActivity.OpenIssues)
+}
+
+func TestArchiveImpactCache(t *testing.T) {
+	store := setupTestStore(t)
+
+	_, err := store.GetArchiveImpact("owner", "repo1")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, store.SaveArchiveImpact("owner", "repo1", `{"openIssues": 1}`))
+	require.NoError(t, store.SaveArchiveImpact("owner", "repo1", `{"openIssues": 2}`))
+
+	c, err := store.GetArchiveImpact("owner", "repo1")
+	require.NoError(t, err)
+	assert.Equal(t, `{"openIssues": 2}`, c.Impact)
+	assert.WithinDuration(t, time.Now(), c.FetchedAt, time.Minute)
+}
+
+func TestWorkflowSteps(t *testing.T) {
+	store := setupTestStore(t)
+
+	steps, err := store.GetCompletedWorkflowSteps("owner", "repo1")
+	require.NoError(t, err)
+	assert.Empty(t, steps)
+
+	require.NoError(t, store.CompleteWorkflowStep("owner", "repo1", "topic:deprecated"))
+	require.NoError(t, store.CompleteWorkflowStep("owner", "repo1", "topic:deprecated"))
+	require.NoError(t, store.CompleteWorkflowStep("owner", "repo1", "close-prs"))
+	require.NoError(t, store.CompleteWorkflowStep("owner", "repo2", "archive"))
+
+	steps, err = store.GetCompletedWorkflowSteps("owner", "repo1")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"topic:deprecated": true, "close-prs": true}, steps)
+
+	require.NoError(t, store.ClearWorkflowSteps("owner", "repo1"))
+	steps, err = store.GetCompletedWorkflowSteps("owner", "repo1")
+	require.NoError(t, err)
+	assert.Empty(t, steps)
+
+	steps, err = store.GetCompletedWorkflowSteps("owner", "repo2")
+	require.NoError(t, err)
+	assert.Len(t, steps, 1)
+}
+
+func TestArchiveSchedules(t *testing.T) {
+	store := setupTestStore(t)
+
+	schedules, err := store.GetArchiveSchedules("owner")
+	require.NoError(t, err)
+	assert.Empty(t, schedules)
+
+	later := time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC)
+	sooner := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	pushed := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, store.SaveArchiveSchedule(ArchiveSchedule{Owner: "owner", RepoName: "repo1", ArchiveOn: later,
+		IssueNumber: 3, IssueURL: "https://github.com/owner/repo1/issues/3", AnnouncedBy: "alice", PushedAt: pushed}))
+	require.NoError(t, store.SaveArchiveSchedule(ArchiveSchedule{Owner: "owner", RepoName: "repo2", ArchiveOn: sooner,
+		IssueNumber: 9, IssueURL: "https://github.com/owner/repo2/issues/9", AnnouncedBy: "alice"}))
+
+	schedules, err = store.GetArchiveSchedules("owner")
+	require.NoError(t, err)
+	require.Len(t, schedules, 2)
+	assert.Equal(t, "repo2", schedules[0].RepoName)
+	assert.True(t, schedules[0].PushedAt.IsZero())
+	assert.Equal(t, "repo1", schedules[1].RepoName)
+	assert.Equal(t, later, schedules[1].ArchiveOn)
+	assert.Equal(t, pushed, schedules[1].PushedAt)
+	assert.Equal(t, 3, schedules[1].IssueNumber)
+	assert.Equal(t, "alice", schedules[1].AnnouncedBy)
+	assert.WithinDuration(t, time.Now(), schedules[1].AnnouncedAt, time.Minute)
+
+	require.NoError(t, store.DeleteArchiveSchedule("owner", "repo2"))
+	schedules, err = store.GetArchiveSchedules("owner")
+	require.NoError(t, err)
+	assert.Len(t, schedules, 1)
+}
+
+func TestMoveRepository(t *testing.T) {
+	store := setupTestStore(t)
+	repos := []github.Repository{
+		{Owner: "acme", Name: "widget", StargazerCount: 3},
+		{Owner: "acme", Name: "gadget"},
+	}
+	require.NoError(t, store.UpsertRepositories("acme", repos))
+	require.NoError(t, store.AddMarkedRepo("acme", "widget"))
+	require.NoError(t, store.RecordRepoChange("acme", "widget", "marked", "user", nil, nil, ""))
+	require.NoError(t, store.SaveReadme("acme", "widget", "# Widget"))
+	require.NoError(t, store.SaveArchiveSchedule(ArchiveSchedule{Owner: "acme", RepoName: "widget",
+		ArchiveOn: time.Now(), IssueNumber: 1, IssueURL: "u", AnnouncedBy: "janitor"}))
+
+	require.NoError(t, store.MoveRepository("acme", "widget", "acme-attic"))
+
+	remaining, err := store.GetRepositories("acme")
+	require.NoError(t, err)
+	require.Len(t, remaining, 1)
+	assert.Equal(t, "gadget", remaining[0].Name)
+
+	moved, err := store.GetRepository("acme-attic", "widget")
+	require.NoError(t, err)
+	assert.Equal(t, "acme-attic/widget", moved.FullName())
+	assert.Equal(t, 3, moved.StargazerCount)
+
+	history, err := store.GetRepoHistory("acme-attic", "widget", 10)
+	require.NoError(t, err)
+	assert.Len(t, history, 1)
+	readme, err := store.GetReadme("acme-attic", "widget")
+	require.NoError(t, err)
+	assert.Equal(t, "# Widget", readme.Content)
+
+	marked, err := store.GetMarkedRepos("acme")
+	require.NoError(t, err)
+	assert.Empty(t, marked)
+	schedules, err := store.GetArchiveSchedules("acme")
+	require.NoError(t, err)
+	assert.Empty(t, schedules)
+}
+
+func TestDeleteRepository(t *testing.T) {
+	store := setupTestStore(t)
+	require.NoError(t, store.UpsertRepositories("acme", []github.Repository{{Owner: "acme", Name: "widget"}}))
+	require.NoError(t, store.AddMarkedRepo("acme", "widget"))
+	require.NoError(t, store.RecordRepoChange("acme", "widget", "marked", "user", nil, nil, ""))
+
+	require.NoError(t, store.DeleteRepository("acme", "widget"))
+
+	_, err := store.GetRepository("acme", "widget")
+	assert.ErrorIs(t, err, ErrNotFound)
+	marked, err := store.GetMarkedRepos("acme")
+	require.NoError(t, err)
+	assert.Empty(t, marked)
+	history, err := store.GetRepoHistory("acme", "widget", 10)
+	require.NoError(t, err)
+	assert.Len(t, history, 1)
+}
+
+func TestWorkflows(t *testing.T) {
+	store := setupTestStore(t)
+	require.NoError(t, store.UpsertRepositories("acme", []github.Repository{{Owner: "acme", Name: "widget"}}))
+	lastRun := time.Date(2025, 3, 1, 4, 0, 0, 0, time.UTC)
+	workflows := []github.ActionsWorkflow{
+		{ID: 1, Name: "CI", Path: ".github/workflows/ci.yml", State: "active", Triggers: []string{"push", "pull_request"}},
+		{ID: 2, Name: "Nightly", Path: ".github/workflows/nightly.yml", State: "active", Triggers: []string{"schedule", "workflow_dispatch"},
+			LastRunAt: lastRun, LastStatus: "failure"},
+	}
+	require.NoError(t, store.SaveWorkflows("acme", "widget", workflows))
+
+	stored, err := store.GetWorkflows("acme")
+	require.NoError(t, err)
+	assert.Equal(t, workflows, stored["widget"])
+	repo, err := store.GetRepository("acme", "widget")
+	require.NoError(t, err)
+	assert.Equal(t, 1, repo.CronWorkflows)
+
+	// Saving replaces the previous inventory
+	workflows[1].State = "disabled_manually"
+	require.NoError(t, store.SaveWorkflows("acme", "widget", workflows[1:]))
+	stored, err = store.GetWorkflows("acme")
+	require.NoError(t, err)
+	assert.Len(t, stored["widget"], 1)
+	repo, err = store.GetRepository("acme", "widget")
+	require.NoError(t, err)
+	assert.Zero(t, repo.CronWorkflows)
+}
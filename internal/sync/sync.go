@@ -29,12 +29,17 @@ type SyncMsg struct {
 	Type  SyncMsgType
 	Repos []github.Repository // only populated for SyncCompleted
 	Error error               // only populated for SyncError
+
+	// ActivityError is set for SyncCompleted when the issue activity could
+	// not be fetched; Repos then carry none.
+	ActivityError error
 }
 
 // SyncResult represents the result of a single sync operation.
 type SyncResult struct {
-	Repos []github.Repository
-	Error error
+	Repos         []github.Repository
+	Error         error
+	ActivityError error // the issue activity could not be fetched
 }
 
 // Syncer handles background repository synchronization.
@@ -74,11 +79,7 @@ func (s *Syncer) Stop() {
 // SyncOnce performs a single sync and returns the result.
 // This is useful for testing or one-off sync operations.
 func (s *Syncer) SyncOnce() SyncResult {
-	repos, err := s.doSync()
-	return SyncResult{
-		Repos: repos,
-		Error: err,
-	}
+	return s.doSync()
 }
 
 // run is the main background sync loop.
@@ -132,22 +133,23 @@ func (s *Syncer) performSync() {
 		return
 	}
 
-	repos, err := s.doSync()
+	result := s.doSync()
 
 	// Send result message
 	var msg SyncMsg
-	if err != nil {
+	if result.Error != nil {
 		msg = SyncMsg{
 			Type:  SyncError,
-			Error: err,
+			Error: result.Error,
 		}
-		slog.Error("sync failed", "component", "sync", "error", err, "owner", s.owner)
+		slog.Error("sync failed", "component", "sync", "error", result.Error, "owner", s.owner)
 	} else {
 		msg = SyncMsg{
-			Type:  SyncCompleted,
-			Repos: repos,
+			Type:          SyncCompleted,
+			Repos:         result.Repos,
+			ActivityError: result.ActivityError,
 		}
-		slog.Info("sync completed", "component", "sync", "owner", s.owner, "repos", len(repos))
+		slog.Info("sync completed", "component", "sync", "owner", s.owner, "repos", len(result.Repos))
 	}
 
 	select {
@@ -157,20 +159,26 @@ func (s *Syncer) performSync() {
 	}
 }
 
-// doSync fetches repositories from GitHub and upserts them to the database.
-func (s *Syncer) doSync() ([]github.Repository, error) {
+// doSync fetches repositories and their issue activity from GitHub and
+// upserts them to the database. When only the activity fetch fails, the
+// repos are still stored, without activity, and ActivityError is set.
+func (s *Syncer) doSync() SyncResult {
 	slog.Debug("starting sync", "component", "sync", "owner", s.owner)
 
 	// Fetch from GitHub
 	repos, err := s.client.FetchRepositories(s.owner)
 	if err != nil {
-		return nil, err
+		return SyncResult{Error: err}
+	}
+	activityErr := s.client.AddIssueActivity(s.owner, repos)
+	if activityErr != nil {
+		slog.Warn("failed to fetch issue activity", "component", "sync", "owner", s.owner, "error", activityErr)
 	}
 
 	// Upsert to database
 	if err := s.store.UpsertRepositories(s.owner, repos); err != nil {
-		return nil, err
+		return SyncResult{Error: err}
 	}
 
-	return repos, nil
+	return SyncResult{Repos: repos, ActivityError: activityErr}
 }
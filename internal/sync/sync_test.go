@@ -39,4 +39,5 @@ func TestSyncResult_DefaultValues(t *testing.T) {
 	assert.Nil(t, result.Repos)
 	// Default error should be nil
 	assert.Nil(t, result.Error)
+	assert.Nil(t, result.ActivityError)
 }
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package testutil
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// NewGitRemote creates a bare git repository with one commit on main and a
+// v1.0.0 tag, and returns its path for use as a clone URL in place of
+// GitHub. The test is skipped when git is not installed.
+func NewGitRemote(t testing.TB) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	work := filepath.Join(dir, "work")
+	remote := filepath.Join(dir, "remote.git")
+
+	run := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	run("init", "--quiet", "--initial-branch=main", work)
+	if err := os.WriteFile(filepath.Join(work, "README.md"), []byte("# Widget\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	run("-C", work, "add", ".")
+	run("-C", work, "commit", "--quiet", "-m", "Initial commit")
+	run("-C", work, "tag", "v1.0.0")
+	run("clone", "--quiet", "--bare", work, remote)
+	return remote
+}
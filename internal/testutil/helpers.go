@@ -124,6 +124,13 @@ func WithPushedAt(t time.Time) RepoOption {
 	}
 }
 
+// WithHumanInactive sets the last human commit to the specified number of days ago.
+func WithHumanInactive(days int) RepoOption {
+	return func(r *github.Repository) {
+		r.LastHumanActivity = time.Now().AddDate(0, 0, -days)
+	}
+}
+
 // WithMarkedForArchive sets the archive marker state (for UI testing).
 func WithMarkedForArchive(marked bool, reason string) RepoOption {
 	return func(r *github.Repository) {
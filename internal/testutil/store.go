@@ -0,0 +1,26 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package testutil
+
+import (
+	"testing"
+
+	"github.com/llbbl/repjan/internal/db"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+// NewStore returns a store backed by a migrated in-memory database, which is
+// closed when the test ends.
+func NewStore(t testing.TB) *store.Store {
+	t.Helper()
+	database, err := db.Open(":memory:")
+	if err != nil {
+		t.Fatalf("opening database: %v", err)
+	}
+	t.Cleanup(func() { db.Close(database) })
+	if err := db.RunMigrations(database); err != nil {
+		t.Fatalf("running migrations: %v", err)
+	}
+	return store.New(database)
+}
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package transfer moves inactive repositories to a graveyard organization,
+// optionally archiving them there.
+package transfer
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+// ActionTransferred is the repo_changes action recorded for a transfer.
+const ActionTransferred = "transferred"
+
+// Client is the GitHub access transferring repositories needs.
+type Client interface {
+	FetchRepoSettings(owner, name string) (github.RepoSettings, error)
+	TransferRepository(owner, name, newOwner string) error
+	ArchiveRepository(owner, name string) error
+}
+
+// Result is what Transfer did with one repository.
+type Result struct {
+	Transferred bool // false when the repository was already under the target
+	Archived    bool // false when not requested or already archived
+}
+
+// Mover transfers repositories and keeps the store in step.
+type Mover struct {
+	client Client
+	store  *store.Store
+
+	// pollInterval and pollAttempts bound the wait for GitHub to finish a
+	// transfer, which happens asynchronously, before archiving.
+	pollInterval time.Duration
+	pollAttempts int
+}
+
+// New creates a mover. The store may be nil.
+func New(client Client, s *store.Store) *Mover {
+	return &Mover{client: client, store: s, pollInterval: 2 * time.Second, pollAttempts: 15}
+}
+
+// Transfer moves repo to target and, with archive, archives it there. A
+// repository under target counts as already transferred only when it is the
+// same repository, so a failed run can be retried; an unrelated repository
+// of the same name is a collision error. The stored repository, its history
+// and cached data move to the new owner.
+func (m *Mover) Transfer(repo github.Repository, target string, archive bool) (Result, error) {
+	if target == "" {
+		return Result{}, errors.New("no target owner configured")
+	}
+	if repo.Owner == target {
+		return Result{}, fmt.Errorf("%s already belongs to %s", repo.FullName(), target)
+	}
+
+	var result Result
+	settings, err := m.client.FetchRepoSettings(target, repo.Name)
+	switch {
+	case errors.Is(err, github.ErrNotFound):
+		if err := m.client.TransferRepository(repo.Owner, repo.Name, target); err != nil {
+			return result, err
+		}
+		result.Transferred = true
+	case err != nil:
+		return result, err
+	default:
+		if err := m.checkSameRepo(repo, target, settings); err != nil {
+			return result, err
+		}
+	}
+
+	if m.store != nil {
+		if err := m.store.MoveRepository(repo.Owner, repo.Name, target); err != nil {
+			return result, err
+		}
+		if result.Transferred {
+			prev := map[string]string{"owner": repo.Owner}
+			next := map[string]string{"owner": target}
+			if err := m.store.RecordRepoChange(target, repo.Name, ActionTransferred, "user", prev, next, ""); err != nil {
+				slog.Warn("failed to record transfer", "component", "transfer", "repo", repo.FullName(), "error", err)
+			}
+		}
+	}
+
+	if archive && result.Transferred {
+		if settings, err = m.waitForTransfer(target, repo.Name); err != nil {
+			return result, err
+		}
+	}
+	if archive && !settings.IsArchived {
+		if err := m.client.ArchiveRepository(target, repo.Name); err != nil {
+			return result, fmt.Errorf("archiving after transfer: %w", err)
+		}
+		result.Archived = true
+		m.markArchived(target, repo.Name)
+	}
+	return result, nil
+}
+
+// checkSameRepo makes sure the repository found under target is repo after
+// an earlier transfer: either repo is gone from its owner or GitHub resolves
+// the old name to the same repository.
+func (m *Mover) checkSameRepo(repo github.Repository, target string, found github.RepoSettings) error {
+	source, err := m.client.FetchRepoSettings(repo.Owner, repo.Name)
+	switch {
+	case errors.Is(err, github.ErrNotFound):
+		return nil
+	case err != nil:
+		return err
+	case source.ID != "" && source.ID == found.ID:
+		return nil
+	}
+	return fmt.Errorf("%s/%s already exists and is a different repository than %s", target, repo.Name, repo.FullName())
+}
+
+// waitForTransfer polls until the transferred repository resolves under its
+// new owner.
+func (m *Mover) waitForTransfer(owner, name string) (github.RepoSettings, error) {
+	for attempt := 1; ; attempt++ {
+		settings, err := m.client.FetchRepoSettings(owner, name)
+		if err == nil {
+			return settings, nil
+		}
+		if !errors.Is(err, github.ErrNotFound) || attempt >= m.pollAttempts {
+			return settings, fmt.Errorf("waiting for transfer of %s to %s: %w", name, owner, err)
+		}
+		time.Sleep(m.pollInterval)
+	}
+}
+
+// markArchived records the archive of a transferred repository.
+func (m *Mover) markArchived(owner, name string) {
+	if m.store == nil {
+		return
+	}
+	if err := m.store.RecordRepoChange(owner, name, "archived", "user", nil, nil, "archived after transfer"); err != nil {
+		slog.Warn("failed to record archive", "component", "transfer", "repo", name, "error", err)
+	}
+	stored, err := m.store.GetRepository(owner, name)
+	if err == nil {
+		stored.IsArchived = true
+		err = m.store.UpdateRepository(*stored)
+	}
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		slog.Warn("failed to update archived repo", "component", "transfer", "repo", name, "error", err)
+	}
+}
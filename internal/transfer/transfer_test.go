@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package transfer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+// fakeClient is an in-memory GitHub keyed by full name. A transferred
+// repository only shows up under its new owner after pending lookups.
+type fakeClient struct {
+	repos     map[string]github.RepoSettings
+	transfers int
+	pending   int
+}
+
+func (c *fakeClient) FetchRepoSettings(owner, name string) (github.RepoSettings, error) {
+	settings, ok := c.repos[owner+"/"+name]
+	if !ok {
+		return settings, github.ErrNotFound
+	}
+	if c.transfers > 0 && c.pending > 0 {
+		c.pending--
+		return github.RepoSettings{}, github.ErrNotFound
+	}
+	return settings, nil
+}
+
+func (c *fakeClient) TransferRepository(owner, name, newOwner string) error {
+	c.transfers++
+	c.repos[newOwner+"/"+name] = c.repos[owner+"/"+name]
+	delete(c.repos, owner+"/"+name)
+	return nil
+}
+
+func (c *fakeClient) ArchiveRepository(owner, name string) error {
+	settings, ok := c.repos[owner+"/"+name]
+	if !ok {
+		return github.ErrNotFound
+	}
+	settings.IsArchived = true
+	c.repos[owner+"/"+name] = settings
+	return nil
+}
+
+func TestTransfer(t *testing.T) {
+	client := &fakeClient{repos: map[string]github.RepoSettings{"acme/widget": {ID: "R_1"}}, pending: 2}
+	s := testutil.NewStore(t)
+	repo := github.Repository{Owner: "acme", Name: "widget"}
+	require.NoError(t, s.UpsertRepositories("acme", []github.Repository{repo}))
+	require.NoError(t, s.AddMarkedRepo("acme", "widget"))
+	require.NoError(t, s.RecordRepoChange("acme", "widget", "marked", "user", nil, nil, ""))
+
+	m := New(client, s)
+	m.pollInterval = 0
+	result, err := m.Transfer(repo, "acme-attic", true)
+	require.NoError(t, err)
+	assert.Equal(t, Result{Transferred: true, Archived: true}, result)
+	assert.True(t, client.repos["acme-attic/widget"].IsArchived)
+
+	stored, err := s.GetRepository("acme-attic", "widget")
+	require.NoError(t, err)
+	assert.True(t, stored.IsArchived)
+	_, err = s.GetRepository("acme", "widget")
+	assert.ErrorIs(t, err, store.ErrNotFound)
+	marked, err := s.GetMarkedRepos("acme")
+	require.NoError(t, err)
+	assert.Empty(t, marked)
+
+	history, err := s.GetRepoHistory("acme-attic", "widget", 10)
+	require.NoError(t, err)
+	actions := make([]string, len(history))
+	for i, c := range history {
+		actions[i] = c.Action
+	}
+	assert.ElementsMatch(t, []string{"marked", ActionTransferred, "archived"}, actions)
+
+	// Retrying finds the repository already moved
+	result, err = m.Transfer(repo, "acme-attic", true)
+	require.NoError(t, err)
+	assert.Equal(t, Result{}, result)
+	assert.Equal(t, 1, client.transfers)
+
+	// GitHub redirecting the old name to the moved repository counts as moved
+	client.repos["acme/widget"] = client.repos["acme-attic/widget"]
+	_, err = m.Transfer(repo, "acme-attic", true)
+	require.NoError(t, err)
+	assert.Equal(t, 1, client.transfers)
+}
+
+func TestTransfer_NameCollision(t *testing.T) {
+	client := &fakeClient{repos: map[string]github.RepoSettings{
+		"acme/widget":       {ID: "R_1"},
+		"acme-attic/widget": {ID: "R_2"},
+	}}
+	s := testutil.NewStore(t)
+	repo := github.Repository{Owner: "acme", Name: "widget", Description: "ours"}
+	other := github.Repository{Owner: "acme-attic", Name: "widget", Description: "theirs"}
+	require.NoError(t, s.UpsertRepositories("acme", []github.Repository{repo}))
+	require.NoError(t, s.UpsertRepositories("acme-attic", []github.Repository{other}))
+	require.NoError(t, s.AddMarkedRepo("acme", "widget"))
+
+	result, err := New(client, s).Transfer(repo, "acme-attic", true)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "different repository")
+	assert.Equal(t, Result{}, result)
+	assert.Zero(t, client.transfers)
+	assert.False(t, client.repos["acme-attic/widget"].IsArchived)
+
+	stored, err := s.GetRepository("acme-attic", "widget")
+	require.NoError(t, err)
+	assert.Equal(t, "theirs", stored.Description)
+	marked, err := s.GetMarkedRepos("acme")
+	require.NoError(t, err)
+	assert.Len(t, marked, 1)
+}
+
+func TestTransfer_NeverResolves(t *testing.T) {
+	client := &fakeClient{repos: map[string]github.RepoSettings{"acme/widget": {ID: "R_1"}}, pending: 100}
+	s := testutil.NewStore(t)
+	repo := github.Repository{Owner: "acme", Name: "widget"}
+	require.NoError(t, s.UpsertRepositories("acme", []github.Repository{repo}))
+	m := New(client, s)
+	m.pollInterval = 0
+	m.pollAttempts = 3
+
+	result, err := m.Transfer(repo, "acme-attic", true)
+	require.ErrorIs(t, err, github.ErrNotFound)
+	assert.Equal(t, Result{Transferred: true}, result)
+
+	// The store still follows the transfer
+	_, err = s.GetRepository("acme-attic", "widget")
+	assert.NoError(t, err)
+}
+
+func TestTransfer_NoTarget(t *testing.T) {
+	m := New(&fakeClient{}, nil)
+	_, err := m.Transfer(github.Repository{Owner: "acme", Name: "widget"}, "", false)
+	assert.Error(t, err)
+	_, err = m.Transfer(github.Repository{Owner: "acme", Name: "widget"}, "acme", false)
+	assert.Error(t, err)
+}
@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/llbbl/repjan/internal/actions"
+	"github.com/llbbl/repjan/internal/github"
+)
+
+// loadWorkflows loads the Actions workflows recorded by 'repjan actions' and
+// applies them to the loaded repos.
+func (m *Model) loadWorkflows() {
+	if m.store == nil {
+		return
+	}
+	workflows, err := m.store.GetWorkflows(m.owner)
+	if err != nil {
+		slog.Warn("failed to load workflows", "component", "tui", "error", err)
+		return
+	}
+
+	m.workflows = make(map[string][]github.ActionsWorkflow, len(workflows))
+	for name, w := range workflows {
+		m.workflows[m.owner+"/"+name] = w
+	}
+	m.applyWorkflows()
+}
+
+// applyWorkflows sets CronWorkflows on repos from the loaded workflows, for
+// repos freshly fetched from GitHub by a sync.
+func (m *Model) applyWorkflows() {
+	for i := range m.repos {
+		if w, ok := m.workflows[m.repos[i].FullName()]; ok {
+			m.repos[i].CronWorkflows = len(actions.Scheduled(w))
+		}
+	}
+}
+
+// renderWorkflows renders the Workflows section of the detail modal, or
+// nothing when the repo's workflows have not been inventoried.
+func (m Model) renderWorkflows(repo github.Repository) string {
+	workflows := m.workflows[repo.FullName()]
+	if len(workflows) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("Workflows:\n")
+	for _, w := range workflows {
+		state := ""
+		if w.State != "active" {
+			state = " (" + strings.ReplaceAll(w.State, "_", " ") + ")"
+		}
+		b.WriteString(fmt.Sprintf("  %-14s %s; %s%s\n", w.Name, strings.Join(w.Triggers, ", "), actions.LastRun(w), state))
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+// disableWorkflowsOfMarkedRepos starts a batch disabling the scheduled
+// workflows of each marked, unarchived repo.
+func (m *Model) disableWorkflowsOfMarkedRepos() tea.Cmd {
+	var repos []github.Repository
+	for _, repo := range m.getMarkedRepos() {
+		if !repo.IsArchived {
+			repos = append(repos, repo)
+		}
+	}
+	if len(repos) == 0 {
+		m.statusMessage = "No unarchived repos marked"
+		return nil
+	}
+	return m.startBatch(repos)
+}
+
+// markWorkflowsDisabled records in the model that a repo's scheduled
+// workflows were disabled. The store was updated by the inventory itself.
+func (m *Model) markWorkflowsDisabled(fullName string) {
+	for i := range m.repos {
+		if m.repos[i].FullName() == fullName {
+			m.repos[i].CronWorkflows = 0
+			break
+		}
+	}
+	for i, w := range m.workflows[fullName] {
+		if w.IsScheduled() {
+			m.workflows[fullName][i].State = "disabled_manually"
+		}
+	}
+	m.RefreshFilteredRepos()
+}
+
+// disableWorkflowsNextRepo returns a command disabling the scheduled
+// workflows of the repository at current.
+func disableWorkflowsNextRepo(inventory *actions.Inventory, repos []github.Repository, current int, state *archiveState) tea.Cmd {
+	return func() tea.Msg {
+		repo := repos[current]
+		_, err := inventory.DisableScheduled(repo)
+		if err != nil {
+			slog.Debug("disabling workflows failed", "component", "tui", "repo", repo.FullName(), "err", err)
+		}
+		state.recordResult(repo, err)
+
+		return ArchiveProgressMsg{
+			Current:  current + 1,
+			Total:    len(repos),
+			RepoName: repo.FullName(),
+			Err:      err,
+		}
+	}
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+func TestDisableWorkflows(t *testing.T) {
+	repos := []github.Repository{
+		testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("widget"), testutil.WithDaysInactive(400)),
+	}
+	s := testutil.NewStore(t)
+	require.NoError(t, s.UpsertRepositories("acme", repos))
+	nightly := github.ActionsWorkflow{ID: 7, Name: "Nightly", Path: ".github/workflows/nightly.yml", State: "active",
+		Triggers: []string{"schedule"}, LastRunAt: time.Date(2025, 3, 1, 4, 0, 0, 0, time.UTC), LastStatus: "failure"}
+	require.NoError(t, s.SaveWorkflows("acme", "widget", []github.ActionsWorkflow{nightly}))
+
+	executor := testutil.NewMockExecutor()
+	executor.ExecuteFunc = func(name string, args ...string) ([]byte, error) {
+		switch {
+		case strings.HasSuffix(args[1], "/actions/workflows"):
+			return []byte(`{"id":7,"name":"Nightly","path":".github/workflows/nightly.yml","state":"active"}`), nil
+		case strings.Contains(args[1], "/contents/"):
+			return []byte(base64.StdEncoding.EncodeToString([]byte("on:\n  schedule:\n    - cron: '0 4 * * *'\n"))), nil
+		}
+		return nil, nil
+	}
+	m := NewModelWithStore(repos, "acme", github.NewClient(executor), s, false, "", nil)
+	assert.Equal(t, 1, m.repos[0].CronWorkflows)
+	assert.Contains(t, m.renderWorkflows(m.repos[0]), "Nightly        schedule; failure 2025-03-01")
+	assert.Contains(t, m.assess(m.repos[0]).Codes(), "scheduled_workflows")
+
+	m.marked["acme/widget"] = true
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("W")})
+	m = updated.(Model)
+	require.Equal(t, ModalConfirm, m.activeModal)
+	assert.Contains(t, m.renderConfirmModal(), "disable the scheduled workflows of 1 repo:")
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	m = updated.(Model)
+	require.NotNil(t, cmd)
+	require.NotNil(t, m.archiveState.inventory)
+
+	state := m.archiveState
+	updated, _ = m.Update(disableWorkflowsNextRepo(state.inventory, state.repos, 0, state)())
+	m = updated.(Model)
+	assert.Zero(t, m.repos[0].CronWorkflows)
+	assert.Contains(t, m.renderWorkflows(m.repos[0]), "(disabled manually)")
+	assert.Equal(t, []string{"gh", "workflow", "disable", "7", "-R", "acme/widget"}, executor.GetCall(executor.CallCount()-1))
+
+	changes, err := s.GetChangesByAction("acme", "workflows_disabled", 10)
+	require.NoError(t, err)
+	assert.Len(t, changes, 1)
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"log/slog"
+	"time"
+)
+
+// loadHumanActivity loads the recorded last human commit of each repository
+// and applies it to the loaded repos.
+func (m *Model) loadHumanActivity() {
+	if m.store == nil {
+		return
+	}
+	activities, err := m.store.GetRepoActivities(m.owner)
+	if err != nil {
+		slog.Warn("failed to load repo activity", "component", "tui", "error", err)
+		return
+	}
+
+	m.humanActivity = make(map[string]time.Time, len(activities))
+	for _, a := range activities {
+		if !a.LastHumanActivity.IsZero() {
+			m.humanActivity[a.Owner+"/"+a.RepoName] = a.LastHumanActivity
+		}
+	}
+	m.applyHumanActivity()
+}
+
+// applyHumanActivity sets LastHumanActivity on repos that lack it, such as
+// repos freshly fetched from GitHub by a sync.
+func (m *Model) applyHumanActivity() {
+	for i := range m.repos {
+		if t, ok := m.humanActivity[m.repos[i].FullName()]; ok && m.repos[i].LastHumanActivity.IsZero() {
+			m.repos[i].LastHumanActivity = t
+		}
+	}
+}
@@ -0,0 +1,267 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/llbbl/repjan/internal/analyze"
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+// aiRequestTimeout bounds one repository's analysis.
+const aiRequestTimeout = 2 * time.Minute
+
+// aiResult is the outcome of analyzing one repository.
+type aiResult struct {
+	repoName string
+	result   string
+	verdict  *analyze.Verdict
+	cached   bool
+	err      error
+}
+
+// aiState tracks an AI analysis run over one or more repositories.
+type aiState struct {
+	repos   []github.Repository
+	results []aiResult
+	scroll  int // first visible line in the analysis modal
+}
+
+// done reports whether every repository has been analyzed.
+func (s *aiState) done() bool {
+	return len(s.results) >= len(s.repos)
+}
+
+// SetAnalyzer sets the AI backend used for repository analysis, replacing
+// the Fabric analyzer enabled by --fabric.
+func (m *Model) SetAnalyzer(a analyze.Analyzer) {
+	m.analyzer = a
+}
+
+// startAIAnalysis opens the analysis modal and analyzes repos one at a time.
+// repos must not be empty.
+func (m *Model) startAIAnalysis(repos []github.Repository) tea.Cmd {
+	if m.analyzer == nil {
+		m.statusMessage = "AI analysis is disabled (start with --fabric or set REPJAN_AI_BACKEND)"
+		return nil
+	}
+	m.aiState = &aiState{repos: repos}
+	m.activeModal = ModalAnalysis
+	return tea.Batch(m.analyzeRepoCmd(repos[0]), m.syncSpinner.Tick)
+}
+
+// analyzeRepoCmd returns a command that analyzes repo, reusing a cached
+// result for the same push when one exists. Structured verdicts are parsed
+// and stored; other output is shown as raw text.
+func (m Model) analyzeRepoCmd(repo github.Repository) tea.Cmd {
+	analyzer := m.analyzer
+	s := m.store
+	var fetcher analyze.ReadmeFetcher
+	if m.client != nil {
+		fetcher = m.client
+	}
+
+	return func() tea.Msg {
+		name := repo.FullName()
+		if s != nil {
+			cached, err := s.GetAIAnalysis(repo.Owner, repo.Name, repo.PushedAt, analyzer.Name())
+			if err == nil {
+				return FabricResultMsg{RepoName: name, Result: cached.Result, Verdict: parseVerdict(name, cached.Result), Cached: true}
+			}
+			if !errors.Is(err, store.ErrNotFound) {
+				slog.Warn("failed to load cached analysis", "component", "tui", "repo", name, "error", err)
+			}
+		}
+
+		readme, _, err := analyze.NewReadmeAnalyzer(fetcher, s).Content(repo, false)
+		if err != nil {
+			slog.Warn("analyzing without readme", "component", "tui", "repo", name, "error", err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), aiRequestTimeout)
+		defer cancel()
+		result, err := analyzer.AnalyzeRepo(ctx, repo, readme)
+		if err != nil {
+			return FabricResultMsg{RepoName: name, Err: err}
+		}
+
+		verdict := parseVerdict(name, result)
+		if s != nil {
+			if err := s.SaveAIAnalysis(repo.Owner, repo.Name, repo.PushedAt, analyzer.Name(), result); err != nil {
+				slog.Warn("failed to cache analysis", "component", "tui", "repo", name, "error", err)
+			}
+			if verdict != nil {
+				if err := analyze.SaveVerdict(s, repo, analyzer.Name(), *verdict); err != nil {
+					slog.Warn("failed to save verdict", "component", "tui", "repo", name, "error", err)
+				}
+			}
+		}
+		return FabricResultMsg{RepoName: name, Result: result, Verdict: verdict}
+	}
+}
+
+// parseVerdict parses a structured verdict from analysis output, returning
+// nil when the output is free text.
+func parseVerdict(repoName, result string) *analyze.Verdict {
+	v, err := analyze.ParseVerdict(result)
+	if err != nil {
+		slog.Debug("analysis is not a structured verdict", "component", "tui", "repo", repoName, "error", err)
+		return nil
+	}
+	return &v
+}
+
+// loadVerdicts loads stored AI verdicts so they apply from startup.
+func (m *Model) loadVerdicts() {
+	if m.store == nil {
+		return
+	}
+	verdicts, err := analyze.LoadVerdicts(m.store, m.owner)
+	if err != nil {
+		slog.Warn("failed to load verdicts", "component", "tui", "error", err)
+		return
+	}
+	for name, v := range verdicts {
+		m.verdicts[name] = v
+	}
+}
+
+// formatVerdict renders a verdict as lines for the analysis modal.
+func formatVerdict(v analyze.Verdict) []string {
+	lines := []string{fmt.Sprintf("Verdict:     %s (%.0f%% confidence)", v.Decision, v.Confidence*100)}
+	if v.Rationale != "" {
+		lines = append(lines, "Rationale:   "+v.Rationale)
+	}
+	if v.Replacement != "" {
+		lines = append(lines, "Replacement: "+v.Replacement)
+	}
+	return lines
+}
+
+// handleAIResult records an analysis result and starts the next repository.
+func (m *Model) handleAIResult(msg FabricResultMsg) tea.Cmd {
+	if msg.Err != nil {
+		m.lastError = msg.Err
+	}
+	if msg.Verdict != nil {
+		m.verdicts[msg.RepoName] = *msg.Verdict
+		if m.verdictFilter != "" {
+			m.RefreshFilteredRepos()
+		}
+	}
+	// Results arriving after the modal was closed are dropped
+	if m.aiState == nil || m.aiState.done() || m.aiState.repos[len(m.aiState.results)].FullName() != msg.RepoName {
+		return nil
+	}
+
+	m.aiState.results = append(m.aiState.results, aiResult{
+		repoName: msg.RepoName,
+		result:   strings.TrimSpace(msg.Result),
+		verdict:  msg.Verdict,
+		cached:   msg.Cached,
+		err:      msg.Err,
+	})
+	if m.aiState.done() {
+		m.statusMessage = fmt.Sprintf("Analyzed %d repo%s", len(m.aiState.repos), pluralize(len(m.aiState.repos)))
+		return nil
+	}
+	return m.analyzeRepoCmd(m.aiState.repos[len(m.aiState.results)])
+}
+
+// handleAnalysisModalKeys scrolls and closes the analysis modal.
+func (m Model) handleAnalysisModalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.aiState == nil {
+		m.activeModal = ModalNone
+		return m, nil
+	}
+
+	maxScroll := max(len(m.analysisLines())-m.analysisHeight(), 0)
+	switch msg.String() {
+	case "j", "down":
+		m.aiState.scroll = min(m.aiState.scroll+1, maxScroll)
+	case "k", "up":
+		m.aiState.scroll = max(m.aiState.scroll-1, 0)
+	case "pgdown", "ctrl+d":
+		m.aiState.scroll = min(m.aiState.scroll+m.analysisHeight(), maxScroll)
+	case "pgup", "ctrl+u":
+		m.aiState.scroll = max(m.aiState.scroll-m.analysisHeight(), 0)
+	case "esc", "q", "enter":
+		// Closing abandons any repos not yet analyzed
+		m.aiState = nil
+		m.activeModal = ModalNone
+	}
+	return m, nil
+}
+
+// analysisHeight returns how many result lines fit in the analysis modal.
+func (m Model) analysisHeight() int {
+	return max(m.height-12, 5)
+}
+
+// analysisLines returns the rendered result lines for the analysis modal.
+func (m Model) analysisLines() []string {
+	if m.aiState == nil {
+		return nil
+	}
+
+	var lines []string
+	for _, r := range m.aiState.results {
+		header := r.repoName
+		if r.cached {
+			header += " (cached)"
+		}
+		lines = append(lines, m.styles.ModalTitle.Render(header))
+		if r.err != nil {
+			lines = append(lines, m.styles.Warning.Render(fmt.Sprintf("Analysis failed: %v", r.err)))
+		} else if r.verdict != nil {
+			lines = append(lines, formatVerdict(*r.verdict)...)
+		} else {
+			lines = append(lines, strings.Split(r.result, "\n")...)
+		}
+		lines = append(lines, "")
+	}
+	return lines
+}
+
+// renderAnalysisModal renders progress and scrollable results of an AI analysis.
+func (m Model) renderAnalysisModal() string {
+	if m.aiState == nil {
+		return ""
+	}
+	state := m.aiState
+
+	var lines []string
+	lines = append(lines, m.styles.ModalTitle.Render(fmt.Sprintf("AI Analysis (%s)", m.analyzer.Name())))
+	lines = append(lines, strings.Repeat("-", 60))
+
+	if !state.done() {
+		current := state.repos[len(state.results)].FullName()
+		lines = append(lines, fmt.Sprintf("%s Analyzing %s (%d/%d)", m.syncSpinner.View(), current, len(state.results)+1, len(state.repos)))
+		lines = append(lines, "")
+	}
+
+	body := m.analysisLines()
+	end := min(state.scroll+m.analysisHeight(), len(body))
+	lines = append(lines, body[min(state.scroll, end):end]...)
+
+	lines = append(lines, strings.Repeat("-", 60))
+	hint := "j/k: Scroll  Esc: Close"
+	if len(body) > m.analysisHeight() {
+		hint = fmt.Sprintf("Lines %d-%d of %d  %s", state.scroll+1, end, len(body), hint)
+	}
+	lines = append(lines, m.styles.HelpDesc.Render(hint))
+
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	return m.styles.ModalBorder.Width(min(max(m.width-4, 40), 100)).Render(content)
+}
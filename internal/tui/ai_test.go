@@ -0,0 +1,218 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/analyze"
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+// fakeAnalyzer returns canned results and records which repos it analyzed.
+type fakeAnalyzer struct {
+	calls  []string
+	output string // returned instead of the default free-text result when set
+	err    error
+}
+
+func (f *fakeAnalyzer) Name() string { return "fake" }
+
+func (f *fakeAnalyzer) AnalyzeRepo(ctx context.Context, repo github.Repository, readme string) (string, error) {
+	f.calls = append(f.calls, repo.FullName())
+	if f.err != nil {
+		return "", f.err
+	}
+	if f.output != "" {
+		return f.output, nil
+	}
+	return "ARCHIVE " + repo.Name + "\nno activity", nil
+}
+
+func (f *fakeAnalyzer) AnalyzeRepos(ctx context.Context, repos []github.Repository) (string, error) {
+	return "", errors.New("not used")
+}
+
+// newAITestModel returns a model with an in-memory store and a fake analyzer.
+func newAITestModel(t *testing.T, repos []github.Repository) (Model, *fakeAnalyzer) {
+	t.Helper()
+
+	s := testutil.NewStore(t)
+	for _, r := range repos {
+		// Cache READMEs so the model never needs a GitHub client
+		require.NoError(t, s.SaveReadme(r.Owner, r.Name, "# "+r.Name))
+	}
+
+	m := NewModelWithStore(repos, "acme", nil, s, false, "", nil)
+	m.width, m.height = 120, 40
+	analyzer := &fakeAnalyzer{}
+	m.SetAnalyzer(analyzer)
+	return m, analyzer
+}
+
+// runAnalysis drives the analysis commands until the run finishes.
+func runAnalysis(t *testing.T, m Model, repos []github.Repository) Model {
+	t.Helper()
+
+	cmd := m.analyzeRepoCmd(repos[0])
+	for cmd != nil {
+		updated, next := m.Update(cmd())
+		m = updated.(Model)
+		cmd = next
+	}
+	return m
+}
+
+func TestAIAnalysis_BatchMarkedRepos(t *testing.T) {
+	repos := []github.Repository{
+		testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("one")),
+		testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("two")),
+	}
+	m, analyzer := newAITestModel(t, repos)
+	m.marked["acme/one"] = true
+	m.marked["acme/two"] = true
+
+	updated, cmd := m.handleMainViewKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("I")})
+	m = updated.(Model)
+	require.NotNil(t, cmd)
+	require.Equal(t, ModalAnalysis, m.activeModal)
+	assert.Contains(t, m.renderAnalysisModal(), "(1/2)")
+
+	m = runAnalysis(t, m, m.aiState.repos)
+
+	assert.ElementsMatch(t, []string{"acme/one", "acme/two"}, analyzer.calls)
+	assert.True(t, m.aiState.done())
+	view := m.renderAnalysisModal()
+	assert.NotContains(t, view, "Analyzing")
+	assert.Contains(t, view, "ARCHIVE one")
+	assert.Contains(t, view, "ARCHIVE two")
+	assert.Equal(t, "Analyzed 2 repos", m.statusMessage)
+}
+
+func TestAIAnalysis_UsesCacheForSamePush(t *testing.T) {
+	repo := testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("one"))
+	m, analyzer := newAITestModel(t, []github.Repository{repo})
+
+	m.startAIAnalysis([]github.Repository{repo})
+	m = runAnalysis(t, m, []github.Repository{repo})
+	require.Len(t, analyzer.calls, 1)
+
+	// Re-opening the analysis reuses the stored result
+	m.startAIAnalysis([]github.Repository{repo})
+	m = runAnalysis(t, m, []github.Repository{repo})
+	assert.Len(t, analyzer.calls, 1)
+	assert.Contains(t, m.renderAnalysisModal(), "acme/one (cached)")
+
+	// A new push invalidates the cached result
+	repo.PushedAt = repo.PushedAt.Add(24 * time.Hour)
+	m.startAIAnalysis([]github.Repository{repo})
+	m = runAnalysis(t, m, []github.Repository{repo})
+	assert.Len(t, analyzer.calls, 2)
+}
+
+func TestAIAnalysis_Error(t *testing.T) {
+	repo := testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("one"))
+	m, analyzer := newAITestModel(t, []github.Repository{repo})
+	analyzer.err = errors.New("backend unavailable")
+
+	m.startAIAnalysis([]github.Repository{repo})
+	m = runAnalysis(t, m, []github.Repository{repo})
+
+	assert.Contains(t, m.renderAnalysisModal(), "Analysis failed: backend unavailable")
+	assert.Equal(t, analyzer.err, m.lastError)
+}
+
+func TestAIAnalysis_Disabled(t *testing.T) {
+	repo := testutil.NewTestRepo()
+	m := NewModel([]github.Repository{repo}, "acme", nil, false, "", nil)
+
+	updated, cmd := m.handleMainViewKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("i")})
+	m = updated.(Model)
+
+	assert.Nil(t, cmd)
+	assert.Equal(t, ModalNone, m.activeModal)
+	assert.Contains(t, m.statusMessage, "AI analysis is disabled")
+}
+
+func TestAnalysisModal_ScrollAndClose(t *testing.T) {
+	repo := testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("one"))
+	m, _ := newAITestModel(t, []github.Repository{repo})
+	m.height = 10 // room for 5 result lines
+
+	m.startAIAnalysis([]github.Repository{repo})
+	m.aiState.results = []aiResult{{repoName: "acme/one", result: "1\n2\n3\n4\n5\n6\n7\n8"}}
+
+	for range 10 {
+		updated, _ := m.handleAnalysisModalKeys(tea.KeyMsg{Type: tea.KeyDown})
+		m = updated.(Model)
+	}
+	// 10 lines (header, 8 result lines, blank) with 5 visible
+	assert.Equal(t, 5, m.aiState.scroll)
+	assert.Contains(t, m.renderAnalysisModal(), "Lines 6-10 of 10")
+
+	updated, _ := m.handleAnalysisModalKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(Model)
+	assert.Nil(t, m.aiState)
+	assert.Equal(t, ModalNone, m.activeModal)
+
+	// Late results after closing are ignored
+	assert.Nil(t, m.handleAIResult(FabricResultMsg{RepoName: "acme/one", Result: "late"}))
+}
+
+func TestAIAnalysis_StructuredVerdict(t *testing.T) {
+	repos := []github.Repository{
+		testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("one"), testutil.WithStars(50), testutil.WithDaysInactive(10)),
+		testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("two"), testutil.WithStars(50), testutil.WithDaysInactive(10)),
+	}
+	m, analyzer := newAITestModel(t, repos)
+	analyzer.output = "```json\n" + `{"verdict": "archive", "confidence": 0.9, "rationale": "Superseded.", "replacement": "acme/three"}` + "\n```"
+
+	m.startAIAnalysis(repos[:1])
+	m = runAnalysis(t, m, repos[:1])
+
+	view := m.renderAnalysisModal()
+	assert.Contains(t, view, "Verdict:     archive (90% confidence)")
+	assert.Contains(t, view, "Replacement: acme/three")
+	assert.NotContains(t, view, "```")
+
+	// The verdict shows in the table and feeds the heuristics
+	assert.Contains(t, m.renderTableBody(), "archive")
+	assert.Contains(t, m.assess(repos[0]).Codes(), string(analyze.ReasonAIArchive))
+
+	// The verdict filter cycles through decisions
+	m.CycleVerdictFilter()
+	assert.Equal(t, analyze.DecisionArchive, m.verdictFilter)
+	require.Len(t, m.filteredRepos, 1)
+	assert.Equal(t, "one", m.filteredRepos[0].Name)
+	m.CycleVerdictFilter()
+	assert.Empty(t, m.filteredRepos)
+	m.CycleVerdictFilter()
+	m.CycleVerdictFilter()
+	assert.Equal(t, analyze.Decision(""), m.verdictFilter)
+	assert.Len(t, m.filteredRepos, 2)
+
+	// Stored verdicts are loaded by a new model
+	reloaded := NewModelWithStore(repos, "acme", nil, m.store, false, "", nil)
+	assert.Equal(t, analyze.DecisionArchive, reloaded.verdicts["acme/one"].Decision)
+}
+
+func TestAIAnalysis_FreeTextFallsBack(t *testing.T) {
+	repo := testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("one"))
+	m, analyzer := newAITestModel(t, []github.Repository{repo})
+	analyzer.output = "I would keep this one {probably}."
+
+	m.startAIAnalysis([]github.Repository{repo})
+	m = runAnalysis(t, m, []github.Repository{repo})
+
+	assert.Contains(t, m.renderAnalysisModal(), "I would keep this one {probably}.")
+	assert.Empty(t, m.verdicts)
+}
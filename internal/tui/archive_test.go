@@ -7,6 +7,7 @@ import (
 	"errors"
 	"testing"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -223,7 +224,7 @@ func TestMarkRepoAsArchived_NonexistentRepo(t *testing.T) {
 }
 
 // TestClearArchivedMarks verifies that clearArchivedMarks removes marks
-// from repos that have been archived.
+// from the repos the batch archived.
 func TestClearArchivedMarks(t *testing.T) {
 	repo1 := testutil.NewTestRepo(testutil.WithOwner("owner1"), testutil.WithName("repo1"), testutil.WithArchived(true))
 	repo2 := testutil.NewTestRepo(testutil.WithOwner("owner2"), testutil.WithName("repo2"), testutil.WithArchived(false))
@@ -232,19 +233,18 @@ func TestClearArchivedMarks(t *testing.T) {
 	m := &Model{
 		repos: []github.Repository{repo1, repo2, repo3},
 		marked: map[string]bool{
-			"owner1/repo1": true, // archived - should be cleared
+			"owner1/repo1": true, // archived by the batch - should be cleared
 			"owner2/repo2": true, // not archived - should remain
-			"owner3/repo3": true, // archived - should be cleared
+			"owner3/repo3": true, // already archived - should remain
 		},
 	}
 
-	m.clearArchivedMarks()
+	m.clearArchivedMarks([]string{"owner1/repo1"})
 
-	// Only the non-archived repo should still be marked
 	assert.False(t, m.marked["owner1/repo1"], "archived repo1 mark should be cleared")
 	assert.True(t, m.marked["owner2/repo2"], "non-archived repo2 mark should remain")
-	assert.False(t, m.marked["owner3/repo3"], "archived repo3 mark should be cleared")
-	assert.Len(t, m.marked, 1, "only 1 mark should remain")
+	assert.True(t, m.marked["owner3/repo3"], "repo3 was not part of the batch")
+	assert.Len(t, m.marked, 2, "2 marks should remain")
 }
 
 // TestClearArchivedMarks_NoMarks verifies clearArchivedMarks handles empty
@@ -257,10 +257,37 @@ func TestClearArchivedMarks_NoMarks(t *testing.T) {
 	}
 
 	// Should not panic
-	m.clearArchivedMarks()
+	m.clearArchivedMarks(nil)
 	assert.Empty(t, m.marked)
 }
 
+// TestClearArchivedMarks_RecordsChange verifies that archived repos are recorded
+// in the change history when a store is available.
+func TestClearArchivedMarks_RecordsChange(t *testing.T) {
+	s := testutil.NewStore(t)
+
+	repo := testutil.NewTestRepo(testutil.WithOwner("owner"), testutil.WithName("repo1"), testutil.WithArchived(true))
+	old := testutil.NewTestRepo(testutil.WithOwner("owner"), testutil.WithName("repo2"), testutil.WithArchived(true))
+	m := &Model{
+		owner:  "owner",
+		repos:  []github.Repository{repo, old},
+		marked: map[string]bool{"owner/repo1": true, "owner/repo2": true},
+		store:  s,
+	}
+
+	m.clearArchivedMarks([]string{"owner/repo1"})
+
+	changes, err := s.GetRepoHistory("owner", "repo1", 10)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "archived", changes[0].Action)
+
+	// An already archived repo outside the batch gets no new event
+	changes, err = s.GetRepoHistory("owner", "repo2", 10)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
 // TestArchiveProgressMsg_UpdatesModel verifies that the Update function
 // correctly handles ArchiveProgressMsg.
 func TestArchiveProgressMsg_UpdatesModel(t *testing.T) {
@@ -307,6 +334,7 @@ func TestArchiveCompleteMsg_UpdatesModel(t *testing.T) {
 		archiving:       true,
 		archiveProgress: 1,
 		archiveTotal:    1,
+		archiveMode:     batchArchive,
 		archiveState: &archiveState{
 			repos:     []github.Repository{repo},
 			succeeded: 1,
@@ -319,6 +347,7 @@ func TestArchiveCompleteMsg_UpdatesModel(t *testing.T) {
 		Succeeded: 1,
 		Failed:    0,
 		Errors:    nil,
+		Done:      []string{"owner/repo"},
 	}
 
 	newModel, _ := m.Update(completeMsg)
@@ -337,6 +366,24 @@ func TestArchiveCompleteMsg_UpdatesModel(t *testing.T) {
 	assert.Equal(t, "Successfully archived 1 repo", updated.statusMessage)
 }
 
+// TestArchiveCompleteMsg_KeepsMarksWhenNotArchiving verifies that batches
+// which don't archive leave the marks of already archived repos alone.
+func TestArchiveCompleteMsg_KeepsMarksWhenNotArchiving(t *testing.T) {
+	repo := testutil.NewTestRepo(testutil.WithOwner("owner"), testutil.WithName("repo"), testutil.WithArchived(true))
+	for _, mode := range []batchMode{batchSchedule, batchTransfer, batchDelete} {
+		m := Model{
+			repos:       []github.Repository{repo},
+			marked:      map[string]bool{"owner/repo": true},
+			archiving:   true,
+			archiveMode: mode,
+			styles:      DefaultStyles(),
+		}
+
+		newModel, _ := m.Update(ArchiveCompleteMsg{Succeeded: 1, Failed: 1})
+		assert.True(t, newModel.(Model).marked["owner/repo"], mode.String())
+	}
+}
+
 // TestArchiveCompleteMsg_WithFailures verifies the status message when
 // some archives fail.
 func TestArchiveCompleteMsg_WithFailures(t *testing.T) {
@@ -543,3 +590,150 @@ func TestArchiveWorkflow_MultipleRepos(t *testing.T) {
 	// Verify executor was called for both repos
 	assert.Equal(t, 2, mockExec.CallCount())
 }
+
+// TestStartBatch_DispatchesUpToWorkers verifies that startBatch dispatches
+// no more repos than the configured worker count.
+func TestStartBatch_DispatchesUpToWorkers(t *testing.T) {
+	repos := []github.Repository{
+		testutil.NewTestRepo(testutil.WithName("repo1")),
+		testutil.NewTestRepo(testutil.WithName("repo2")),
+		testutil.NewTestRepo(testutil.WithName("repo3")),
+		testutil.NewTestRepo(testutil.WithName("repo4")),
+	}
+
+	m := &Model{
+		repos:        repos,
+		marked:       make(map[string]bool),
+		client:       github.NewClient(testutil.NewMockExecutor()),
+		batchWorkers: 3,
+	}
+
+	cmd := m.startBatch(repos)
+	require.NotNil(t, cmd)
+	assert.Equal(t, 3, m.archiveState.next, "three repos should be dispatched")
+	assert.Equal(t, 3, m.archiveState.inFlight())
+}
+
+// TestArchiveProgressMsg_DispatchesNextRepo verifies that a finished worker
+// slot is refilled with the next queued repo.
+func TestArchiveProgressMsg_DispatchesNextRepo(t *testing.T) {
+	repos := []github.Repository{
+		testutil.NewTestRepo(testutil.WithOwner("owner"), testutil.WithName("repo1")),
+		testutil.NewTestRepo(testutil.WithOwner("owner"), testutil.WithName("repo2")),
+		testutil.NewTestRepo(testutil.WithOwner("owner"), testutil.WithName("repo3")),
+	}
+
+	m := Model{
+		repos:     repos,
+		marked:    make(map[string]bool),
+		client:    github.NewClient(testutil.NewMockExecutor()),
+		archiving: true,
+		archiveState: &archiveState{
+			repos: repos,
+			next:  2, // two workers in flight
+		},
+		styles: DefaultStyles(),
+	}
+
+	newModel, cmd := m.Update(ArchiveProgressMsg{Current: 1, Total: 3, RepoName: "owner/repo1"})
+	updated := newModel.(Model)
+
+	require.NotNil(t, cmd, "expected next repo to be dispatched")
+	assert.Equal(t, 3, updated.archiveState.next)
+	assert.Equal(t, 1, updated.archiveProgress)
+
+	msg := cmd()
+	progress, ok := msg.(ArchiveProgressMsg)
+	require.True(t, ok, "expected ArchiveProgressMsg, got %T", msg)
+	assert.Equal(t, "owner/repo3", progress.RepoName)
+}
+
+// TestArchiveProgressMsg_CancelStopsDispatch verifies that no new repos are
+// dispatched after cancel and completion waits for in-flight operations.
+func TestArchiveProgressMsg_CancelStopsDispatch(t *testing.T) {
+	repos := []github.Repository{
+		testutil.NewTestRepo(testutil.WithOwner("owner"), testutil.WithName("repo1")),
+		testutil.NewTestRepo(testutil.WithOwner("owner"), testutil.WithName("repo2")),
+		testutil.NewTestRepo(testutil.WithOwner("owner"), testutil.WithName("repo3")),
+		testutil.NewTestRepo(testutil.WithOwner("owner"), testutil.WithName("repo4")),
+	}
+
+	m := Model{
+		repos:       repos,
+		marked:      make(map[string]bool),
+		archiving:   true,
+		activeModal: ModalProgress,
+		archiveState: &archiveState{
+			repos:     repos,
+			next:      2,
+			succeeded: 2,
+		},
+		styles: DefaultStyles(),
+	}
+
+	// Cancel from the progress modal
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	m = newModel.(Model)
+	require.True(t, m.archiveState.cancelled)
+
+	// First in-flight repo finishes: nothing new is dispatched
+	newModel, cmd := m.Update(ArchiveProgressMsg{Current: 1, Total: 4, RepoName: "owner/repo1"})
+	m = newModel.(Model)
+	assert.Nil(t, cmd)
+	assert.Equal(t, 2, m.archiveState.next)
+
+	// Second in-flight repo finishes: operation completes
+	newModel, cmd = m.Update(ArchiveProgressMsg{Current: 2, Total: 4, RepoName: "owner/repo2"})
+	require.NotNil(t, cmd)
+
+	complete, ok := cmd().(ArchiveCompleteMsg)
+	require.True(t, ok)
+	assert.True(t, complete.Cancelled)
+	assert.Equal(t, 2, complete.Skipped)
+
+	newModel, _ = newModel.(Model).Update(complete)
+	updated := newModel.(Model)
+	assert.False(t, updated.archiving)
+	assert.Equal(t, ModalNone, updated.activeModal)
+	assert.Equal(t, "Archive cancelled: 2 succeeded, 0 failed, 2 skipped", updated.statusMessage)
+}
+
+// TestArchiveCompleteMsg_ShowsResultsAndRetries verifies that failures open
+// the results modal and that retry re-runs only the failed repos.
+func TestArchiveCompleteMsg_ShowsResultsAndRetries(t *testing.T) {
+	ok1 := testutil.NewTestRepo(testutil.WithOwner("owner"), testutil.WithName("ok1"), testutil.WithArchived(true))
+	bad := testutil.NewTestRepo(testutil.WithOwner("owner"), testutil.WithName("bad"))
+
+	mockExec := testutil.NewMockExecutor()
+	client := github.NewClient(mockExec)
+
+	m := Model{
+		repos:       []github.Repository{ok1, bad},
+		marked:      map[string]bool{"owner/ok1": true, "owner/bad": true},
+		client:      client,
+		archiving:   true,
+		activeModal: ModalProgress,
+		archiveMode: batchArchive,
+		styles:      DefaultStyles(),
+	}
+
+	failures := []ArchiveFailure{{Repo: bad, Err: errors.New("boom")}}
+	newModel, _ := m.Update(ArchiveCompleteMsg{Succeeded: 1, Failed: 1, Failures: failures, Done: []string{"owner/ok1"}})
+	m = newModel.(Model)
+
+	assert.Equal(t, ModalResults, m.activeModal)
+	assert.Len(t, m.batchFailures, 1)
+	assert.Contains(t, m.renderResultsModal(), "owner/bad")
+
+	newModel, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	m = newModel.(Model)
+	require.NotNil(t, cmd)
+	assert.Equal(t, ModalProgress, m.activeModal)
+	assert.True(t, m.archiving)
+	require.NotNil(t, m.archiveState)
+	require.Len(t, m.archiveState.repos, 1)
+	assert.Equal(t, "owner/bad", m.archiveState.repos[0].FullName())
+
+	cmd()
+	assert.Equal(t, []string{"gh", "repo", "archive", "owner/bad", "--yes"}, mockExec.GetCall(0))
+}
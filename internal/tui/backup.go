@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/llbbl/repjan/internal/backup"
+	"github.com/llbbl/repjan/internal/github"
+)
+
+// SetBackup sets the backer used to back repos up before deleting them and
+// by the backup step of the archive workflow.
+func (m *Model) SetBackup(b *backup.Backer) {
+	m.backer = b
+}
+
+// loadBackup reads the backup metadata of repo for the detail modal.
+func (m *Model) loadBackup(repo github.Repository) {
+	if m.backer == nil {
+		return
+	}
+	if m.backups == nil {
+		m.backups = make(map[string]backup.Metadata)
+	}
+	meta, err := backup.Load(m.backer.Dir(), repo.Owner, repo.Name)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			slog.Warn("failed to load backup metadata", "component", "tui", "repo", repo.FullName(), "error", err)
+		}
+		delete(m.backups, repo.FullName())
+		return
+	}
+	m.backups[repo.FullName()] = meta
+}
+
+// renderBackup renders the backup line of the detail modal.
+func (m Model) renderBackup(repo github.Repository) string {
+	if m.backer == nil {
+		return ""
+	}
+	meta, ok := m.backups[repo.FullName()]
+	if !ok {
+		return "  Backup:        none\n"
+	}
+	return fmt.Sprintf("  Backup:        %s, %s (%d refs, %d open issues)\n",
+		meta.Format, meta.CreatedAt.Local().Format("2006-01-02"), meta.Refs, meta.Issues.Open)
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/backup"
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+func TestDetailModal_BackupStatus(t *testing.T) {
+	remote := testutil.NewGitRemote(t)
+	mockExec := testutil.NewMockExecutor()
+	mockExec.ExecuteFunc = func(name string, args ...string) ([]byte, error) {
+		return []byte(`[{"number":3,"title":"Broken build","state":"OPEN"}]`), nil
+	}
+	client := github.NewClient(mockExec)
+	backer := backup.NewBacker(client, t.TempDir(), "")
+	backer.SetRemoteURL(func(owner, name string) string { return remote })
+
+	repos := []github.Repository{
+		testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("widget")),
+		testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("gadget")),
+	}
+	m := NewModel(repos, "acme", client, false, "", nil)
+	m.SetBackup(backer)
+	_, err := backer.Backup(repos[0])
+	require.NoError(t, err)
+
+	open := func(index int) string {
+		m.cursor = index
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		m = updated.(Model)
+		require.Equal(t, ModalDetail, m.activeModal)
+		out := m.renderBackup(*m.selectedRepo)
+		m.activeModal = ModalNone
+		return out
+	}
+	assert.Regexp(t, `Backup:\s+mirror, \d{4}-\d\d-\d\d \(2 refs, 1 open issues\)`, open(repoIndex(m, "widget")))
+	assert.Contains(t, open(repoIndex(m, "gadget")), "Backup:        none")
+}
+
+// repoIndex returns the position of the repo named name in the filtered list.
+func repoIndex(m Model, name string) int {
+	for i, repo := range m.filteredRepos {
+		if repo.Name == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/llbbl/repjan/internal/actions"
+	"github.com/llbbl/repjan/internal/deletion"
+	"github.com/llbbl/repjan/internal/edit"
+	"github.com/llbbl/repjan/internal/schedule"
+	"github.com/llbbl/repjan/internal/transfer"
+	"github.com/llbbl/repjan/internal/visibility"
+)
+
+// batchMode is the operation a batch runs on the marked repos.
+type batchMode int
+
+const (
+	batchArchive batchMode = iota
+	batchUnarchive
+	batchSchedule
+	batchTransfer
+	batchDelete
+	batchVisibility
+	batchEdit
+	batchWorkflows
+)
+
+// String returns the mode name used in logs.
+func (b batchMode) String() string {
+	return b.op().name
+}
+
+// batchOp describes how a batch mode starts, processes each repo and reports.
+type batchOp struct {
+	name     string // used in logs
+	action   string // noun in status messages, e.g. "Transfer"
+	progress string // verb in the progress title, e.g. "Transferring"
+	doneVerb string // what the succeeded count is called next to failures
+
+	// start begins the batch on the marked repos once it is confirmed.
+	start func(m *Model) tea.Cmd
+	// prepare sets up the worker state of the mode, if it needs any.
+	prepare func(m *Model, state *archiveState)
+	// next returns the command processing the repo at index.
+	next func(m *Model, state *archiveState, index int) tea.Cmd
+	// succeeded updates the model after the operation succeeded on a repo.
+	succeeded func(m *Model, fullName string)
+	// done returns the status message of a batch without failures.
+	done func(m *Model, succeeded int) string
+	// finish runs when the batch completes, whatever its outcome; may be nil.
+	finish func(m *Model)
+}
+
+// op returns the handlers of the batch mode.
+func (b batchMode) op() batchOp {
+	switch b {
+	case batchArchive:
+		return batchOp{
+			name: "archive", action: "Archive", progress: "Archiving", doneVerb: "succeeded",
+			start: (*Model).archiveMarkedRepos,
+			next: func(m *Model, state *archiveState, index int) tea.Cmd {
+				if state.runner != nil {
+					return runWorkflowStep(state.runner, state.repos[index], index, 0)
+				}
+				return archiveNextRepo(m.client, state.repos, index, state)
+			},
+			succeeded: (*Model).markRepoAsArchived,
+			done: func(m *Model, n int) string {
+				return fmt.Sprintf("Successfully archived %d repo%s", n, pluralize(n))
+			},
+		}
+	case batchUnarchive:
+		return batchOp{
+			name: "unarchive", action: "Unarchive", progress: "Unarchiving", doneVerb: "succeeded",
+			start: (*Model).unarchiveMarkedRepos,
+			next: func(m *Model, state *archiveState, index int) tea.Cmd {
+				return unarchiveNextRepo(m.client, state.repos, index, state)
+			},
+			succeeded: (*Model).markRepoAsUnarchived,
+			done: func(m *Model, n int) string {
+				return fmt.Sprintf("Successfully unarchived %d repo%s", n, pluralize(n))
+			},
+		}
+	case batchSchedule:
+		return batchOp{
+			name: "schedule", action: "Scheduling", progress: "Scheduling", doneVerb: "scheduled",
+			start: (*Model).scheduleMarkedRepos,
+			prepare: func(m *Model, state *archiveState) {
+				state.scheduler = schedule.New(m.client, m.store, nil)
+				state.archiveOn = m.scheduleDate()
+			},
+			next: func(m *Model, state *archiveState, index int) tea.Cmd {
+				return scheduleNextRepo(state.scheduler, state.repos, index, state.archiveOn, state)
+			},
+			// Scheduled repos stay active until apply-due archives them
+			succeeded: func(*Model, string) {},
+			done: func(m *Model, n int) string {
+				return fmt.Sprintf("Scheduled %d repo%s for archive on %s; run 'repjan apply-due' after that date",
+					n, pluralize(n), m.scheduleDate().Format("2006-01-02"))
+			},
+			finish: (*Model).loadSchedules,
+		}
+	case batchTransfer:
+		return batchOp{
+			name: "transfer", action: "Transfer", progress: "Transferring", doneVerb: "succeeded",
+			start: (*Model).transferMarkedRepos,
+			prepare: func(m *Model, state *archiveState) {
+				state.mover = transfer.New(m.client, m.store)
+			},
+			next: func(m *Model, state *archiveState, index int) tea.Cmd {
+				return transferNextRepo(state.mover, state.repos, index, m.graveyardOwner, m.graveyardArchive, state)
+			},
+			succeeded: (*Model).removeRepo,
+			done: func(m *Model, n int) string {
+				return fmt.Sprintf("Transferred %d repo%s to %s", n, pluralize(n), m.graveyardOwner)
+			},
+		}
+	case batchDelete:
+		return batchOp{
+			name: "delete", action: "Deletion", progress: "Deleting", doneVerb: "deleted",
+			start: (*Model).deleteMarkedRepos,
+			prepare: func(m *Model, state *archiveState) {
+				state.deleter = deletion.New(m.client, m.store, m.backer)
+			},
+			next: func(m *Model, state *archiveState, index int) tea.Cmd {
+				return deleteNextRepo(state.deleter, state.repos, index, state)
+			},
+			succeeded: (*Model).removeRepo,
+			done: func(m *Model, n int) string {
+				return fmt.Sprintf("Deleted %d repo%s; backups are in %s", n, pluralize(n), m.backer.Dir())
+			},
+		}
+	case batchVisibility:
+		return batchOp{
+			name: "visibility", action: "Visibility change", progress: "Changing Visibility of", doneVerb: "succeeded",
+			start: (*Model).changeVisibilityOfMarkedRepos,
+			prepare: func(m *Model, state *archiveState) {
+				state.changer = visibility.New(m.client, m.store)
+			},
+			next: func(m *Model, state *archiveState, index int) tea.Cmd {
+				return visibilityNextRepo(state.changer, state.repos, index, m.makePrivate, state)
+			},
+			succeeded: func(m *Model, fullName string) {
+				m.markRepoVisibility(fullName, m.makePrivate)
+			},
+			done: func(m *Model, n int) string {
+				return fmt.Sprintf("Made %d repo%s %s", n, pluralize(n), visibility.Name(m.makePrivate))
+			},
+		}
+	case batchEdit:
+		return batchOp{
+			name: "edit", action: "Edit", progress: "Editing", doneVerb: "succeeded",
+			start: func(m *Model) tea.Cmd {
+				return m.startBatch(m.getMarkedRepos())
+			},
+			prepare: func(m *Model, state *archiveState) {
+				state.editor = edit.New(m.client, m.store)
+			},
+			next: func(m *Model, state *archiveState, index int) tea.Cmd {
+				return editNextRepo(state.editor, state.repos, index, m.editing, state)
+			},
+			succeeded: (*Model).markRepoEdited,
+			done: func(m *Model, n int) string {
+				return fmt.Sprintf("Applied %s to %d repo%s", m.editing, n, pluralize(n))
+			},
+		}
+	case batchWorkflows:
+		return batchOp{
+			name: "workflows", action: "Disabling workflows", progress: "Disabling Workflows of", doneVerb: "succeeded",
+			start: (*Model).disableWorkflowsOfMarkedRepos,
+			prepare: func(m *Model, state *archiveState) {
+				state.inventory = actions.New(m.client, m.store)
+			},
+			next: func(m *Model, state *archiveState, index int) tea.Cmd {
+				return disableWorkflowsNextRepo(state.inventory, state.repos, index, state)
+			},
+			succeeded: (*Model).markWorkflowsDisabled,
+			done: func(m *Model, n int) string {
+				return fmt.Sprintf("Disabled the scheduled workflows of %d repo%s", n, pluralize(n))
+			},
+		}
+	}
+	panic(fmt.Sprintf("unknown batch mode %d", int(b)))
+}
+
+// archives reports whether a batch in this mode archives or unarchives
+// repos: an archive or unarchive, or a transfer that archives afterwards.
+func (b batchMode) archives(graveyardArchive bool) bool {
+	switch b {
+	case batchArchive, batchUnarchive:
+		return true
+	case batchTransfer:
+		return graveyardArchive
+	}
+	return false
+}
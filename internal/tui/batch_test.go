@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestBatchModes verifies that every batch mode has its handlers and a
+// distinct name.
+func TestBatchModes(t *testing.T) {
+	names := map[string]bool{}
+	for mode := batchArchive; mode <= batchWorkflows; mode++ {
+		op := mode.op()
+		assert.NotEmpty(t, op.name)
+		assert.False(t, names[op.name], "duplicate name %q", op.name)
+		names[op.name] = true
+		assert.NotEmpty(t, op.action, op.name)
+		assert.NotEmpty(t, op.progress, op.name)
+		assert.NotEmpty(t, op.doneVerb, op.name)
+		assert.NotNil(t, op.start, op.name)
+		assert.NotNil(t, op.next, op.name)
+		assert.NotNil(t, op.succeeded, op.name)
+		assert.NotNil(t, op.done, op.name)
+	}
+	assert.Panics(t, func() { batchMode(-1).op() })
+
+	assert.True(t, batchArchive.archives(false))
+	assert.True(t, batchUnarchive.archives(false))
+	assert.True(t, batchTransfer.archives(true))
+	assert.False(t, batchTransfer.archives(false))
+	assert.False(t, batchSchedule.archives(true))
+}
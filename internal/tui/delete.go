@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"log/slog"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/llbbl/repjan/internal/deletion"
+	"github.com/llbbl/repjan/internal/github"
+)
+
+// SetDeletion enables or disables deleting marked repos. Each one is backed
+// up with the backer set by SetBackup first.
+func (m *Model) SetDeletion(allow bool) {
+	m.allowDelete = allow
+}
+
+// handleDeleteConfirmKeys reads the typed count that confirms a deletion.
+// Only the exact number of marked repos followed by enter starts it.
+func (m Model) handleDeleteConfirmKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.activeModal = ModalNone
+		m.statusMessage = "Deletion cancelled"
+		return m, nil
+	case tea.KeyBackspace:
+		if len(m.deleteInput) > 0 {
+			m.deleteInput = m.deleteInput[:len(m.deleteInput)-1]
+		}
+		return m, nil
+	case tea.KeyEnter:
+		if err := deletion.CheckConfirmation(m.deleteInput, len(m.getMarkedRepos())); err != nil {
+			m.deleteInput = ""
+			m.statusMessage = "Type the number of marked repos to confirm"
+			return m, nil
+		}
+		m.activeModal = ModalProgress
+		cmd := m.deleteMarkedRepos()
+		if cmd == nil {
+			m.activeModal = ModalNone
+		}
+		return m, cmd
+	case tea.KeyRunes:
+		for _, r := range msg.Runes {
+			if r >= '0' && r <= '9' && len(m.deleteInput) < 6 {
+				m.deleteInput += string(r)
+			}
+		}
+	}
+	return m, nil
+}
+
+// deleteMarkedRepos starts a batch backing up and deleting each marked repo.
+func (m *Model) deleteMarkedRepos() tea.Cmd {
+	repos := m.getMarkedRepos()
+	if len(repos) == 0 || m.store == nil {
+		m.statusMessage = "Deleting needs marked repos and a database"
+		return nil
+	}
+	return m.startBatch(repos)
+}
+
+// deleteNextRepo returns a command backing up and deleting the repository at current.
+func deleteNextRepo(deleter *deletion.Deleter, repos []github.Repository, current int, state *archiveState) tea.Cmd {
+	return func() tea.Msg {
+		repo := repos[current]
+		b, err := deleter.Delete(repo)
+		if err != nil {
+			slog.Debug("deletion failed", "component", "tui", "repo", repo.FullName(), "err", err)
+		} else {
+			slog.Info("deleted repository", "component", "tui", "repo", repo.FullName(), "backup", b.Path)
+		}
+		state.recordResult(repo, err)
+
+		return ArchiveProgressMsg{
+			Current:  current + 1,
+			Total:    len(repos),
+			RepoName: repo.FullName(),
+			Err:      err,
+		}
+	}
+}
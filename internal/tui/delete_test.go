@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/backup"
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+func TestDeleteConfirmation(t *testing.T) {
+	repos := []github.Repository{
+		testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("junk")),
+		testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("hackathon")),
+	}
+	client := github.NewClient(testutil.NewMockExecutor())
+	m := NewModelWithStore(repos, "acme", client, testutil.NewStore(t), false, "", nil)
+	m.SetBackup(backup.NewBacker(client, t.TempDir(), ""))
+	m.marked["acme/junk"] = true
+	m.marked["acme/hackathon"] = true
+	press := func(key tea.KeyMsg) tea.Cmd {
+		updated, cmd := m.Update(key)
+		m = updated.(Model)
+		return cmd
+	}
+	runes := func(s string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)} }
+
+	// Deleting is disabled unless allowed
+	press(runes("D"))
+	assert.Equal(t, ModalNone, m.activeModal)
+	assert.Contains(t, m.statusMessage, "REPJAN_ALLOW_DELETE")
+
+	m.SetDeletion(true)
+	press(runes("D"))
+	require.Equal(t, ModalConfirm, m.activeModal)
+	assert.Contains(t, m.renderConfirmModal(), "permanently delete 2 repos")
+
+	// "y" is not a confirmation, and a wrong count is rejected
+	assert.Nil(t, press(runes("y")))
+	assert.Nil(t, press(runes("3")))
+	assert.Contains(t, m.renderConfirmModal(), "Type 2 to confirm: 3")
+	assert.Nil(t, press(tea.KeyMsg{Type: tea.KeyEnter}))
+	assert.Equal(t, ModalConfirm, m.activeModal)
+	assert.Empty(t, m.deleteInput)
+
+	press(runes("2"))
+	cmd := press(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	assert.Equal(t, ModalProgress, m.activeModal)
+	assert.Contains(t, m.renderProgressModal(), "Deleting")
+	assert.NotNil(t, m.archiveState.deleter)
+}
+
+func TestDeleteConfirmation_Cancel(t *testing.T) {
+	repos := []github.Repository{testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("junk"))}
+	m := NewModel(repos, "acme", nil, false, "", nil)
+	m.SetBackup(backup.NewBacker(nil, t.TempDir(), ""))
+	m.SetDeletion(true)
+	m.marked["acme/junk"] = true
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("D")})
+	m = updated.(Model)
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(Model)
+	assert.Equal(t, ModalNone, m.activeModal)
+	assert.Equal(t, "Deletion cancelled", m.statusMessage)
+}
@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/llbbl/repjan/internal/edit"
+	"github.com/llbbl/repjan/internal/github"
+)
+
+// Edit modal fields, in focus order.
+const (
+	editFieldKind = iota
+	editFieldValue
+	editFieldCount
+)
+
+// editOptions holds the selections made in the edit modal.
+type editOptions struct {
+	kindIdx int    // selected index into edit.Kinds
+	value   string // topic, description or prefix
+	field   int    // focused field
+	err     string // validation error shown in the modal
+}
+
+// edit returns the edit described by the options.
+func (o editOptions) edit() edit.Edit {
+	return edit.Edit{Kind: edit.Kinds[o.kindIdx], Value: strings.TrimSpace(o.value)}
+}
+
+// openEditModal resets the edit options and opens the edit modal for the
+// marked repos.
+func (m *Model) openEditModal() {
+	if len(m.marked) == 0 {
+		m.statusMessage = "Mark repos to edit their topics or description"
+		return
+	}
+	m.editOpts = editOptions{field: editFieldValue}
+	m.activeModal = ModalEdit
+}
+
+// handleEditModalKeys handles key input for the edit modal.
+func (m Model) handleEditModalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	opts := &m.editOpts
+
+	switch msg.Type {
+	case tea.KeyEscape:
+		m.activeModal = ModalNone
+		m.statusMessage = "Edit cancelled"
+		return m, nil
+
+	case tea.KeyEnter:
+		e := opts.edit()
+		if err := e.Validate(); err != nil {
+			opts.err = err.Error()
+			return m, nil
+		}
+		m.editing = e
+		m.archiveMode = batchEdit
+		m.activeModal = ModalProgress
+		return m, m.startBatch(m.getMarkedRepos())
+
+	case tea.KeyTab, tea.KeyDown, tea.KeyShiftTab, tea.KeyUp:
+		opts.field = (opts.field + 1) % editFieldCount
+		return m, nil
+	}
+
+	if opts.field == editFieldKind {
+		switch msg.String() {
+		case "left", "h":
+			opts.kindIdx = (opts.kindIdx + len(edit.Kinds) - 1) % len(edit.Kinds)
+		case "right", "l":
+			opts.kindIdx = (opts.kindIdx + 1) % len(edit.Kinds)
+		case "q":
+			m.activeModal = ModalNone
+		}
+		opts.err = ""
+		return m, nil
+	}
+
+	switch msg.Type {
+	case tea.KeyBackspace:
+		if len(opts.value) > 0 {
+			runes := []rune(opts.value)
+			opts.value = string(runes[:len(runes)-1])
+		}
+	case tea.KeyRunes:
+		opts.value += string(msg.Runes)
+	case tea.KeySpace:
+		opts.value += " "
+	}
+	opts.err = ""
+	return m, nil
+}
+
+// renderEditModal renders the topic and description edit modal.
+func (m Model) renderEditModal() string {
+	opts := m.editOpts
+	count := len(m.getMarkedRepos())
+
+	var lines []string
+	lines = append(lines, m.styles.ModalTitle.Render(fmt.Sprintf("Edit %d Repo%s", count, pluralize(count))))
+	lines = append(lines, strings.Repeat("-", 50))
+
+	renderField := func(field int, label, value string) string {
+		cursor := "  "
+		style := m.styles.ModalContent
+		if opts.field == field {
+			cursor = "> "
+			style = m.styles.ActiveFilter
+		}
+		return style.Render(fmt.Sprintf("%s%-8s %s", cursor, label, value))
+	}
+
+	value := opts.value
+	if opts.field == editFieldValue {
+		value += "_"
+	}
+	lines = append(lines, renderField(editFieldKind, "Action:", fmt.Sprintf("< %s >", edit.Kinds[opts.kindIdx])))
+	lines = append(lines, renderField(editFieldValue, "Value:", value))
+	if opts.err != "" {
+		lines = append(lines, m.styles.Error.Render(opts.err))
+	}
+
+	lines = append(lines, strings.Repeat("-", 50))
+	lines = append(lines, m.styles.HelpDesc.Render("Tab: Field  Left/Right: Action  Enter: Apply  Esc: Cancel"))
+
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	return m.styles.ModalBorder.Render(content)
+}
+
+// markRepoEdited applies the running edit to a repo in the model. The store
+// was updated by the edit itself.
+func (m *Model) markRepoEdited(fullName string) {
+	for i := range m.repos {
+		if m.repos[i].FullName() == fullName {
+			m.editing.ApplyTo(&m.repos[i])
+			break
+		}
+	}
+	m.RefreshFilteredRepos()
+}
+
+// editNextRepo returns a command applying e to the repository at current.
+func editNextRepo(editor *edit.Editor, repos []github.Repository, current int, e edit.Edit, state *archiveState) tea.Cmd {
+	return func() tea.Msg {
+		repo := repos[current]
+		_, err := editor.Apply(repo, e)
+		if err != nil {
+			slog.Debug("edit failed", "component", "tui", "repo", repo.FullName(), "err", err)
+		}
+		state.recordResult(repo, err)
+
+		return ArchiveProgressMsg{
+			Current:  current + 1,
+			Total:    len(repos),
+			RepoName: repo.FullName(),
+			Err:      err,
+		}
+	}
+}
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+func TestEditModal(t *testing.T) {
+	repos := []github.Repository{
+		testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("widget"), testutil.WithDescription("A widget")),
+	}
+	s := testutil.NewStore(t)
+	require.NoError(t, s.UpsertRepositories("acme", repos))
+	executor := testutil.NewMockExecutor()
+	executor.ExecuteFunc = func(name string, args ...string) ([]byte, error) {
+		if args[1] == "view" {
+			return []byte(`{"description": "A widget", "topics": []}`), nil
+		}
+		return nil, nil
+	}
+	m := NewModelWithStore(repos, "acme", github.NewClient(executor), s, false, "", nil)
+	press := func(msg tea.KeyMsg) tea.Cmd {
+		updated, cmd := m.Update(msg)
+		m = updated.(Model)
+		return cmd
+	}
+	runes := func(s string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)} }
+
+	press(runes("E"))
+	assert.Equal(t, ModalNone, m.activeModal)
+	assert.Contains(t, m.statusMessage, "Mark repos")
+
+	m.marked["acme/widget"] = true
+	press(runes("E"))
+	require.Equal(t, ModalEdit, m.activeModal)
+
+	// An invalid topic keeps the modal open with the error
+	press(runes("Old"))
+	assert.Nil(t, press(tea.KeyMsg{Type: tea.KeyEnter}))
+	assert.Equal(t, ModalEdit, m.activeModal)
+	assert.Contains(t, m.renderEditModal(), "invalid topic")
+
+	// Switch to prefixing the description
+	press(tea.KeyMsg{Type: tea.KeyTab})
+	press(tea.KeyMsg{Type: tea.KeyLeft})
+	assert.Contains(t, m.renderEditModal(), "< Prefix description >")
+	press(tea.KeyMsg{Type: tea.KeyTab})
+	for range 3 {
+		press(tea.KeyMsg{Type: tea.KeyBackspace})
+	}
+	press(runes("[DEPRECATED]"))
+	require.NotNil(t, press(tea.KeyMsg{Type: tea.KeyEnter}))
+	assert.Equal(t, ModalProgress, m.activeModal)
+	assert.Contains(t, m.renderProgressModal(), "Editing")
+	require.NotNil(t, m.archiveState.editor)
+
+	state := m.archiveState
+	updated, _ := m.Update(editNextRepo(state.editor, state.repos, 0, m.editing, state)())
+	m = updated.(Model)
+	assert.Equal(t, "[DEPRECATED] A widget", m.repos[0].Description)
+	stored, err := s.GetRepository("acme", "widget")
+	require.NoError(t, err)
+	assert.Equal(t, "[DEPRECATED] A widget", stored.Description)
+	assert.True(t, m.marked["acme/widget"])
+}
@@ -0,0 +1,271 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/llbbl/repjan/internal/analyze"
+	"github.com/llbbl/repjan/internal/export"
+	"github.com/llbbl/repjan/internal/github"
+)
+
+// ExportScope selects which repositories the TUI export includes.
+type ExportScope int
+
+const (
+	ExportScopeMarked ExportScope = iota
+	ExportScopeVisible
+	ExportScopeAll
+)
+
+// exportScopeNames are the display names for each ExportScope, in order.
+var exportScopeNames = []string{"Marked", "Visible", "All"}
+
+// Export modal fields, in focus order.
+const (
+	exportFieldFormat = iota
+	exportFieldScope
+	exportFieldDestination
+	exportFieldCount
+)
+
+// exportOptions holds the selections made in the export modal.
+type exportOptions struct {
+	formats     []string    // available export formats
+	formatIdx   int         // selected index into formats
+	scope       ExportScope // which repos to export
+	destination string      // output path; empty means a timestamped file
+	field       int         // focused field
+}
+
+// format returns the selected export format name.
+func (o exportOptions) format() string {
+	if o.formatIdx < 0 || o.formatIdx >= len(o.formats) {
+		return "json"
+	}
+	return o.formats[o.formatIdx]
+}
+
+// ExportCompleteMsg is sent when an export started from the TUI finishes.
+type ExportCompleteMsg struct {
+	Path  string
+	Count int
+	Err   error
+}
+
+// templatePrefix marks built-in template entries in the export format list.
+const templatePrefix = "template:"
+
+// exportFormats returns the export formats followed by the built-in templates.
+func exportFormats() []string {
+	formats := export.Formats()
+	for _, name := range export.BuiltinTemplates() {
+		formats = append(formats, templatePrefix+name)
+	}
+	return formats
+}
+
+// openExportModal resets the export options and opens the export modal.
+// Marked repos are the default scope when any exist, otherwise visible repos.
+func (m *Model) openExportModal() {
+	formats := exportFormats()
+	formatIdx := 0
+	for i, f := range formats {
+		if f == "json" {
+			formatIdx = i
+		}
+	}
+
+	scope := ExportScopeVisible
+	if len(m.marked) > 0 {
+		scope = ExportScopeMarked
+	}
+
+	m.exportOpts = exportOptions{
+		formats:   formats,
+		formatIdx: formatIdx,
+		scope:     scope,
+	}
+	m.activeModal = ModalExport
+}
+
+// handleExportModalKeys handles key input for the export modal.
+func (m Model) handleExportModalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	opts := &m.exportOpts
+
+	switch msg.Type {
+	case tea.KeyEscape:
+		m.activeModal = ModalNone
+		return m, nil
+
+	case tea.KeyEnter:
+		m.activeModal = ModalNone
+		return m, m.runExport()
+
+	case tea.KeyTab, tea.KeyDown:
+		opts.field = (opts.field + 1) % exportFieldCount
+		return m, nil
+
+	case tea.KeyShiftTab, tea.KeyUp:
+		opts.field = (opts.field + exportFieldCount - 1) % exportFieldCount
+		return m, nil
+
+	case tea.KeyLeft:
+		opts.cycle(-1)
+		return m, nil
+
+	case tea.KeyRight:
+		opts.cycle(1)
+		return m, nil
+	}
+
+	// Remaining keys edit the destination path when it is focused
+	if opts.field == exportFieldDestination {
+		switch msg.Type {
+		case tea.KeyBackspace:
+			if len(opts.destination) > 0 {
+				runes := []rune(opts.destination)
+				opts.destination = string(runes[:len(runes)-1])
+			}
+		case tea.KeyRunes:
+			opts.destination += string(msg.Runes)
+		case tea.KeySpace:
+			opts.destination += " "
+		}
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "h":
+		opts.cycle(-1)
+	case "l":
+		opts.cycle(1)
+	case "q":
+		m.activeModal = ModalNone
+	}
+
+	return m, nil
+}
+
+// cycle moves the selection of the focused option field by delta, wrapping around.
+func (o *exportOptions) cycle(delta int) {
+	switch o.field {
+	case exportFieldFormat:
+		if len(o.formats) > 0 {
+			o.formatIdx = (o.formatIdx + delta + len(o.formats)) % len(o.formats)
+		}
+	case exportFieldScope:
+		n := len(exportScopeNames)
+		o.scope = ExportScope((int(o.scope) + delta + n) % n)
+	}
+}
+
+// exportRepos returns the repositories included in the given export scope.
+func (m Model) exportRepos(scope ExportScope) []github.Repository {
+	switch scope {
+	case ExportScopeMarked:
+		return m.getMarkedRepos()
+	case ExportScopeVisible:
+		return m.filteredRepos
+	default:
+		return m.repos
+	}
+}
+
+// runExport returns a command that writes the selected repos using the export package.
+func (m *Model) runExport() tea.Cmd {
+	opts := m.exportOpts
+	repos := m.exportRepos(opts.scope)
+	if len(repos) == 0 {
+		m.statusMessage = fmt.Sprintf("No repositories to export (%s)", strings.ToLower(exportScopeNames[opts.scope]))
+		return nil
+	}
+
+	m.statusMessage = fmt.Sprintf("Exporting %d repo%s as %s...", len(repos), pluralize(len(repos)), opts.format())
+	owner := m.owner
+	format := opts.format()
+	destination := strings.TrimSpace(opts.destination)
+	repoStore := m.store
+
+	return func() tea.Msg {
+		slog.Debug("exporting repositories",
+			"component", "tui",
+			"format", format,
+			"count", len(repos),
+			"destination", destination,
+		)
+
+		var assessor *analyze.Assessor
+		if repoStore != nil {
+			var err error
+			if assessor, err = analyze.LoadAssessor(repoStore, owner); err != nil {
+				return ExportCompleteMsg{Count: len(repos), Err: err}
+			}
+		}
+
+		name, isTemplate := strings.CutPrefix(format, templatePrefix)
+		if !isTemplate {
+			path, err := export.ExportTo(repos, owner, assessor, format, destination)
+			return ExportCompleteMsg{Path: path, Count: len(repos), Err: err}
+		}
+
+		exporter, err := export.BuiltinTemplate(name)
+		if err != nil {
+			return ExportCompleteMsg{Count: len(repos), Err: err}
+		}
+		if repoStore != nil {
+			exporter.History, err = export.LoadHistory(repoStore, owner, repos)
+			if err != nil {
+				return ExportCompleteMsg{Count: len(repos), Err: err}
+			}
+		}
+		path, err := export.WriteData(exporter, export.BuildExportData(repos, owner, assessor), destination)
+		return ExportCompleteMsg{Path: path, Count: len(repos), Err: err}
+	}
+}
+
+// renderExportModal renders the export options modal.
+func (m Model) renderExportModal() string {
+	opts := m.exportOpts
+
+	var lines []string
+	lines = append(lines, m.styles.ModalTitle.Render("Export Repositories"))
+	lines = append(lines, strings.Repeat("-", 50))
+
+	renderField := func(field int, label, value string) string {
+		cursor := "  "
+		style := m.styles.ModalContent
+		if opts.field == field {
+			cursor = "> "
+			style = m.styles.ActiveFilter
+		}
+		return style.Render(fmt.Sprintf("%s%-13s %s", cursor, label, value))
+	}
+
+	scopeName := exportScopeNames[opts.scope]
+	scopeCount := len(m.exportRepos(opts.scope))
+
+	destination := opts.destination
+	if opts.field == exportFieldDestination {
+		destination += "_"
+	} else if destination == "" {
+		destination = "(timestamped file in current directory)"
+	}
+
+	lines = append(lines, renderField(exportFieldFormat, "Format:", fmt.Sprintf("< %s >", opts.format())))
+	lines = append(lines, renderField(exportFieldScope, "Scope:", fmt.Sprintf("< %s > (%d repo%s)", scopeName, scopeCount, pluralize(scopeCount))))
+	lines = append(lines, renderField(exportFieldDestination, "Destination:", destination))
+
+	lines = append(lines, strings.Repeat("-", 50))
+	lines = append(lines, m.styles.HelpDesc.Render("Tab/Up/Dn: Field  Left/Right: Change  Enter: Export  Esc: Cancel"))
+
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	return m.styles.ModalBorder.Render(content)
+}
@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+// TestOpenExportModal_DefaultScope verifies the modal defaults to marked repos
+// when any are marked and to visible repos otherwise.
+func TestOpenExportModal_DefaultScope(t *testing.T) {
+	repo := testutil.NewTestRepo(testutil.WithOwner("owner"), testutil.WithName("repo"))
+
+	m := &Model{repos: []github.Repository{repo}, marked: make(map[string]bool)}
+	m.openExportModal()
+	assert.Equal(t, ModalExport, m.activeModal)
+	assert.Equal(t, ExportScopeVisible, m.exportOpts.scope)
+	assert.Equal(t, "json", m.exportOpts.format())
+
+	m.marked["owner/repo"] = true
+	m.openExportModal()
+	assert.Equal(t, ExportScopeMarked, m.exportOpts.scope)
+}
+
+// TestHandleExportModalKeys_Navigation verifies field focus, option cycling and
+// destination editing.
+func TestHandleExportModalKeys_Navigation(t *testing.T) {
+	m := &Model{marked: make(map[string]bool)}
+	m.openExportModal()
+	startFormat := m.exportOpts.formatIdx
+
+	result, _ := m.handleExportModalKeys(tea.KeyMsg{Type: tea.KeyRight})
+	model := result.(Model)
+	assert.Equal(t, (startFormat+1)%len(model.exportOpts.formats), model.exportOpts.formatIdx)
+
+	result, _ = model.handleExportModalKeys(tea.KeyMsg{Type: tea.KeyTab})
+	model = result.(Model)
+	assert.Equal(t, exportFieldScope, model.exportOpts.field)
+
+	result, _ = model.handleExportModalKeys(tea.KeyMsg{Type: tea.KeyRight})
+	model = result.(Model)
+	assert.Equal(t, ExportScopeAll, model.exportOpts.scope)
+
+	result, _ = model.handleExportModalKeys(tea.KeyMsg{Type: tea.KeyTab})
+	model = result.(Model)
+	assert.Equal(t, exportFieldDestination, model.exportOpts.field)
+
+	// Letters are typed into the destination rather than treated as shortcuts
+	result, _ = model.handleExportModalKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("out.ql")})
+	model = result.(Model)
+	result, _ = model.handleExportModalKeys(tea.KeyMsg{Type: tea.KeyBackspace})
+	model = result.(Model)
+	assert.Equal(t, "out.q", model.exportOpts.destination)
+	assert.Equal(t, ModalExport, model.activeModal)
+
+	result, _ = model.handleExportModalKeys(tea.KeyMsg{Type: tea.KeyEscape})
+	model = result.(Model)
+	assert.Equal(t, ModalNone, model.activeModal)
+}
+
+// TestRunExport_WritesFile verifies that confirming the modal writes the selected
+// scope in the selected format and reports the path.
+func TestRunExport_WritesFile(t *testing.T) {
+	repo1 := testutil.NewTestRepo(testutil.WithOwner("owner"), testutil.WithName("repo1"))
+	repo2 := testutil.NewTestRepo(testutil.WithOwner("owner"), testutil.WithName("repo2"))
+	path := filepath.Join(t.TempDir(), "repos.csv")
+
+	m := &Model{
+		owner:         "owner",
+		repos:         []github.Repository{repo1, repo2},
+		filteredRepos: []github.Repository{repo2},
+		marked:        make(map[string]bool),
+	}
+	m.openExportModal()
+	m.exportOpts.formatIdx = indexOf(m.exportOpts.formats, "csv")
+	m.exportOpts.destination = path
+
+	result, cmd := m.handleExportModalKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	model := result.(Model)
+	assert.Equal(t, ModalNone, model.activeModal)
+	require.NotNil(t, cmd)
+
+	msg, ok := cmd().(ExportCompleteMsg)
+	require.True(t, ok, "expected ExportCompleteMsg")
+	require.NoError(t, msg.Err)
+	assert.Equal(t, path, msg.Path)
+	assert.Equal(t, 1, msg.Count)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "repo2")
+	assert.NotContains(t, string(data), "repo1")
+
+	updated, _ := model.Update(msg)
+	assert.Contains(t, updated.(Model).statusMessage, path)
+}
+
+// TestRunExport_BuiltinTemplate verifies that built-in templates are offered as
+// formats and rendered through the export package.
+func TestRunExport_BuiltinTemplate(t *testing.T) {
+	repo := testutil.NewTestRepo(testutil.WithOwner("owner"), testutil.WithName("repo1"))
+	path := filepath.Join(t.TempDir(), "email.txt")
+
+	m := &Model{
+		owner:  "owner",
+		repos:  []github.Repository{repo},
+		marked: map[string]bool{"owner/repo1": true},
+	}
+	m.openExportModal()
+	idx := indexOf(m.exportOpts.formats, "template:email")
+	require.NotEqual(t, -1, idx, "expected built-in email template in formats")
+	m.exportOpts.formatIdx = idx
+	m.exportOpts.destination = path
+
+	cmd := m.runExport()
+	require.NotNil(t, cmd)
+	msg := cmd().(ExportCompleteMsg)
+	require.NoError(t, msg.Err)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "Subject: Planned archive of 1 owner repository")
+	assert.Contains(t, string(data), "owner/repo1")
+}
+
+// TestRunExport_EmptyScope verifies that exporting an empty scope reports a status
+// message instead of writing a file.
+func TestRunExport_EmptyScope(t *testing.T) {
+	m := &Model{marked: make(map[string]bool)}
+	m.openExportModal()
+	m.exportOpts.scope = ExportScopeMarked
+
+	cmd := m.runExport()
+	assert.Nil(t, cmd)
+	assert.Contains(t, m.statusMessage, "No repositories to export")
+}
+
+// TestExportCompleteMsg_Error verifies that export failures surface in the status bar.
+func TestExportCompleteMsg_Error(t *testing.T) {
+	m := Model{marked: make(map[string]bool)}
+
+	updated, _ := m.Update(ExportCompleteMsg{Err: errors.New("disk full")})
+	model := updated.(Model)
+	assert.EqualError(t, model.lastError, "disk full")
+	assert.Contains(t, model.statusMessage, "Export failed")
+}
+
+func indexOf(values []string, want string) int {
+	for i, v := range values {
+		if v == want {
+			return i
+		}
+	}
+	return -1
+}
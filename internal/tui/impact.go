@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"fmt"
+	"sort"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/llbbl/repjan/internal/analyze"
+	"github.com/llbbl/repjan/internal/github"
+)
+
+// maxImpactLines is the maximum number of impact items shown in the confirm modal.
+const maxImpactLines = 10
+
+// ImpactCheckedMsg is sent when the archive impact of the marked repos is known.
+type ImpactCheckedMsg struct {
+	Reports []analyze.ImpactReport
+}
+
+// checkImpact returns a command that checks what archiving repos would
+// affect, using up to batchWorkers concurrent lookups. It returns nil when
+// there is no GitHub client.
+func (m *Model) checkImpact(repos []github.Repository) tea.Cmd {
+	m.impacts = nil
+	if m.client == nil || len(repos) == 0 {
+		return nil
+	}
+	m.impactChecking = true
+
+	checker := analyze.NewImpactChecker(m.client, m.store)
+	workers := min(max(m.batchWorkers, 1), len(repos))
+	return func() tea.Msg {
+		sem := make(chan struct{}, workers)
+		results := make(chan analyze.ImpactReport, len(repos))
+		for _, repo := range repos {
+			go func() {
+				sem <- struct{}{}
+				defer func() { <-sem }()
+				results <- checker.Check(repo, false)
+			}()
+		}
+
+		reports := make([]analyze.ImpactReport, 0, len(repos))
+		for range repos {
+			reports = append(reports, <-results)
+		}
+		sort.Slice(reports, func(i, j int) bool { return reports[i].Repo < reports[j].Repo })
+		return ImpactCheckedMsg{Reports: reports}
+	}
+}
+
+// handleImpactChecked stores the impact reports for the confirm modal.
+func (m *Model) handleImpactChecked(msg ImpactCheckedMsg) {
+	m.impactChecking = false
+	m.impacts = make(map[string]analyze.ImpactReport, len(msg.Reports))
+	for _, r := range msg.Reports {
+		m.impacts[r.Repo] = r
+	}
+}
+
+// impactBlocking returns the number of blocking items across the marked repos.
+func (m Model) impactBlocking() int {
+	n := 0
+	for _, r := range m.impacts {
+		n += r.Blocking()
+	}
+	return n
+}
+
+// renderImpact renders the impact section of the archive confirm modal:
+// blocking items first, then warnings, then repos whose impact is unknown.
+func (m Model) renderImpact(repos []github.Repository) []string {
+	if m.impactChecking {
+		return []string{"Checking impact (PRs, releases, deployments, Pages, webhooks, packages)..."}
+	}
+	if m.impacts == nil {
+		return nil
+	}
+
+	var blocking, warnings, unknown []string
+	for _, repo := range repos {
+		r, ok := m.impacts[repo.FullName()]
+		if !ok {
+			continue
+		}
+		if r.Err != nil {
+			unknown = append(unknown, m.styles.Warning.Render(fmt.Sprintf("  ? %s: impact unknown (%v)", repo.Name, r.Err)))
+			continue
+		}
+		for _, item := range r.Items {
+			if item.Severity == analyze.ImpactBlocking {
+				blocking = append(blocking, m.styles.Error.Render(fmt.Sprintf("  ✖ %s: %s", repo.Name, item.Description)))
+			} else {
+				warnings = append(warnings, m.styles.Warning.Render(fmt.Sprintf("  ⚠ %s: %s", repo.Name, item.Description)))
+			}
+		}
+	}
+
+	if len(blocking)+len(warnings)+len(unknown) == 0 {
+		return []string{m.styles.Success.Render("Impact: nothing depends on these repos")}
+	}
+
+	lines := []string{fmt.Sprintf("Impact: %d blocking, %d warning%s", len(blocking), len(warnings), pluralize(len(warnings)))}
+	items := append(append(blocking, warnings...), unknown...)
+	for _, line := range items[:min(maxImpactLines, len(items))] {
+		lines = append(lines, line)
+	}
+	if len(items) > maxImpactLines {
+		lines = append(lines, fmt.Sprintf("  ... (%d more)", len(items)-maxImpactLines))
+	}
+	return lines
+}
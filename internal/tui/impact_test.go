@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+// impactClient returns a client whose repos were released releasedDaysAgo
+// and have one open pull request, no Pages site and no webhooks.
+func impactClient(releasedDaysAgo int) *github.Client {
+	released := time.Now().AddDate(0, 0, -releasedDaysAgo).UTC().Format(time.RFC3339)
+	mockExec := testutil.NewMockExecutor()
+	mockExec.ExecuteFunc = func(name string, args ...string) ([]byte, error) {
+		switch args[1] {
+		case "graphql":
+			return []byte(fmt.Sprintf(`{"openPullRequests":1,"latestRelease":"v2.0.0","latestReleaseAt":%q,"packages":[]}`, released)), nil
+		case "repos/acme/widget/pages":
+			return nil, errors.New("HTTP 404: Not Found")
+		default:
+			return []byte("0"), nil
+		}
+	}
+	return github.NewClient(mockExec)
+}
+
+func TestConfirmModal_ShowsImpact(t *testing.T) {
+	repo := testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("widget"))
+	m := NewModel([]github.Repository{repo}, "acme", impactClient(10), false, "", nil)
+	m.marked["acme/widget"] = true
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	m = updated.(Model)
+	require.NotNil(t, cmd)
+	assert.Equal(t, ModalConfirm, m.activeModal)
+	assert.Contains(t, m.renderConfirmModal(), "Checking impact")
+
+	// Confirming waits for the check
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	m = updated.(Model)
+	assert.Equal(t, ModalConfirm, m.activeModal)
+
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	modal := m.renderConfirmModal()
+	assert.Contains(t, modal, "Impact: 1 blocking, 1 warning")
+	assert.Contains(t, modal, "widget: Released v2.0.0 10 days ago")
+	assert.Contains(t, modal, "widget: 1 open pull request will be frozen")
+	assert.Contains(t, modal, "Archive anyway? [y/N]")
+
+	// Blocking impact needs an explicit yes
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	assert.Equal(t, ModalConfirm, m.activeModal)
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	m = updated.(Model)
+	assert.Equal(t, ModalProgress, m.activeModal)
+}
+
+func TestConfirmModal_NoBlockingImpact(t *testing.T) {
+	repo := testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("widget"))
+	m := NewModel([]github.Repository{repo}, "acme", impactClient(400), false, "", nil)
+	m.marked["acme/widget"] = true
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	m = updated.(Model)
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+
+	modal := m.renderConfirmModal()
+	assert.Contains(t, modal, "Impact: 0 blocking, 1 warning")
+	assert.Contains(t, modal, "Continue? [Y/n]")
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	assert.Equal(t, ModalProgress, m.activeModal)
+}
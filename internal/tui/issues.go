@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/llbbl/repjan/internal/github"
+)
+
+// openItemsLabel returns the number of open issues, pull requests and
+// discussions for the table, or "-" when they haven't been fetched.
+func openItemsLabel(repo github.Repository) string {
+	if repo.IssueActivity == nil {
+		return "-"
+	}
+	return strconv.Itoa(repo.IssueActivity.OpenItems())
+}
+
+// renderIssueActivity renders the open items and latest update for the
+// detail modal's activity section, or "" when they haven't been fetched.
+func renderIssueActivity(repo github.Repository) string {
+	a := repo.IssueActivity
+	if a == nil {
+		return ""
+	}
+
+	lastUpdate := "Never"
+	if days := a.DaysSinceUpdate(); days >= 0 {
+		lastUpdate = fmt.Sprintf("%s (%s)", formatDaysAgo(days), a.LastUpdated.Format("2006-01-02"))
+	}
+	return fmt.Sprintf("  Open Items:    %d issues, %d PRs, %d discussions\n  Last Issue:    %s\n",
+		a.OpenIssues, a.OpenPullRequests, a.OpenDiscussions, lastUpdate)
+}
@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+func TestIssueActivityDisplay(t *testing.T) {
+	repo := testutil.NewTestRepo()
+	assert.Equal(t, "-", openItemsLabel(repo))
+	assert.Empty(t, renderIssueActivity(repo))
+
+	repo.IssueActivity = &github.IssueActivity{
+		OpenIssues:       3,
+		OpenPullRequests: 1,
+		LastUpdated:      time.Now().AddDate(0, 0, -10),
+	}
+	assert.Equal(t, "4", openItemsLabel(repo))
+	detail := renderIssueActivity(repo)
+	assert.Contains(t, detail, "3 issues, 1 PRs, 0 discussions")
+	assert.Contains(t, detail, "1 week ago")
+
+	repo.IssueActivity = &github.IssueActivity{}
+	assert.Contains(t, renderIssueActivity(repo), "Last Issue:    Never")
+}
+
+func TestReposSyncedMsg_ActivityError(t *testing.T) {
+	m := NewModel(nil, "testowner", nil, false, "", nil)
+	repos := []github.Repository{testutil.NewTestRepo()}
+
+	updated, _ := m.Update(ReposSyncedMsg{Repos: repos, ActivityErr: errors.New("HTTP 502")})
+	assert.Equal(t, "Synced 1 repos; issue activity not updated: HTTP 502", updated.(Model).statusMessage)
+}
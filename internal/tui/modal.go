@@ -9,23 +9,96 @@ import (
 	"os/exec"
 	"runtime"
 	"strings"
+	"sync"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
-	"github.com/llbbl/repjan/internal/analyze"
+	"github.com/llbbl/repjan/internal/actions"
+	"github.com/llbbl/repjan/internal/deletion"
+	"github.com/llbbl/repjan/internal/edit"
 	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/schedule"
+	"github.com/llbbl/repjan/internal/transfer"
+	"github.com/llbbl/repjan/internal/visibility"
+	"github.com/llbbl/repjan/internal/workflow"
 )
 
 // maxReposToShow is the maximum number of repo names to display in the confirm modal.
 const maxReposToShow = 5
 
+// maxFailuresToShow is the maximum number of failures visible at once in the results modal.
+const maxFailuresToShow = 10
+
 // archiveState holds the state for an ongoing archive operation.
+// Counters written by worker commands are guarded by mu; next, handled and
+// cancelled are only touched from Update and need no locking.
 type archiveState struct {
+	mu        sync.Mutex
 	repos     []github.Repository
 	succeeded int
 	failed    int
 	errors    []error
+	failures  []ArchiveFailure
+	done      []string // full names of the repos that succeeded
+
+	next      int  // index of the next repo to dispatch
+	handled   int  // progress messages processed by Update
+	cancelled bool // no further repos are dispatched once set
+
+	runner      *workflow.Runner             // archive workflow, nil to archive directly
+	stepResults map[string][]workflow.Result // workflow step outcomes by owner/name
+
+	scheduler *schedule.Scheduler // set when scheduling archives instead of archiving
+	archiveOn time.Time           // date scheduled archives are due
+
+	mover   *transfer.Mover     // set when transferring repos instead of archiving
+	deleter *deletion.Deleter   // set when deleting repos instead of archiving
+	changer *visibility.Changer // set when changing visibility instead of archiving
+	editor  *edit.Editor        // set when editing topics or descriptions instead of archiving
+
+	inventory *actions.Inventory // set when disabling scheduled workflows instead of archiving
+}
+
+// recordResult records the outcome of a single repo operation.
+func (s *archiveState) recordResult(repo github.Repository, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err != nil {
+		s.failed++
+		s.errors = append(s.errors, fmt.Errorf("%s: %w", repo.FullName(), err))
+		s.failures = append(s.failures, ArchiveFailure{Repo: repo, Err: err})
+		return
+	}
+	s.succeeded++
+	s.done = append(s.done, repo.FullName())
+}
+
+// counts returns the current success and failure counts.
+func (s *archiveState) counts() (succeeded, failed int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.succeeded, s.failed
+}
+
+// completeMsg builds an ArchiveCompleteMsg from the accumulated results.
+func (s *archiveState) completeMsg() ArchiveCompleteMsg {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return ArchiveCompleteMsg{
+		Succeeded: s.succeeded,
+		Failed:    s.failed,
+		Errors:    s.errors,
+		Failures:  s.failures,
+		Done:      s.done,
+	}
+}
+
+// inFlight returns the number of dispatched repos whose results have not been handled yet.
+func (s *archiveState) inFlight() int {
+	return s.next - s.handled
 }
 
 // renderConfirmModal renders the archive/unarchive confirmation modal.
@@ -41,27 +114,49 @@ func (m Model) renderConfirmModal() string {
 	count := len(markedRepos)
 	if count == 0 {
 		action := "archive"
-		if m.archiveMode == "unarchive" {
+		if m.archiveMode == batchUnarchive {
 			action = "unarchive"
 		}
 		return m.styles.ModalBorder.Render(fmt.Sprintf("No repositories marked for %s", action))
 	}
 
-	// Build modal content
-	var lines []string
-
 	// Header - varies based on archiveMode
-	if m.archiveMode == "unarchive" {
-		lines = append(lines, m.styles.ModalTitle.Render("Unarchive Confirmation"))
-		lines = append(lines, strings.Repeat("-", 40))
-		lines = append(lines, "")
-		lines = append(lines, fmt.Sprintf("You are about to unarchive %d repo%s:", count, pluralize(count)))
-	} else {
-		lines = append(lines, m.styles.ModalTitle.Render("Archive Confirmation"))
-		lines = append(lines, strings.Repeat("-", 40))
-		lines = append(lines, "")
-		lines = append(lines, fmt.Sprintf("You are about to archive %d repo%s:", count, pluralize(count)))
+	var title, intro string
+	switch m.archiveMode {
+	case batchUnarchive:
+		title = "Unarchive Confirmation"
+		intro = fmt.Sprintf("You are about to unarchive %d repo%s:", count, pluralize(count))
+	case batchSchedule:
+		title = "Schedule Archive"
+		intro = fmt.Sprintf("You are about to announce the archive of %d repo%s on %s:",
+			count, pluralize(count), m.scheduleDate().Format("2006-01-02"))
+	case batchTransfer:
+		then := ""
+		if m.graveyardArchive {
+			then = " and archive them"
+		}
+		title = "Transfer Confirmation"
+		intro = fmt.Sprintf("You are about to transfer %d repo%s to %s%s:", count, pluralize(count), m.graveyardOwner, then)
+	case batchWorkflows:
+		title = "Disable Scheduled Workflows"
+		intro = fmt.Sprintf("You are about to disable the scheduled workflows of %d repo%s:", count, pluralize(count))
+	case batchVisibility:
+		title = "Visibility Confirmation"
+		intro = fmt.Sprintf("You are about to make %d repo%s %s:", count, pluralize(count), visibility.Name(m.makePrivate))
+	case batchDelete:
+		title = "Delete Confirmation"
+		intro = m.styles.Error.Render(fmt.Sprintf("You are about to permanently delete %d repo%s:", count, pluralize(count)))
+	default:
+		title = "Archive Confirmation"
+		intro = fmt.Sprintf("You are about to archive %d repo%s:", count, pluralize(count))
 	}
+
+	// Build modal content
+	var lines []string
+	lines = append(lines, m.styles.ModalTitle.Render(title))
+	lines = append(lines, strings.Repeat("-", 40))
+	lines = append(lines, "")
+	lines = append(lines, intro)
 	lines = append(lines, "")
 
 	// List first N repos
@@ -80,13 +175,120 @@ func (m Model) renderConfirmModal() string {
 		lines = append(lines, fmt.Sprintf("  ... (%d more)", remaining))
 	}
 
+	if m.usesWorkflow() && len(m.workflow) > 1 {
+		lines = append(lines, "")
+		lines = append(lines, "Workflow: "+strings.Join(stepNames(m.workflow), " → "))
+	}
+
+	var warning []string
+	switch m.archiveMode {
+	case batchArchive:
+		warning = m.renderImpact(markedRepos)
+	case batchVisibility:
+		warning = m.renderVisibilityWarning(markedRepos)
+	}
+	if len(warning) > 0 {
+		lines = append(lines, "")
+		lines = append(lines, warning...)
+	}
+
 	lines = append(lines, "")
-	lines = append(lines, "This action is reversible.")
+	switch {
+	case m.archiveMode == batchSchedule:
+		lines = append(lines, "A notice issue is opened on each repo. 'repjan apply-due' archives")
+		lines = append(lines, "them after that date unless someone objects or pushes.")
+	case m.archiveMode == batchTransfer:
+		lines = append(lines, "Transferred repos leave this list; their history moves with them.")
+	case m.archiveMode == batchDelete:
+		lines = append(lines, "Each repo is backed up to "+m.backer.Dir())
+		lines = append(lines, "and the backup verified before it is deleted. This cannot be undone.")
+	case m.archiveMode == batchWorkflows:
+		lines = append(lines, "Workflows are listed afresh first; other workflows keep running.")
+		lines = append(lines, "Disabled workflows can be enabled again on GitHub.")
+	case m.archiveMode == batchVisibility && m.makePrivate:
+		lines = append(lines, "Stars and watchers do not come back when a repo is made public again.")
+	default:
+		lines = append(lines, "This action is reversible.")
+	}
+	lines = append(lines, "")
+	switch {
+	case m.archiveMode == batchDelete:
+		lines = append(lines, m.styles.HelpKey.Render(fmt.Sprintf("Type %d to confirm: %s▏", count, m.deleteInput)))
+	case m.archiveMode == batchArchive && m.impactBlocking() > 0:
+		lines = append(lines, m.styles.HelpKey.Render("Archive anyway? [y/N]"))
+	default:
+		lines = append(lines, m.styles.HelpKey.Render("Continue? [Y/n]"))
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+
+	return m.styles.ModalBorder.Render(content)
+}
+
+// renderProgressModal renders the progress modal shown while a batch operation runs.
+func (m Model) renderProgressModal() string {
+	var lines []string
+	lines = append(lines, m.styles.ModalTitle.Render(fmt.Sprintf("%s Repositories", m.archiveMode.op().progress)))
+	lines = append(lines, strings.Repeat("-", 40))
 	lines = append(lines, "")
-	lines = append(lines, m.styles.HelpKey.Render("Continue? [Y/n]"))
+	lines = append(lines, fmt.Sprintf("Progress:   %d/%d", m.archiveProgress, m.archiveTotal))
+
+	if m.archiveState != nil {
+		succeeded, failed := m.archiveState.counts()
+		lines = append(lines, fmt.Sprintf("Succeeded:  %d", succeeded))
+		lines = append(lines, fmt.Sprintf("Failed:     %d", failed))
+		lines = append(lines, fmt.Sprintf("Workers:    %d", min(max(m.batchWorkers, 1), len(m.archiveState.repos))))
+		if steps := m.renderWorkflowSteps(); len(steps) > 0 {
+			lines = append(lines, "")
+			lines = append(lines, steps...)
+		}
+		lines = append(lines, "")
+		if m.archiveState.cancelled {
+			inFlight := m.archiveState.inFlight()
+			lines = append(lines, m.styles.Warning.Render(fmt.Sprintf("Cancelling... waiting for %d in-flight operation%s", inFlight, pluralize(inFlight))))
+		} else {
+			lines = append(lines, m.styles.HelpKey.Render("[c] Cancel"))
+		}
+	}
 
 	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	return m.styles.ModalBorder.Render(content)
+}
+
+// renderResultsModal renders the list of repos that failed in the last batch operation.
+func (m Model) renderResultsModal() string {
+	count := len(m.batchFailures)
+
+	var lines []string
+	lines = append(lines, m.styles.ModalTitle.Render("Batch Results"))
+	lines = append(lines, strings.Repeat("-", 60))
+	lines = append(lines, "")
+	lines = append(lines, fmt.Sprintf("%d repo%s failed:", count, pluralize(count)))
+	lines = append(lines, "")
+
+	startIdx := 0
+	if m.resultsCursor >= maxFailuresToShow {
+		startIdx = m.resultsCursor - maxFailuresToShow + 1
+	}
+	endIdx := min(startIdx+maxFailuresToShow, count)
 
+	for i := startIdx; i < endIdx; i++ {
+		f := m.batchFailures[i]
+		cursor := "  "
+		if i == m.resultsCursor {
+			cursor = "> "
+		}
+		lines = append(lines, cursor+m.styles.Error.Render(f.Repo.FullName()))
+		lines = append(lines, "    "+m.styles.HelpDesc.Render(truncateString(f.Err.Error(), 70)))
+	}
+	if endIdx < count {
+		lines = append(lines, m.styles.HelpDesc.Render(fmt.Sprintf("  ... (%d more)", count-endIdx)))
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, m.styles.HelpDesc.Render("j/k: Scroll  r: Retry failed  Esc: Close"))
+
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
 	return m.styles.ModalBorder.Render(content)
 }
 
@@ -137,14 +339,8 @@ func archiveNextRepo(client *github.Client, repos []github.Repository, current i
 		if current >= len(repos) {
 			slog.Debug("archive queue exhausted, returning ArchiveCompleteMsg",
 				"component", "tui",
-				"succeeded", state.succeeded,
-				"failed", state.failed,
 			)
-			return ArchiveCompleteMsg{
-				Succeeded: state.succeeded,
-				Failed:    state.failed,
-				Errors:    state.errors,
-			}
+			return state.completeMsg()
 		}
 
 		repo := repos[current]
@@ -162,15 +358,13 @@ func archiveNextRepo(client *github.Client, repos []github.Repository, current i
 				"repo", repo.FullName(),
 				"err", err,
 			)
-			state.failed++
-			state.errors = append(state.errors, fmt.Errorf("%s: %w", repo.FullName(), err))
 		} else {
 			slog.Debug("archive succeeded",
 				"component", "tui",
 				"repo", repo.FullName(),
 			)
-			state.succeeded++
 		}
+		state.recordResult(repo, err)
 
 		return ArchiveProgressMsg{
 			Current:  current + 1,
@@ -193,14 +387,8 @@ func unarchiveNextRepo(client *github.Client, repos []github.Repository, current
 		if current >= len(repos) {
 			slog.Debug("unarchive queue exhausted, returning ArchiveCompleteMsg",
 				"component", "tui",
-				"succeeded", state.succeeded,
-				"failed", state.failed,
 			)
-			return ArchiveCompleteMsg{
-				Succeeded: state.succeeded,
-				Failed:    state.failed,
-				Errors:    state.errors,
-			}
+			return state.completeMsg()
 		}
 
 		repo := repos[current]
@@ -218,15 +406,13 @@ func unarchiveNextRepo(client *github.Client, repos []github.Repository, current
 				"repo", repo.FullName(),
 				"err", err,
 			)
-			state.failed++
-			state.errors = append(state.errors, fmt.Errorf("%s: %w", repo.FullName(), err))
 		} else {
 			slog.Debug("unarchive succeeded",
 				"component", "tui",
 				"repo", repo.FullName(),
 			)
-			state.succeeded++
 		}
+		state.recordResult(repo, err)
 
 		return ArchiveProgressMsg{
 			Current:  current + 1,
@@ -248,6 +434,34 @@ func (m Model) getMarkedRepos() []github.Repository {
 	return marked
 }
 
+// renderReadmeSignals renders the README findings for the detail modal.
+func (m Model) renderReadmeSignals(fullName string) string {
+	if m.readmeChecking[fullName] {
+		return "  Checking...\n"
+	}
+	signals, ok := m.readmeSignals[fullName]
+	if !ok {
+		return "  Not checked\n"
+	}
+
+	var b strings.Builder
+	switch {
+	case signals.Missing:
+		b.WriteString("  No README\n")
+	case signals.Boilerplate:
+		b.WriteString("  Empty or boilerplate\n")
+	case !signals.Any():
+		b.WriteString("  No archive signals\n")
+	}
+	if signals.MovedTo != "" {
+		b.WriteString(fmt.Sprintf("  Moved to:      %s\n", signals.MovedTo))
+	}
+	for _, phrase := range signals.Phrases {
+		b.WriteString(fmt.Sprintf("  %q\n", phrase))
+	}
+	return b.String()
+}
+
 // renderDetailModal renders the repository detail modal.
 func (m Model) renderDetailModal() string {
 	if m.selectedRepo == nil {
@@ -265,6 +479,9 @@ func (m Model) renderDetailModal() string {
 		description = "No description"
 	}
 	content.WriteString(fmt.Sprintf("Description: %s\n\n", description))
+	if len(repo.Topics) > 0 {
+		content.WriteString(fmt.Sprintf("Topics: %s\n\n", strings.Join(repo.Topics, ", ")))
+	}
 
 	// Stats section
 	content.WriteString("Stats:\n")
@@ -304,35 +521,57 @@ func (m Model) renderDetailModal() string {
 	} else {
 		content.WriteString(fmt.Sprintf("  Last Push:     %s\n", lastPush))
 	}
+	content.WriteString(renderIssueActivity(*repo))
+	if !repo.LastHumanActivity.IsZero() {
+		content.WriteString(fmt.Sprintf("  Last Human:    %s (%s)\n",
+			formatDaysAgo(repo.DaysSinceHumanActivity()), repo.LastHumanActivity.Format("2006-01-02")))
+	}
 	content.WriteString(fmt.Sprintf("  Created:       %s\n\n", createdAt))
+	content.WriteString(m.renderWorkflows(*repo))
 
 	// Archive Analysis section
 	content.WriteString("Archive Analysis:\n")
 
-	isCandidate, reasons := analyze.IsArchiveCandidate(*repo)
+	assessment := m.assess(*repo)
 	status := "Active"
 	if repo.IsArchived {
 		status = "Archived"
-	} else if isCandidate {
+	} else if assessment.Candidate {
 		status = "Candidate"
 	}
 
 	reasonsDisplay := "None"
-	if reasons != "" {
-		reasonsDisplay = reasons
+	if assessment.Candidate {
+		reasonsDisplay = assessment.Summary()
 	}
 
 	content.WriteString(fmt.Sprintf("  Status:        %s\n", status))
-	content.WriteString(fmt.Sprintf("  Reasons:       %s\n\n", reasonsDisplay))
+	content.WriteString(fmt.Sprintf("  Reasons:       %s\n", reasonsDisplay))
+	content.WriteString(m.renderSchedule(*repo))
+	content.WriteString(m.renderBackup(*repo))
+	content.WriteString(m.renderPrediction(*repo))
+	if v, ok := m.verdicts[repo.FullName()]; ok {
+		content.WriteString(fmt.Sprintf("  AI Verdict:    %s (%.0f%%)\n", v.Decision, v.Confidence*100))
+		if v.Replacement != "" {
+			content.WriteString(fmt.Sprintf("  Replacement:   %s\n", v.Replacement))
+		}
+	}
+	content.WriteString("\n")
+
+	// README section
+	content.WriteString("README:\n")
+	content.WriteString(m.renderReadmeSignals(repo.FullName()))
+	content.WriteString("\n")
 
 	// Actions section
 	content.WriteString("Actions:\n")
 	content.WriteString("  [Space] Mark/unmark for archiving\n")
 	content.WriteString("  [o]     Open in browser\n")
-	if m.fabricEnabled {
-		content.WriteString("  [i]     Analyze with Fabric\n")
+	content.WriteString("  [r]     Re-check README\n")
+	if m.analyzer != nil {
+		content.WriteString(fmt.Sprintf("  [i]     Analyze with AI (%s)\n", m.analyzer.Name()))
 	} else {
-		content.WriteString("  [i]     Analyze with Fabric (disabled)\n")
+		content.WriteString("  [i]     Analyze with AI (disabled)\n")
 	}
 	content.WriteString("  [Esc]   Close\n")
 
@@ -444,8 +683,10 @@ func (m Model) renderHelpModal() string {
 	lines = append(lines, formatBinding("o", "Show old (365+ days)"))
 	lines = append(lines, formatBinding("n", "Show no stars"))
 	lines = append(lines, formatBinding("f", "Show only forks"))
+	lines = append(lines, formatBinding("b", "Show bot-only activity (1+ year)"))
 	lines = append(lines, formatBinding("l", "Language filter"))
 	lines = append(lines, formatBinding("p", "Toggle private/public"))
+	lines = append(lines, formatBinding("v", "Cycle AI verdict filter"))
 	lines = append(lines, "")
 
 	// Sorting section
@@ -459,17 +700,25 @@ func (m Model) renderHelpModal() string {
 	lines = append(lines, formatBinding("Shift+A/U", "Mark/unmark all visible"))
 	lines = append(lines, formatBinding("Enter", "View details"))
 	lines = append(lines, formatBinding("a", "Archive marked repos"))
-	lines = append(lines, formatBinding("e", "Export marked to JSON"))
+	lines = append(lines, formatBinding("S", "Schedule archive with notice issue"))
+	lines = append(lines, formatBinding("T", "Transfer marked repos to graveyard"))
+	lines = append(lines, formatBinding("D", "Back up and delete marked repos"))
+	lines = append(lines, formatBinding("P", "Make marked repos private/public"))
+	lines = append(lines, formatBinding("E", "Edit topics/description of marked repos"))
+	lines = append(lines, formatBinding("W", "Disable scheduled workflows of marked repos"))
+	lines = append(lines, formatBinding("c", "Cancel running batch"))
+	lines = append(lines, formatBinding("r", "Retry failed (results)"))
+	lines = append(lines, formatBinding("e", "Export (format, scope, path)"))
 	lines = append(lines, "")
 
-	// Fabric section (conditional)
-	if m.fabricEnabled {
-		lines = append(lines, categoryStyle.Render("Fabric (enabled):"))
+	// AI section (conditional)
+	if m.analyzer != nil {
+		lines = append(lines, categoryStyle.Render(fmt.Sprintf("AI analysis (%s):", m.analyzer.Name())))
 	} else {
-		lines = append(lines, categoryStyle.Render("Fabric (if enabled):"))
+		lines = append(lines, categoryStyle.Render("AI analysis (if enabled):"))
 	}
 	lines = append(lines, formatBinding("i", "Analyze repo"))
-	lines = append(lines, formatBinding("Shift+I", "Batch analyze"))
+	lines = append(lines, formatBinding("Shift+I", "Batch analyze marked"))
 	lines = append(lines, "")
 
 	// Close hint
@@ -10,29 +10,14 @@ import (
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/llbbl/repjan/internal/analyze"
+	"github.com/llbbl/repjan/internal/backup"
+	"github.com/llbbl/repjan/internal/edit"
 	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/query"
 	"github.com/llbbl/repjan/internal/store"
 	"github.com/llbbl/repjan/internal/sync"
-)
-
-// Filter represents the available repository filter options.
-type Filter int
-
-const (
-	FilterAll Filter = iota
-	FilterOld
-	FilterNoStars
-	FilterForks
-)
-
-// SortField represents the available sorting fields.
-type SortField int
-
-const (
-	SortName SortField = iota
-	SortActivity
-	SortStars
-	SortLanguage
+	"github.com/llbbl/repjan/internal/workflow"
 )
 
 // ModalType represents the type of modal currently displayed.
@@ -44,6 +29,11 @@ const (
 	ModalConfirm
 	ModalHelp
 	ModalLanguage
+	ModalProgress
+	ModalResults
+	ModalExport
+	ModalAnalysis
+	ModalEdit
 )
 
 // languageOption represents a language filter option with its repo count.
@@ -67,7 +57,7 @@ type Model struct {
 	marked         map[string]bool // key: owner/name
 
 	// Filters
-	currentFilter  Filter
+	currentFilter  query.Filter
 	languageFilter string
 
 	// Visibility toggles (privacy-safe defaults)
@@ -75,7 +65,7 @@ type Model struct {
 	showArchived bool // whether to include archived repos (default: false)
 
 	// Sorting
-	sortField     SortField
+	sortField     query.SortField
 	sortAscending bool
 
 	// Modals
@@ -89,21 +79,56 @@ type Model struct {
 	searchQuery string
 
 	// Async state
-	loading         bool
-	archiving       bool
-	archiveProgress int
-	archiveTotal    int
-	archiveState    *archiveState       // tracks ongoing archive operation
-	archiveMode     string              // "archive" or "unarchive" mode for modal
-	syncing         bool                // whether a sync operation is in progress
-	syncSpinner     spinner.Model       // animated spinner for sync operations
-	lastSyncTime    time.Time           // when repos were last synced from GitHub
-	usingCache      bool                // whether we're showing cached data
-	syncCh          <-chan sync.SyncMsg // channel for receiving sync messages
-
-	// Fabric
-	fabricEnabled bool
-	fabricPath    string
+	loading          bool
+	archiving        bool
+	archiveProgress  int
+	archiveTotal     int
+	archiveState     *archiveState                    // tracks ongoing archive operation
+	archiveMode      batchMode                        // operation of the confirm modal and running batch
+	batchWorkers     int                              // number of concurrent workers for batch operations
+	workflow         []workflow.Step                  // archive workflow steps, nil to archive directly
+	graceDays        int                              // days from a scheduled archive's notice to its date
+	graveyardOwner   string                           // organization repos are transferred to, empty to disable
+	graveyardArchive bool                             // archive repos after transferring them
+	backer           *backup.Backer                   // backs repos up before deleting or in the archive workflow
+	backups          map[string]backup.Metadata       // backups of repos opened in the detail modal, key: owner/name
+	allowDelete      bool                             // whether marked repos can be deleted
+	deleteInput      string                           // count typed to confirm a deletion
+	makePrivate      bool                             // visibility marked repos are changed to
+	editOpts         editOptions                      // selections in the edit modal
+	editing          edit.Edit                        // topic or description edit applied by the batch
+	schedules        map[string]store.ArchiveSchedule // scheduled archives, key: owner/name
+	batchFailures    []ArchiveFailure                 // failures from the last batch, shown in results modal
+	resultsCursor    int                              // scroll position in the results modal
+	exportOpts       exportOptions                    // selections in the export modal
+	syncing          bool                             // whether a sync operation is in progress
+	syncSpinner      spinner.Model                    // animated spinner for sync operations
+	lastSyncTime     time.Time                        // when repos were last synced from GitHub
+	usingCache       bool                             // whether we're showing cached data
+	syncCh           <-chan sync.SyncMsg              // channel for receiving sync messages
+
+	// README analysis
+	readmeSignals  map[string]analyze.ReadmeSignals // key: owner/name
+	readmeChecking map[string]bool                  // READMEs being fetched
+
+	// Archive impact of the marked repos, shown in the confirm modal
+	impacts        map[string]analyze.ImpactReport // key: owner/name; nil until checked
+	impactChecking bool
+
+	// Commit activity, recorded by 'repjan activity'
+	humanActivity map[string]time.Time // last human commit, key: owner/name
+
+	// Actions workflows, recorded by 'repjan actions'
+	workflows map[string][]github.ActionsWorkflow // key: owner/name
+
+	// AI analysis
+	analyzer      analyze.Analyzer           // nil when AI analysis is disabled
+	aiState       *aiState                   // current analysis run, shown in the analysis modal
+	verdicts      map[string]analyze.Verdict // latest parsed AI verdicts, key: owner/name
+	verdictFilter analyze.Decision           // show only repos with this verdict; "" for all
+
+	// Archive prediction
+	classifier *analyze.Classifier // nil until 'repjan model train' has been run
 
 	// Dimensions
 	width, height int
@@ -135,12 +160,25 @@ type ArchiveCompleteMsg struct {
 	Succeeded int
 	Failed    int
 	Errors    []error
+	Failures  []ArchiveFailure // per-repo failures, used for retry
+	Cancelled bool             // whether the operation was cancelled by the user
+	Skipped   int              // repos never started because of cancellation
+	Done      []string         // full names of the repos that succeeded
+}
+
+// ArchiveFailure pairs a repository with the error from a failed batch operation.
+type ArchiveFailure struct {
+	Repo github.Repository
+	Err  error
 }
 
-// FabricResultMsg is sent when a Fabric AI analysis completes.
+// FabricResultMsg is sent when an AI analysis of one repository completes,
+// whichever backend produced it.
 type FabricResultMsg struct {
 	RepoName string
 	Result   string
+	Verdict  *analyze.Verdict // nil when the result is not a structured verdict
+	Cached   bool             // result came from the analysis cache
 	Err      error
 }
 
@@ -154,6 +192,9 @@ type ErrorMsg struct {
 type ReposSyncedMsg struct {
 	Repos []github.Repository
 	Error error
+
+	// ActivityErr is set when the repos were synced without issue activity.
+	ActivityErr error
 }
 
 // NewModel creates a new TUI model with the provided repositories and configuration.
@@ -165,24 +206,29 @@ func NewModel(repos []github.Repository, owner string, client *github.Client, fa
 	s.Style = DefaultStyles().HelpKey
 
 	m := Model{
-		repos:         repos,
-		owner:         owner,
-		client:        client,
-		marked:        make(map[string]bool),
-		currentFilter: FilterAll,
-		sortField:     SortActivity,
-		sortAscending: true, // oldest first
-		activeModal:   ModalNone,
-		fabricEnabled: fabricEnabled,
-		fabricPath:    fabricPath,
-		syncCh:        syncCh,
-		syncSpinner:   s,
-		lastSyncTime:  time.Now(),
-		styles:        DefaultStyles(),
+		repos:          repos,
+		owner:          owner,
+		client:         client,
+		marked:         make(map[string]bool),
+		readmeSignals:  make(map[string]analyze.ReadmeSignals),
+		readmeChecking: make(map[string]bool),
+		verdicts:       make(map[string]analyze.Verdict),
+		currentFilter:  query.FilterAll,
+		sortField:      query.SortActivity,
+		sortAscending:  true, // oldest first
+		activeModal:    ModalNone,
+		syncCh:         syncCh,
+		syncSpinner:    s,
+		lastSyncTime:   time.Now(),
+		styles:         DefaultStyles(),
 		// showPrivate and showArchived default to false (Go zero values)
 		// This provides privacy-safe defaults by hiding sensitive repos
 	}
 
+	if fabricEnabled {
+		m.analyzer = analyze.NewFabricAnalyzer(fabricPath)
+	}
+
 	// Apply initial filtering to respect visibility defaults
 	m.RefreshFilteredRepos()
 
@@ -194,6 +240,13 @@ func NewModel(repos []github.Repository, owner string, client *github.Client, fa
 func NewModelWithStore(repos []github.Repository, owner string, client *github.Client, s *store.Store, fabricEnabled bool, fabricPath string, syncCh <-chan sync.SyncMsg) Model {
 	m := NewModel(repos, owner, client, fabricEnabled, fabricPath, syncCh)
 	m.store = s
+	m.loadCachedReadmes()
+	m.loadVerdicts()
+	m.loadClassifier()
+	m.loadHumanActivity()
+	m.loadWorkflows()
+	m.loadSchedules()
+	m.RefreshFilteredRepos()
 	return m
 }
 
@@ -210,6 +263,16 @@ func (m *Model) SetStore(s *store.Store) {
 	m.store = s
 }
 
+// SetStatusMessage sets the message shown in the status bar.
+func (m *Model) SetStatusMessage(msg string) {
+	m.statusMessage = msg
+}
+
+// SetBatchWorkers sets how many repositories batch operations process concurrently.
+func (m *Model) SetBatchWorkers(n int) {
+	m.batchWorkers = n
+}
+
 // LoadMarkedRepos loads marked repos from the database into the model's marked map.
 func (m *Model) LoadMarkedRepos() error {
 	if m.store == nil {
@@ -275,7 +338,8 @@ func (m Model) listenForSyncMsgs() tea.Cmd {
 			return syncStartedMsg{}
 		case sync.SyncCompleted:
 			return ReposSyncedMsg{
-				Repos: msg.Repos,
+				Repos:       msg.Repos,
+				ActivityErr: msg.ActivityError,
 			}
 		case sync.SyncError:
 			return ReposSyncedMsg{
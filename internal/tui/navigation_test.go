@@ -9,6 +9,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/query"
 )
 
 // createTestModelWithRepos creates a test model with the specified number of repos.
@@ -192,7 +193,7 @@ func TestFilterChange_ResetsViewportWhenNeeded(t *testing.T) {
 	m.viewportOffset = 2
 
 	// Apply NoStars filter (should reduce to 3 repos)
-	m.ApplyFilter(FilterNoStars)
+	m.ApplyFilter(query.FilterNoStars)
 
 	// Cursor should be clamped to valid range
 	if m.cursor >= len(m.filteredRepos) {
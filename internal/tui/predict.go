@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/llbbl/repjan/internal/analyze"
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+// explainFeatureCount is how many features the detail view lists.
+const explainFeatureCount = 3
+
+// loadClassifier loads the trained archive prediction model, if any.
+func (m *Model) loadClassifier() {
+	if m.store == nil {
+		return
+	}
+	c, _, err := analyze.LoadClassifier(m.store, m.owner)
+	if err != nil {
+		if !errors.Is(err, store.ErrNotFound) {
+			slog.Warn("failed to load archive model", "component", "tui", "error", err)
+		}
+		return
+	}
+	m.classifier = c
+}
+
+// predictionLabel returns the predicted archive probability for the table,
+// or "-" when there is no model or the repo is already archived.
+func (m Model) predictionLabel(repo github.Repository) string {
+	if m.classifier == nil || repo.IsArchived {
+		return "-"
+	}
+	return fmt.Sprintf("%.0f%%", m.classifier.Probability(repo, time.Now())*100)
+}
+
+// renderPrediction renders the prediction and its main drivers for the
+// detail modal, or "" when there is no model.
+func (m Model) renderPrediction(repo github.Repository) string {
+	if m.classifier == nil {
+		return ""
+	}
+	now := time.Now()
+	explanation := m.classifier.Explain(repo, now)
+	drivers := make([]string, 0, explainFeatureCount)
+	for _, f := range explanation[:min(explainFeatureCount, len(explanation))] {
+		drivers = append(drivers, f.String())
+	}
+	return fmt.Sprintf("  Prediction:    %.0f%% archive (%s)\n",
+		m.classifier.Probability(repo, now)*100, strings.Join(drivers, ", "))
+}
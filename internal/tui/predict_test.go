@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/analyze"
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+func TestPrediction(t *testing.T) {
+	stale := testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("stale"), testutil.WithDaysInactive(900), testutil.WithStars(0), testutil.WithForks(0))
+	archived := testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("gone"), testutil.WithArchived(true))
+	m, _ := newAITestModel(t, []github.Repository{stale, archived})
+
+	// Without a trained model there is no prediction
+	assert.Equal(t, "-", m.predictionLabel(stale))
+	assert.Empty(t, m.renderPrediction(stale))
+
+	var examples []analyze.Example
+	for i := range 5 {
+		examples = append(examples,
+			analyze.Example{Repo: testutil.NewTestRepo(testutil.WithName(fmt.Sprintf("old-%d", i)), testutil.WithDaysInactive(800), testutil.WithStars(0), testutil.WithForks(0)), AsOf: time.Now(), Archived: true},
+			analyze.Example{Repo: testutil.NewTestRepo(testutil.WithName(fmt.Sprintf("new-%d", i)), testutil.WithDaysInactive(5), testutil.WithStars(30)), AsOf: time.Now()},
+		)
+	}
+	c, err := analyze.TrainClassifier(examples)
+	require.NoError(t, err)
+	require.NoError(t, analyze.SaveClassifier(m.store, "acme", c, len(examples), nil))
+
+	m.loadClassifier()
+	require.NotNil(t, m.classifier)
+	assert.Regexp(t, `^\d+%$`, m.predictionLabel(stale))
+	assert.Equal(t, "-", m.predictionLabel(archived))
+	assert.Contains(t, m.renderPrediction(stale), "archive (")
+	assert.Contains(t, m.renderTableHeader(), "PRED")
+}
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"log/slog"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/llbbl/repjan/internal/analyze"
+	"github.com/llbbl/repjan/internal/github"
+)
+
+// ReadmeAnalyzedMsg is sent when README analysis for a repository completes.
+type ReadmeAnalyzedMsg struct {
+	RepoName string // owner/name
+	Signals  analyze.ReadmeSignals
+	Found    bool // false when no README could be fetched or loaded from cache
+	Err      error
+}
+
+// assess runs the archive heuristics on repo, including README signals and
+// the AI verdict once they are known.
+func (m Model) assess(repo github.Repository) analyze.Assessment {
+	var signals analyze.Signals
+	if s, ok := m.readmeSignals[repo.FullName()]; ok {
+		signals.Readme = &s
+	}
+	if v, ok := m.verdicts[repo.FullName()]; ok {
+		signals.Verdict = &v
+	}
+	return analyze.AssessWithSignals(repo, signals)
+}
+
+// loadCachedReadmes analyzes READMEs already cached in the store so their
+// signals apply without a network round trip.
+func (m *Model) loadCachedReadmes() {
+	if m.store == nil {
+		return
+	}
+	signals, err := analyze.CachedReadmeSignals(m.store, m.owner)
+	if err != nil {
+		slog.Warn("failed to load cached readmes", "component", "tui", "error", err)
+		return
+	}
+	for name, s := range signals {
+		m.readmeSignals[name] = s
+	}
+}
+
+// analyzeReadme returns a command that fetches (through the cache) and
+// analyzes repo's README. It returns nil when there is nothing to fetch from.
+func (m Model) analyzeReadme(repo github.Repository, refresh bool) tea.Cmd {
+	if m.client == nil && m.store == nil {
+		return nil
+	}
+	name := repo.FullName()
+	if m.readmeChecking[name] {
+		return nil
+	}
+	m.readmeChecking[name] = true
+
+	var fetcher analyze.ReadmeFetcher
+	if m.client != nil {
+		fetcher = m.client
+	}
+	analyzer := analyze.NewReadmeAnalyzer(fetcher, m.store)
+
+	return func() tea.Msg {
+		signals, found, err := analyzer.Analyze(repo, refresh)
+		return ReadmeAnalyzedMsg{RepoName: name, Signals: signals, Found: found, Err: err}
+	}
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"encoding/base64"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+func TestDetailModal_AnalyzesReadme(t *testing.T) {
+	readme := base64.StdEncoding.EncodeToString([]byte("# widget\n\nThis project is deprecated.\n"))
+	mockExec := testutil.NewMockExecutor()
+	mockExec.ExecuteFunc = func(name string, args ...string) ([]byte, error) {
+		return []byte(readme), nil
+	}
+
+	// Recently active and starred, so only the README makes it a candidate
+	repo := testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("widget"), testutil.WithDaysInactive(5), testutil.WithStars(10))
+	m := NewModel([]github.Repository{repo}, "acme", github.NewClient(mockExec), false, "", nil)
+	m.width, m.height = 120, 60
+
+	updated, cmd := m.handleMainViewKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	require.Equal(t, ModalDetail, m.activeModal)
+	require.NotNil(t, cmd, "opening the detail modal should fetch the README")
+	assert.Contains(t, m.renderDetailModal(), "Checking...")
+
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+
+	view := m.renderDetailModal()
+	assert.Contains(t, view, "Candidate")
+	assert.Contains(t, view, "README marks project deprecated")
+	assert.Contains(t, view, `"This project is deprecated"`)
+	assert.Equal(t, "Candidate", getStatusText(repo, m.assess(repo).Candidate))
+}
+
+func TestNewModelWithStore_LoadsCachedReadmes(t *testing.T) {
+	s := testutil.NewStore(t)
+	require.NoError(t, s.SaveReadme("acme", "widget", "No longer maintained, moved to https://codeberg.org/acme/widget"))
+
+	repo := testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("widget"), testutil.WithStars(10))
+	m := NewModelWithStore([]github.Repository{repo}, "acme", nil, s, false, "", nil)
+
+	a := m.assess(repo)
+	assert.Equal(t, []string{"readme_unmaintained", "readme_moved"}, a.Codes())
+}
+
+func TestReadmeAnalyzedMsg_Error(t *testing.T) {
+	m := NewModel(nil, "acme", nil, false, "", nil)
+	m.readmeChecking["acme/widget"] = true
+
+	updated, _ := m.Update(ReadmeAnalyzedMsg{RepoName: "acme/widget", Err: assert.AnError})
+	m = updated.(Model)
+
+	assert.False(t, m.readmeChecking["acme/widget"])
+	assert.NotContains(t, m.readmeSignals, "acme/widget")
+	assert.Contains(t, m.statusMessage, "README check failed")
+}
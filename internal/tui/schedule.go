@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/schedule"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+// SetArchiveGraceDays sets how many days after the notice a scheduled
+// archive is due.
+func (m *Model) SetArchiveGraceDays(days int) {
+	m.graceDays = days
+}
+
+// scheduleDate returns the archive date for repos scheduled now.
+func (m Model) scheduleDate() time.Time {
+	days := m.graceDays
+	if days < 1 {
+		days = schedule.DefaultGraceDays
+	}
+	return schedule.ArchiveDate(time.Now(), days)
+}
+
+// loadSchedules loads the scheduled archives for the detail modal.
+func (m *Model) loadSchedules() {
+	if m.store == nil {
+		return
+	}
+	schedules, err := m.store.GetArchiveSchedules(m.owner)
+	if err != nil {
+		slog.Warn("failed to load archive schedules", "component", "tui", "error", err)
+		return
+	}
+
+	m.schedules = make(map[string]store.ArchiveSchedule, len(schedules))
+	for _, s := range schedules {
+		m.schedules[s.Owner+"/"+s.RepoName] = s
+	}
+}
+
+// scheduleMarkedRepos starts a batch opening a notice issue on each marked,
+// unarchived repo and recording its archive date.
+func (m *Model) scheduleMarkedRepos() tea.Cmd {
+	var repos []github.Repository
+	for _, repo := range m.getMarkedRepos() {
+		if !repo.IsArchived {
+			repos = append(repos, repo)
+		}
+	}
+	if len(repos) == 0 || m.store == nil {
+		m.statusMessage = "Scheduling archives needs marked repos and a database"
+		return nil
+	}
+	return m.startBatch(repos)
+}
+
+// scheduleNextRepo returns a command scheduling the archive of the repository at current.
+func scheduleNextRepo(scheduler *schedule.Scheduler, repos []github.Repository, current int, archiveOn time.Time, state *archiveState) tea.Cmd {
+	return func() tea.Msg {
+		repo := repos[current]
+		_, err := scheduler.Schedule(repo, archiveOn)
+		if err != nil {
+			slog.Debug("scheduling archive failed", "component", "tui", "repo", repo.FullName(), "err", err)
+		}
+		state.recordResult(repo, err)
+
+		return ArchiveProgressMsg{
+			Current:  current + 1,
+			Total:    len(repos),
+			RepoName: repo.FullName(),
+			Err:      err,
+		}
+	}
+}
+
+// renderSchedule renders the scheduled archive line of the detail modal.
+func (m Model) renderSchedule(repo github.Repository) string {
+	s, ok := m.schedules[repo.FullName()]
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("  Archive On:    %s (notice #%d)\n", s.ArchiveOn.Format("2006-01-02"), s.IssueNumber)
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+// scheduleClient returns a client that opens issue #5 on any repo.
+func scheduleClient() *github.Client {
+	mockExec := testutil.NewMockExecutor()
+	mockExec.ExecuteFunc = func(name string, args ...string) ([]byte, error) {
+		switch {
+		case args[0] == "api" && args[1] == "user":
+			return []byte("janitor\n"), nil
+		case args[0] == "repo" && args[1] == "view":
+			return []byte(`{"description":"","isArchived":false,"pushedAt":"2024-01-01T00:00:00Z","topics":[]}`), nil
+		case args[0] == "api":
+			return []byte(`{"number":5,"url":"https://github.com/acme/widget/issues/5"}`), nil
+		}
+		return nil, nil
+	}
+	return github.NewClient(mockExec)
+}
+
+func TestScheduleArchive(t *testing.T) {
+	s := testutil.NewStore(t)
+
+	repo := testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("widget"))
+	m := NewModelWithStore([]github.Repository{repo}, "acme", scheduleClient(), s, false, "", nil)
+	m.SetArchiveGraceDays(14)
+	m.marked["acme/widget"] = true
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("S")})
+	m = updated.(Model)
+	require.Equal(t, ModalConfirm, m.activeModal)
+	assert.Contains(t, m.renderConfirmModal(), "announce the archive of 1 repo on "+m.scheduleDate().Format("2006-01-02"))
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	m = updated.(Model)
+	require.NotNil(t, cmd)
+	assert.Contains(t, m.renderProgressModal(), "Scheduling")
+
+	updated, cmd = m.Update(cmd())
+	m = updated.(Model)
+	require.NotNil(t, cmd)
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+
+	assert.Contains(t, m.statusMessage, "Scheduled 1 repo for archive")
+	assert.False(t, m.repos[0].IsArchived, "scheduled repos stay active")
+	assert.True(t, m.marked["acme/widget"])
+
+	schedules, err := s.GetArchiveSchedules("acme")
+	require.NoError(t, err)
+	require.Len(t, schedules, 1)
+	assert.Equal(t, 5, schedules[0].IssueNumber)
+	assert.Equal(t, m.scheduleDate(), schedules[0].ArchiveOn)
+	assert.Equal(t, "  Archive On:    "+m.scheduleDate().Format("2006-01-02")+" (notice #5)\n", m.renderSchedule(m.repos[0]))
+}
@@ -10,7 +10,6 @@ import (
 
 	"github.com/charmbracelet/lipgloss"
 
-	"github.com/llbbl/repjan/internal/analyze"
 	"github.com/llbbl/repjan/internal/github"
 )
 
@@ -87,8 +86,9 @@ func buildTableHeader() string {
 // renderTableRow renders a single table row for a repository.
 func (m Model) renderTableRow(repo github.Repository, isSelected bool) string {
 	// Get status icon with color
-	statusIcon := getStatusIcon(repo)
-	statusStyle := m.getStatusStyle(repo)
+	candidate := m.assess(repo).Candidate
+	statusIcon := getStatusIcon(repo, candidate)
+	statusStyle := m.getStatusStyle(repo, candidate)
 	styledIcon := statusStyle.Render(statusIcon)
 
 	// Format fields
@@ -96,7 +96,7 @@ func (m Model) renderTableRow(repo github.Repository, isSelected bool) string {
 	stars := fmt.Sprintf("%*d", colWidthStars, repo.StargazerCount)
 	lang := truncateWithEllipsis(repo.PrimaryLanguage, colWidthLang)
 	lastPush := formatRelativeTime(repo.PushedAt)
-	status := getStatusText(repo)
+	status := getStatusText(repo, candidate)
 
 	// Mark indicator
 	mark := ""
@@ -160,12 +160,11 @@ func (m Model) calculateViewport(visibleRows int) (startIdx, endIdx int) {
 }
 
 // getStatusStyle returns the appropriate style for a repository's status.
-func (m Model) getStatusStyle(repo github.Repository) lipgloss.Style {
+func (m Model) getStatusStyle(repo github.Repository, candidate bool) lipgloss.Style {
 	if repo.IsArchived {
 		return m.styles.StatusArchived
 	}
-	isCandidate, _ := analyze.IsArchiveCandidate(repo)
-	if isCandidate {
+	if candidate {
 		return m.styles.StatusCandidate
 	}
 	return m.styles.StatusActive
@@ -206,12 +205,11 @@ func truncateWithEllipsis(s string, max int) string {
 
 // getStatusIcon returns the appropriate status icon for a repository.
 // Returns ● for active, ⚠ for archive candidate, □ for archived.
-func getStatusIcon(repo github.Repository) string {
+func getStatusIcon(repo github.Repository, candidate bool) string {
 	if repo.IsArchived {
 		return iconArchived
 	}
-	isCandidate, _ := analyze.IsArchiveCandidate(repo)
-	if isCandidate {
+	if candidate {
 		return iconCandidate
 	}
 	return iconActive
@@ -219,12 +217,11 @@ func getStatusIcon(repo github.Repository) string {
 
 // getStatusText returns the status text for a repository.
 // Returns "Active", "Candidate", or "Archived".
-func getStatusText(repo github.Repository) string {
+func getStatusText(repo github.Repository, candidate bool) string {
 	if repo.IsArchived {
 		return "Archived"
 	}
-	isCandidate, _ := analyze.IsArchiveCandidate(repo)
-	if isCandidate {
+	if candidate {
 		return "Candidate"
 	}
 	return "Active"
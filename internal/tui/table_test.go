@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/llbbl/repjan/internal/analyze"
 	"github.com/llbbl/repjan/internal/github"
 	"github.com/llbbl/repjan/internal/testutil"
 	"github.com/stretchr/testify/assert"
@@ -292,7 +293,7 @@ func TestGetStatusIcon(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := getStatusIcon(tt.repo)
+			result := getStatusIcon(tt.repo, analyze.Assess(tt.repo).Candidate)
 			assert.Equal(t, tt.expected, result)
 		})
 	}
@@ -331,7 +332,7 @@ func TestGetStatusText(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := getStatusText(tt.repo)
+			result := getStatusText(tt.repo, analyze.Assess(tt.repo).Candidate)
 			assert.Equal(t, tt.expected, result)
 		})
 	}
@@ -384,7 +385,7 @@ func TestGetStatusIcon_ArchiveCandidateCriteria(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			icon := getStatusIcon(tt.repo)
+			icon := getStatusIcon(tt.repo, analyze.Assess(tt.repo).Candidate)
 			if tt.isCandidate {
 				assert.Equal(t, iconCandidate, icon, "expected candidate icon")
 			} else {
@@ -415,7 +416,7 @@ func TestGetStatusText_ArchiveCandidateCriteria(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			text := getStatusText(tt.repo)
+			text := getStatusText(tt.repo, analyze.Assess(tt.repo).Candidate)
 			if tt.isCandidate {
 				assert.Equal(t, "Candidate", text)
 			} else {
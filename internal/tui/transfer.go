@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"log/slog"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/transfer"
+)
+
+// SetGraveyard sets the organization marked repos are transferred to, and
+// whether they are archived after the transfer. An empty owner disables
+// transfers.
+func (m *Model) SetGraveyard(owner string, archive bool) {
+	m.graveyardOwner = owner
+	m.graveyardArchive = archive
+}
+
+// transferMarkedRepos starts a batch transferring each marked, unarchived
+// repo to the graveyard organization.
+func (m *Model) transferMarkedRepos() tea.Cmd {
+	var repos []github.Repository
+	for _, repo := range m.getMarkedRepos() {
+		if !repo.IsArchived {
+			repos = append(repos, repo)
+		}
+	}
+	if len(repos) == 0 || m.graveyardOwner == "" {
+		m.statusMessage = "Transfers need marked repos and REPJAN_GRAVEYARD_OWNER"
+		return nil
+	}
+	return m.startBatch(repos)
+}
+
+// transferNextRepo returns a command transferring the repository at current.
+func transferNextRepo(mover *transfer.Mover, repos []github.Repository, current int, target string, archive bool, state *archiveState) tea.Cmd {
+	return func() tea.Msg {
+		repo := repos[current]
+		_, err := mover.Transfer(repo, target, archive)
+		if err != nil {
+			slog.Debug("transfer failed", "component", "tui", "repo", repo.FullName(), "err", err)
+		}
+		state.recordResult(repo, err)
+
+		return ArchiveProgressMsg{
+			Current:  current + 1,
+			Total:    len(repos),
+			RepoName: repo.FullName(),
+			Err:      err,
+		}
+	}
+}
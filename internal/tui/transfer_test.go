@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+func TestTransferRepos(t *testing.T) {
+	s := testutil.NewStore(t)
+
+	var transferred []string
+	mockExec := testutil.NewMockExecutor()
+	mockExec.ExecuteFunc = func(name string, args ...string) ([]byte, error) {
+		switch {
+		case args[0] == "repo" && args[1] == "view":
+			return []byte("HTTP 404: Not Found"), errors.New("exit status 1")
+		case args[0] == "api" && args[2] == "POST":
+			transferred = append(transferred, args[3])
+		}
+		return nil, nil
+	}
+
+	widget := testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("widget"))
+	gadget := testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("gadget"))
+	require.NoError(t, s.UpsertRepositories("acme", []github.Repository{widget, gadget}))
+	m := NewModelWithStore([]github.Repository{widget, gadget}, "acme", github.NewClient(mockExec), s, false, "", nil)
+	m.marked["acme/widget"] = true
+
+	// Without a graveyard, T only explains what is missing
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("T")})
+	m = updated.(Model)
+	assert.Equal(t, ModalNone, m.activeModal)
+	assert.Contains(t, m.statusMessage, "REPJAN_GRAVEYARD_OWNER")
+
+	m.SetGraveyard("acme-attic", false)
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("T")})
+	m = updated.(Model)
+	require.Equal(t, ModalConfirm, m.activeModal)
+	assert.Contains(t, m.renderConfirmModal(), "transfer 1 repo to acme-attic:")
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	m = updated.(Model)
+	require.NotNil(t, cmd)
+	assert.Contains(t, m.renderProgressModal(), "Transferring")
+
+	updated, cmd = m.Update(cmd())
+	m = updated.(Model)
+	require.NotNil(t, cmd)
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+
+	assert.Equal(t, []string{"repos/acme/widget/transfer"}, transferred)
+	assert.Equal(t, "Transferred 1 repo to acme-attic", m.statusMessage)
+	require.Len(t, m.repos, 1)
+	assert.Equal(t, "gadget", m.repos[0].Name)
+	assert.Empty(t, m.marked)
+
+	moved, err := s.GetRepository("acme-attic", "widget")
+	require.NoError(t, err)
+	assert.Equal(t, "acme-attic/widget", moved.FullName())
+}
@@ -10,6 +10,10 @@ import (
 
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/query"
+	"github.com/llbbl/repjan/internal/workflow"
 )
 
 // reservedRows is the number of rows reserved for UI chrome (not available for table content).
@@ -35,6 +39,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.lastError = msg.Err
 		} else {
 			m.repos = msg.Repos
+			m.applyHumanActivity()
+			m.applyWorkflows()
 			m.RefreshFilteredRepos()
 		}
 	case ArchiveProgressMsg:
@@ -44,84 +50,103 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			"total", msg.Total,
 			"repoName", msg.RepoName,
 			"err", msg.Err,
-			"archiveMode", m.archiveMode,
+			"archiveMode", m.archiveMode.String(),
 		)
 		m.archiveProgress = msg.Current
 		m.archiveTotal = msg.Total
 		if msg.Err != nil {
 			m.lastError = msg.Err
 		} else if msg.RepoName != "" {
-			// Operation succeeded - update the repo's IsArchived field based on mode
-			if m.archiveMode == "unarchive" {
-				m.markRepoAsUnarchived(msg.RepoName)
-			} else {
-				m.markRepoAsArchived(msg.RepoName)
-			}
+			m.archiveMode.op().succeeded(&m, msg.RepoName)
 		}
-		// Continue to the next repo if there are more
-		if m.archiveState != nil && msg.Current < msg.Total {
-			slog.Debug("chaining to next repo",
-				"component", "tui",
-				"nextIndex", msg.Current,
-				"archiveMode", m.archiveMode,
-			)
-			if m.archiveMode == "unarchive" {
-				return m, unarchiveNextRepo(m.client, m.archiveState.repos, msg.Current, m.archiveState)
-			}
-			return m, archiveNextRepo(m.client, m.archiveState.repos, msg.Current, m.archiveState)
+		if m.archiveState == nil {
+			return m, nil
 		}
-		// All repos processed - send completion message.
-		//
+
+		// Workers finish out of order, so progress is counted here rather than
+		// taken from msg.Current, which only identifies the repo's queue position.
+		state := m.archiveState
+		state.handled++
+		m.archiveProgress = state.handled
+		m.archiveTotal = len(state.repos)
+
 		// Archive/unarchive completion logic:
-		// The archiveNextRepo/unarchiveNextRepo command processes one repo at a time and sends
-		// an ArchiveProgressMsg when done. The msg.Current field indicates how many repos have
-		// been processed so far. When msg.Current >= msg.Total, all repos have been processed,
-		// so we emit an ArchiveCompleteMsg to transition out of archiving state. This pattern
-		// ensures the completion message is sent exactly once, after the final repo is processed,
-		// preventing the operation from hanging indefinitely.
-		if m.archiveState != nil && msg.Current >= msg.Total {
+		// Each worker command processes one repo and sends an ArchiveProgressMsg when done.
+		// Every message frees a worker slot, which is refilled with the next queued repo
+		// unless the user cancelled. Once every dispatched repo has reported back (all of
+		// them, or only the in-flight ones after a cancel) we emit a single
+		// ArchiveCompleteMsg to transition out of archiving state.
+		if state.handled >= len(state.repos) || (state.cancelled && state.inFlight() == 0) {
 			slog.Debug("archive/unarchive operation complete, sending ArchiveCompleteMsg",
 				"component", "tui",
-				"succeeded", m.archiveState.succeeded,
-				"failed", m.archiveState.failed,
-				"archiveMode", m.archiveMode,
+				"handled", state.handled,
+				"cancelled", state.cancelled,
+				"archiveMode", m.archiveMode.String(),
 			)
-			return m, func() tea.Msg {
-				return ArchiveCompleteMsg{
-					Succeeded: m.archiveState.succeeded,
-					Failed:    m.archiveState.failed,
-					Errors:    m.archiveState.errors,
-				}
-			}
+			complete := state.completeMsg()
+			complete.Cancelled = state.cancelled
+			complete.Skipped = len(state.repos) - state.next
+			return m, func() tea.Msg { return complete }
+		}
+		if !state.cancelled && state.next < len(state.repos) {
+			slog.Debug("dispatching next repo",
+				"component", "tui",
+				"nextIndex", state.next,
+				"archiveMode", m.archiveMode.String(),
+			)
+			return m, m.dispatchNextRepo()
 		}
 	case ArchiveCompleteMsg:
 		m.archiving = false
 		m.archiveProgress = 0
 		m.archiveTotal = 0
-		archiveMode := m.archiveMode // Save before clearing state
 		m.archiveState = nil
-		// Clear marks for successfully archived/unarchived repos and update status message
-		if msg.Succeeded > 0 {
-			m.clearArchivedMarks()
+		op := m.archiveMode.op()
+		// Clear marks for successfully archived/unarchived repos and update status message.
+		// Other batches didn't archive anything, so marks are left to them.
+		if msg.Succeeded > 0 && m.archiveMode.archives(m.graveyardArchive) {
+			m.clearArchivedMarks(msg.Done)
 		}
-		if archiveMode == "unarchive" {
-			if msg.Failed > 0 {
-				m.statusMessage = fmt.Sprintf("Unarchive completed: %d succeeded, %d failed", msg.Succeeded, msg.Failed)
-			} else {
-				m.statusMessage = fmt.Sprintf("Successfully unarchived %d repo%s", msg.Succeeded, pluralize(msg.Succeeded))
-			}
+		// Show per-repo failures so they can be retried; otherwise dismiss the progress modal
+		m.batchFailures = msg.Failures
+		m.resultsCursor = 0
+		if len(msg.Failures) > 0 {
+			m.activeModal = ModalResults
+		} else if m.activeModal == ModalProgress {
+			m.activeModal = ModalNone
+		}
+		if op.finish != nil {
+			op.finish(&m)
+		}
+		if msg.Cancelled {
+			m.statusMessage = fmt.Sprintf("%s cancelled: %d succeeded, %d failed, %d skipped", op.action, msg.Succeeded, msg.Failed, msg.Skipped)
+		} else if msg.Failed > 0 {
+			m.statusMessage = fmt.Sprintf("%s completed: %d %s, %d failed", op.action, msg.Succeeded, op.doneVerb, msg.Failed)
 		} else {
-			if msg.Failed > 0 {
-				m.statusMessage = fmt.Sprintf("Archive completed: %d succeeded, %d failed", msg.Succeeded, msg.Failed)
-			} else {
-				m.statusMessage = fmt.Sprintf("Successfully archived %d repo%s", msg.Succeeded, pluralize(msg.Succeeded))
-			}
+			m.statusMessage = op.done(&m, msg.Succeeded)
 		}
 		m.RefreshFilteredRepos()
-	case FabricResultMsg:
+	case ExportCompleteMsg:
 		if msg.Err != nil {
 			m.lastError = msg.Err
+			m.statusMessage = fmt.Sprintf("Export failed: %v", msg.Err)
+		} else {
+			m.statusMessage = fmt.Sprintf("Exported %d repo%s to %s", msg.Count, pluralize(msg.Count), msg.Path)
 		}
+	case ReadmeAnalyzedMsg:
+		delete(m.readmeChecking, msg.RepoName)
+		if msg.Err != nil {
+			m.lastError = msg.Err
+			m.statusMessage = fmt.Sprintf("README check failed: %v", msg.Err)
+		} else if msg.Found {
+			m.readmeSignals[msg.RepoName] = msg.Signals
+		}
+	case FabricResultMsg:
+		return m, m.handleAIResult(msg)
+	case ImpactCheckedMsg:
+		m.handleImpactChecked(msg)
+	case WorkflowStepMsg:
+		return m, m.handleWorkflowStep(msg)
 	case ErrorMsg:
 		m.lastError = msg.Err
 	case syncStartedMsg:
@@ -135,8 +160,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, tea.Batch(cmds...)
 	case spinner.TickMsg:
-		// Update spinner animation when syncing
-		if m.syncing {
+		// Update spinner animation when syncing or analyzing
+		if m.syncing || (m.aiState != nil && !m.aiState.done()) {
 			var cmd tea.Cmd
 			m.syncSpinner, cmd = m.syncSpinner.Update(msg)
 			return m, cmd
@@ -154,9 +179,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 
 			m.repos = msg.Repos
+			m.applyHumanActivity()
+			m.applyWorkflows()
 			m.lastSyncTime = time.Now()
 			m.usingCache = false
 			m.statusMessage = fmt.Sprintf("Synced %d repos", len(msg.Repos))
+			if msg.ActivityErr != nil {
+				m.statusMessage += fmt.Sprintf("; issue activity not updated: %v", msg.ActivityErr)
+			}
 			m.RefreshFilteredRepos()
 
 			// Try to restore cursor to same repo
@@ -249,6 +279,29 @@ func (m Model) handleModalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleLanguageModalKeys(msg)
 	}
 
+	// Handle batch progress and results modal keys
+	if m.activeModal == ModalProgress {
+		return m.handleProgressModalKeys(msg)
+	}
+	if m.activeModal == ModalResults {
+		return m.handleResultsModalKeys(msg)
+	}
+
+	// Handle export modal keys
+	if m.activeModal == ModalExport {
+		return m.handleExportModalKeys(msg)
+	}
+
+	// Handle edit modal keys
+	if m.activeModal == ModalEdit {
+		return m.handleEditModalKeys(msg)
+	}
+
+	// Handle AI analysis modal keys
+	if m.activeModal == ModalAnalysis {
+		return m.handleAnalysisModalKeys(msg)
+	}
+
 	switch msg.String() {
 	case "esc", "q":
 		// Close any modal
@@ -261,6 +314,22 @@ func (m Model) handleModalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.activeModal = ModalNone
 		m.selectedRepo = nil
 		return m, nil
+
+	case "r":
+		// Re-fetch the README of the repo in the detail modal
+		if m.activeModal == ModalDetail && m.selectedRepo != nil {
+			return m, m.analyzeReadme(*m.selectedRepo, true)
+		}
+
+	case "i":
+		// Analyze the repo in the detail modal with AI
+		if m.activeModal == ModalDetail && m.selectedRepo != nil {
+			repo := *m.selectedRepo
+			m.selectedRepo = nil
+			m.activeModal = ModalNone
+			cmd := m.startAIAnalysis([]github.Repository{repo})
+			return m, cmd
+		}
 	}
 
 	return m, nil
@@ -304,24 +373,88 @@ func (m Model) handleLanguageModalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 // handleConfirmModalKeys handles key input for the archive/unarchive confirmation modal.
 func (m Model) handleConfirmModalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.archiveMode == batchDelete {
+		return m.handleDeleteConfirmKeys(msg)
+	}
 	switch msg.String() {
 	case "Y", "y", "enter":
-		// Confirm archive/unarchive operation
-		m.activeModal = ModalNone
-		if m.archiveMode == "unarchive" {
-			return m, m.unarchiveMarkedRepos()
+		if m.archiveMode == batchArchive {
+			if m.impactChecking {
+				m.statusMessage = "Still checking archive impact..."
+				return m, nil
+			}
+			// Blocking impact needs an explicit yes
+			if msg.String() == "enter" && m.impactBlocking() > 0 {
+				return m, nil
+			}
+		}
+
+		// Confirm archive/unarchive operation and follow it in the progress modal
+		m.activeModal = ModalProgress
+		cmd := m.archiveMode.op().start(&m)
+		if cmd == nil {
+			m.activeModal = ModalNone
 		}
-		return m, m.archiveMarkedRepos()
+		return m, cmd
 
 	case "N", "n", "esc", "q":
 		// Cancel archive/unarchive operation
 		m.activeModal = ModalNone
-		if m.archiveMode == "unarchive" {
-			m.statusMessage = "Unarchive cancelled"
-		} else {
-			m.statusMessage = "Archive cancelled"
+		m.statusMessage = m.archiveMode.op().action + " cancelled"
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// handleProgressModalKeys handles key input while a batch operation is running.
+func (m Model) handleProgressModalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "c", "esc":
+		// Stop dispatching new repos; in-flight operations are allowed to finish
+		if m.archiveState != nil && !m.archiveState.cancelled {
+			m.archiveState.cancelled = true
+			m.statusMessage = "Cancelling... waiting for in-flight operations"
+		}
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// handleResultsModalKeys handles key input for the batch results modal.
+func (m Model) handleResultsModalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "r", "R":
+		// Retry only the repos that failed in the last batch
+		if len(m.batchFailures) == 0 {
+			return m, nil
+		}
+		repos := make([]github.Repository, len(m.batchFailures))
+		for i, f := range m.batchFailures {
+			repos[i] = f.Repo
+		}
+		m.batchFailures = nil
+		m.lastError = nil
+		m.activeModal = ModalProgress
+		return m, m.startBatch(repos)
+
+	case "j", "down":
+		if m.resultsCursor < len(m.batchFailures)-1 {
+			m.resultsCursor++
+		}
+		return m, nil
+
+	case "k", "up":
+		if m.resultsCursor > 0 {
+			m.resultsCursor--
 		}
 		return m, nil
+
+	case "esc", "q", "enter":
+		m.activeModal = ModalNone
+		m.batchFailures = nil
+		return m, nil
 	}
 
 	return m, nil
@@ -391,7 +524,7 @@ func (m Model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "a":
 		// 'a' has dual purpose:
 		// - When repos are marked: open confirm modal for archive/unarchive action
-		// - When no repos are marked: set filter to FilterAll
+		// - When no repos are marked: set filter to query.FilterAll
 		if len(m.marked) > 0 {
 			// Determine if we're archiving or unarchiving based on marked repos
 			markedRepos := m.getMarkedRepos()
@@ -406,9 +539,9 @@ func (m Model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			}
 
 			if allArchived {
-				m.archiveMode = "unarchive"
+				m.archiveMode = batchUnarchive
 			} else if allUnarchived {
-				m.archiveMode = "archive"
+				m.archiveMode = batchArchive
 			} else {
 				// Mixed state - show error
 				m.statusMessage = "Cannot mix archived and unarchived repos"
@@ -416,21 +549,117 @@ func (m Model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			}
 
 			m.activeModal = ModalConfirm
+			if m.archiveMode == batchArchive {
+				return m, m.checkImpact(markedRepos)
+			}
+			return m, nil
+		}
+		m.ApplyFilter(query.FilterAll)
+		return m, nil
+
+	case "S":
+		// Announce the archive of marked repos with a notice issue
+		if len(m.marked) == 0 {
+			m.statusMessage = "Mark repos to schedule their archive"
 			return m, nil
 		}
-		m.ApplyFilter(FilterAll)
+		for _, repo := range m.getMarkedRepos() {
+			if repo.IsArchived {
+				m.statusMessage = "Cannot schedule archived repos"
+				return m, nil
+			}
+		}
+		m.archiveMode = batchSchedule
+		m.activeModal = ModalConfirm
+		return m, nil
+
+	case "T":
+		// Move marked repos to the graveyard organization
+		if m.graveyardOwner == "" {
+			m.statusMessage = "Set REPJAN_GRAVEYARD_OWNER to transfer repos"
+			return m, nil
+		}
+		if len(m.marked) == 0 {
+			m.statusMessage = "Mark repos to transfer them"
+			return m, nil
+		}
+		for _, repo := range m.getMarkedRepos() {
+			if repo.IsArchived {
+				m.statusMessage = "Cannot transfer archived repos"
+				return m, nil
+			}
+		}
+		m.archiveMode = batchTransfer
+		m.activeModal = ModalConfirm
+		return m, nil
+
+	case "D":
+		// Permanently delete marked repos after backing them up
+		if !m.allowDelete || m.backer == nil {
+			m.statusMessage = "Set REPJAN_ALLOW_DELETE=true to delete repos"
+			return m, nil
+		}
+		if len(m.marked) == 0 {
+			m.statusMessage = "Mark repos to delete them"
+			return m, nil
+		}
+		m.archiveMode = batchDelete
+		m.deleteInput = ""
+		m.activeModal = ModalConfirm
+		return m, nil
+
+	case "P":
+		// Make marked repos private, or public if they are all private
+		if len(m.marked) == 0 {
+			m.statusMessage = "Mark repos to change their visibility"
+			return m, nil
+		}
+		allPrivate, allPublic := true, true
+		for _, repo := range m.getMarkedRepos() {
+			if repo.IsPrivate {
+				allPublic = false
+			} else {
+				allPrivate = false
+			}
+		}
+		if !allPrivate && !allPublic {
+			m.statusMessage = "Cannot mix private and public repos"
+			return m, nil
+		}
+		m.makePrivate = allPublic
+		m.archiveMode = batchVisibility
+		m.activeModal = ModalConfirm
+		return m, nil
+
+	case "W":
+		// Disable the scheduled Actions workflows of marked repos without archiving
+		if len(m.marked) == 0 {
+			m.statusMessage = "Mark repos to disable their scheduled workflows"
+			return m, nil
+		}
+		m.archiveMode = batchWorkflows
+		m.activeModal = ModalConfirm
+		return m, nil
+
+	case "E":
+		// Add or remove a topic, or set or prefix the description, of marked repos
+		m.openEditModal()
 		return m, nil
 
 	case "o":
-		m.ApplyFilter(FilterOld)
+		m.ApplyFilter(query.FilterOld)
 		return m, nil
 
 	case "n":
-		m.ApplyFilter(FilterNoStars)
+		m.ApplyFilter(query.FilterNoStars)
 		return m, nil
 
 	case "f":
-		m.ApplyFilter(FilterForks)
+		m.ApplyFilter(query.FilterForks)
+		return m, nil
+
+	case "b":
+		m.ApplyFilter(query.FilterBotOnly)
 		return m, nil
 
 	case "p":
@@ -443,6 +672,11 @@ func (m Model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.ToggleShowArchived()
 		return m, nil
 
+	case "v":
+		// Cycle the AI verdict filter
+		m.CycleVerdictFilter()
+		return m, nil
+
 	case "l":
 		// Open language filter modal
 		m.populateLanguages()
@@ -452,40 +686,40 @@ func (m Model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 	// Sorting keys
 	case "1":
-		if m.sortField == SortName {
+		if m.sortField == query.SortName {
 			m.ToggleSortDirection()
 		} else {
-			m.sortField = SortName
+			m.sortField = query.SortName
 			m.sortAscending = true
 			m.RefreshFilteredRepos()
 		}
 		return m, nil
 
 	case "2":
-		if m.sortField == SortActivity {
+		if m.sortField == query.SortActivity {
 			m.ToggleSortDirection()
 		} else {
-			m.sortField = SortActivity
+			m.sortField = query.SortActivity
 			m.sortAscending = true
 			m.RefreshFilteredRepos()
 		}
 		return m, nil
 
 	case "3":
-		if m.sortField == SortStars {
+		if m.sortField == query.SortStars {
 			m.ToggleSortDirection()
 		} else {
-			m.sortField = SortStars
+			m.sortField = query.SortStars
 			m.sortAscending = false // Default descending for stars
 			m.RefreshFilteredRepos()
 		}
 		return m, nil
 
 	case "4":
-		if m.sortField == SortLanguage {
+		if m.sortField == query.SortLanguage {
 			m.ToggleSortDirection()
 		} else {
-			m.sortField = SortLanguage
+			m.sortField = query.SortLanguage
 			m.sortAscending = true
 			m.RefreshFilteredRepos()
 		}
@@ -539,12 +773,35 @@ func (m Model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			repo := m.filteredRepos[m.cursor]
 			m.selectedRepo = &repo
 			m.activeModal = ModalDetail
+			m.loadBackup(repo)
+			if _, ok := m.readmeSignals[repo.FullName()]; !ok {
+				return m, m.analyzeReadme(repo, false)
+			}
 		}
 		return m, nil
 
+	case "i":
+		// Analyze the current repo with AI
+		if len(m.filteredRepos) > 0 && m.cursor < len(m.filteredRepos) {
+			cmd := m.startAIAnalysis([]github.Repository{m.filteredRepos[m.cursor]})
+			return m, cmd
+		}
+		return m, nil
+
+	case "I":
+		// Analyze all marked repos with AI, one at a time
+		marked := m.getMarkedRepos()
+		if len(marked) == 0 {
+			m.statusMessage = "No marked repos to analyze"
+			return m, nil
+		}
+		cmd := m.startAIAnalysis(marked)
+		return m, cmd
+
 	case "e":
-		// Export marked repos
-		return m, m.exportMarkedRepos()
+		// Open export modal to choose format, scope and destination
+		m.openExportModal()
+		return m, nil
 
 	// Meta keys
 	case "?":
@@ -623,18 +880,7 @@ func (m *Model) archiveMarkedRepos() tea.Cmd {
 		"repos", repoNames,
 	)
 
-	m.archiving = true
-	m.archiveTotal = len(toArchive)
-	m.archiveProgress = 0
-	m.archiveState = &archiveState{
-		repos:     toArchive,
-		succeeded: 0,
-		failed:    0,
-		errors:    nil,
-	}
-
-	// Start archiving the first repo
-	return archiveNextRepo(m.client, toArchive, 0, m.archiveState)
+	return m.startBatch(toArchive)
 }
 
 // unarchiveMarkedRepos returns a command to unarchive all marked repositories.
@@ -671,33 +917,47 @@ func (m *Model) unarchiveMarkedRepos() tea.Cmd {
 		"repos", repoNames,
 	)
 
+	return m.startBatch(toUnarchive)
+}
+
+// startBatch initializes batch state for the given repos and starts up to
+// batchWorkers operations concurrently, using the current archiveMode.
+func (m *Model) startBatch(repos []github.Repository) tea.Cmd {
 	m.archiving = true
-	m.archiveTotal = len(toUnarchive)
+	m.archiveTotal = len(repos)
 	m.archiveProgress = 0
 	m.archiveState = &archiveState{
-		repos:     toUnarchive,
-		succeeded: 0,
-		failed:    0,
-		errors:    nil,
+		repos: repos,
+	}
+	if prepare := m.archiveMode.op().prepare; prepare != nil {
+		prepare(m, m.archiveState)
+	}
+	if m.usesWorkflow() {
+		m.archiveState.runner = workflow.NewRunner(m.client, m.store, m.workflow)
+		m.archiveState.runner.SetBackup(m.backer)
+		m.archiveState.stepResults = make(map[string][]workflow.Result)
 	}
 
-	// Start unarchiving the first repo
-	return unarchiveNextRepo(m.client, toUnarchive, 0, m.archiveState)
-}
+	workers := min(max(m.batchWorkers, 1), len(repos))
+	slog.Debug("starting batch workers",
+		"component", "tui",
+		"workers", workers,
+		"archiveMode", m.archiveMode.String(),
+	)
 
-// exportMarkedRepos returns a command to export marked repositories.
-func (m *Model) exportMarkedRepos() tea.Cmd {
-	if len(m.marked) == 0 {
-		m.statusMessage = "No repositories marked for export"
-		return nil
+	cmds := make([]tea.Cmd, 0, workers)
+	for i := 0; i < workers; i++ {
+		cmds = append(cmds, m.dispatchNextRepo())
 	}
+	return tea.Batch(cmds...)
+}
 
-	// Return a command that will handle the export operation
-	return func() tea.Msg {
-		// This is a placeholder - the actual export logic will be implemented
-		// and will write to a file or stdout
-		return nil
-	}
+// dispatchNextRepo returns a command processing the next queued repo and advances the queue.
+func (m *Model) dispatchNextRepo() tea.Cmd {
+	state := m.archiveState
+	index := state.next
+	state.next++
+	return m.archiveMode.op().next(m, state, index)
 }
 
 // markRepoAsArchived updates a repo's IsArchived field in the model.
@@ -710,6 +970,19 @@ func (m *Model) markRepoAsArchived(fullName string) {
 	}
 }
 
+// removeRepo drops a repo that was transferred or deleted from the list and
+// its marks. The store was updated by the operation itself.
+func (m *Model) removeRepo(fullName string) {
+	delete(m.marked, fullName)
+	for i := range m.repos {
+		if m.repos[i].FullName() == fullName {
+			m.repos = append(m.repos[:i], m.repos[i+1:]...)
+			break
+		}
+	}
+	m.RefreshFilteredRepos()
+}
+
 // markRepoAsUnarchived updates a repo's IsArchived field to false in the model.
 func (m *Model) markRepoAsUnarchived(fullName string) {
 	for i := range m.repos {
@@ -720,33 +993,27 @@ func (m *Model) markRepoAsUnarchived(fullName string) {
 	}
 }
 
-// clearArchivedMarks removes marks from repos that have been archived or unarchived.
-// It also updates the database: removes marks and updates is_archived flag.
-// For archive mode: clears marks from repos where IsArchived == true
-// For unarchive mode: clears marks from repos where IsArchived == false
-func (m *Model) clearArchivedMarks() {
-	for key := range m.marked {
+// clearArchivedMarks removes the marks of the repos a batch archived or
+// unarchived and records the change. Only the repos the batch reported as
+// succeeded are touched, so marked repos that were already archived don't get
+// a fresh history entry. The database is updated too: marks are removed and
+// the is_archived flag is saved.
+func (m *Model) clearArchivedMarks(done []string) {
+	action := "archived"
+	if m.archiveMode == batchUnarchive {
+		action = "unarchived"
+	}
+	for _, key := range done {
 		for i, repo := range m.repos {
 			if repo.FullName() != key {
 				continue
 			}
-			// For archive mode, check if repo is now archived
-			// For unarchive mode, check if repo is now unarchived
-			shouldClear := false
-			if m.archiveMode == "unarchive" {
-				shouldClear = !repo.IsArchived
-			} else {
-				shouldClear = repo.IsArchived
-			}
-
-			if shouldClear {
-				delete(m.marked, key)
-				// Remove from database marked_repos
-				if m.store != nil {
-					_ = m.store.RemoveMarkedRepo(m.owner, repo.Name)
-					// Update is_archived in repositories table
-					_ = m.store.UpdateRepository(m.repos[i])
-				}
+			delete(m.marked, key)
+			if m.store != nil {
+				_ = m.store.RemoveMarkedRepo(m.owner, repo.Name)
+				_ = m.store.UpdateRepository(m.repos[i])
+				// Record the change so reports can list archives by period
+				_ = m.store.RecordRepoChange(m.owner, repo.Name, action, "user", nil, nil, "")
 			}
 			break
 		}
@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/charmbracelet/lipgloss"
+
+	"github.com/llbbl/repjan/internal/query"
 )
 
 // View implements tea.Model and renders the complete TUI.
@@ -61,6 +63,16 @@ func (m Model) View() string {
 			modalContent = m.renderHelpModal()
 		case ModalLanguage:
 			modalContent = m.renderLanguageModal()
+		case ModalProgress:
+			modalContent = m.renderProgressModal()
+		case ModalResults:
+			modalContent = m.renderResultsModal()
+		case ModalExport:
+			modalContent = m.renderExportModal()
+		case ModalAnalysis:
+			modalContent = m.renderAnalysisModal()
+		case ModalEdit:
+			modalContent = m.renderEditModal()
 		default:
 			modalContent = m.styles.ModalBorder.Render("Unknown modal")
 		}
@@ -101,7 +113,7 @@ func (m Model) renderSortBar() string {
 	}
 
 	// Build filter line
-	filterNames := map[Filter]string{FilterAll: "All", FilterOld: "Old", FilterNoStars: "NoStars", FilterForks: "Forks"}
+	filterNames := map[query.Filter]string{query.FilterAll: "All", query.FilterOld: "Old", query.FilterNoStars: "NoStars", query.FilterForks: "Forks", query.FilterBotOnly: "BotOnly"}
 	privateStr := "[P]rivate"
 	if m.showPrivate {
 		// Style the PRIVATE indicator with bold red to make it obvious
@@ -112,17 +124,21 @@ func (m Model) renderSortBar() string {
 		// Style with light blue to indicate archived repos are visible
 		archivedStr = lipgloss.NewStyle().Foreground(lipgloss.Color("#87CEEB")).Bold(true).Render("[X]+Archived")
 	}
-	filterLine := fmt.Sprintf("%s | Filter: %s | %s %s", m.getVisibilityLabel(), filterNames[m.currentFilter], privateStr, archivedStr)
+	verdictStr := "[V]erdict"
+	if m.verdictFilter != "" {
+		verdictStr = m.styles.ActiveFilter.Render("[V]erdict: " + m.verdictFilter.Short())
+	}
+	filterLine := fmt.Sprintf("%s | Filter: %s | %s %s %s", m.getVisibilityLabel(), filterNames[m.currentFilter], privateStr, archivedStr, verdictStr)
 
 	sortOptions := []struct {
 		key   string
 		label string
-		field SortField
+		field query.SortField
 	}{
-		{"1", "Name", SortName},
-		{"2", "Activity", SortActivity},
-		{"3", "Stars", SortStars},
-		{"4", "Language", SortLanguage},
+		{"1", "Name", query.SortName},
+		{"2", "Activity", query.SortActivity},
+		{"3", "Stars", query.SortStars},
+		{"4", "Language", query.SortLanguage},
 	}
 
 	var parts []string
@@ -163,15 +179,21 @@ func (m Model) renderTableHeader() string {
 	starsWidth := 8
 	langWidth := 12
 	pushWidth := 12
+	openWidth := 5
 	statusWidth := 10
+	verdictWidth := 7
+	predWidth := 4
 	markWidth := 6
 
-	header := fmt.Sprintf("%-*s | %*s | %-*s | %-*s | %-*s | %-*s",
+	header := fmt.Sprintf("%-*s | %*s | %-*s | %-*s | %*s | %-*s | %-*s | %*s | %-*s",
 		nameWidth, "NAME",
 		starsWidth, "STARS",
 		langWidth, "LANG",
 		pushWidth, "LAST PUSH",
+		openWidth, "OPEN",
 		statusWidth, "STATUS",
+		verdictWidth, "AI",
+		predWidth, "PRED",
 		markWidth, "MARK",
 	)
 
@@ -244,14 +266,24 @@ func (m Model) renderTableBody() string {
 		status := "active"
 		if repo.IsArchived {
 			status = "archived"
+		} else if m.assess(repo).Candidate {
+			status = "candidate"
 		}
 
-		row := fmt.Sprintf("%-30s | %8d | %-12s | %-12s | %-10s | %-6s",
+		verdict := "-"
+		if v, ok := m.verdicts[repoKey]; ok {
+			verdict = v.Decision.Short()
+		}
+
+		row := fmt.Sprintf("%-30s | %8d | %-12s | %-12s | %5s | %-10s | %-7s | %4s | %-6s",
 			truncateString(repo.Name, 30),
 			repo.StargazerCount,
 			truncateString(lang, 12),
 			lastPush,
+			openItemsLabel(repo),
 			status,
+			verdict,
+			m.predictionLabel(repo),
 			markIndicator,
 		)
 
@@ -308,11 +340,7 @@ func (m Model) renderStatusBar() string {
 
 	// Show archiving/unarchiving progress if in progress (takes priority)
 	if m.archiving {
-		action := "Archiving"
-		if m.archiveMode == "unarchive" {
-			action = "Unarchiving"
-		}
-		archiveStatus := fmt.Sprintf("%s %d/%d repositories...", action, m.archiveProgress, m.archiveTotal)
+		archiveStatus := fmt.Sprintf("%s %d/%d repositories...", m.archiveMode.op().progress, m.archiveProgress, m.archiveTotal)
 		parts = append(parts, m.styles.Warning.Render(archiveStatus))
 	} else if m.syncing {
 		// Show animated syncing indicator
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/visibility"
+)
+
+// changeVisibilityOfMarkedRepos starts a batch making each marked repo
+// private or public, as chosen when the confirm modal was opened.
+func (m *Model) changeVisibilityOfMarkedRepos() tea.Cmd {
+	repos := m.getMarkedRepos()
+	if len(repos) == 0 {
+		m.statusMessage = "No repos marked"
+		return nil
+	}
+	return m.startBatch(repos)
+}
+
+// renderVisibilityWarning lists the marked repos that lose stars or forks
+// by being made private.
+func (m Model) renderVisibilityWarning(repos []github.Repository) []string {
+	affected := visibility.Affected(repos, m.makePrivate)
+	if len(affected) == 0 {
+		return nil
+	}
+	lines := []string{m.styles.Warning.Render(fmt.Sprintf("%d repo%s lose stars and watchers, and forks are detached:", len(affected), pluralize(len(affected))))}
+	for i, repo := range affected {
+		if i == maxReposToShow {
+			lines = append(lines, fmt.Sprintf("  ... (%d more)", len(affected)-maxReposToShow))
+			break
+		}
+		lines = append(lines, fmt.Sprintf("  ! %s (%s)", repo.FullName(), visibility.Impact(repo)))
+	}
+	return lines
+}
+
+// markRepoVisibility updates a repo's IsPrivate field in the model.
+func (m *Model) markRepoVisibility(fullName string, private bool) {
+	for i := range m.repos {
+		if m.repos[i].FullName() == fullName {
+			m.repos[i].IsPrivate = private
+			break
+		}
+	}
+	m.RefreshFilteredRepos()
+}
+
+// visibilityNextRepo returns a command changing the visibility of the
+// repository at current.
+func visibilityNextRepo(changer *visibility.Changer, repos []github.Repository, current int, private bool, state *archiveState) tea.Cmd {
+	return func() tea.Msg {
+		repo := repos[current]
+		_, err := changer.Set(repo, private)
+		if err != nil {
+			slog.Debug("visibility change failed", "component", "tui", "repo", repo.FullName(), "err", err)
+		}
+		state.recordResult(repo, err)
+
+		return ArchiveProgressMsg{
+			Current:  current + 1,
+			Total:    len(repos),
+			RepoName: repo.FullName(),
+			Err:      err,
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+func TestVisibilityChange(t *testing.T) {
+	repos := []github.Repository{
+		testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("popular"), testutil.WithStars(12), testutil.WithForks(0)),
+		testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("quiet"), testutil.WithStars(0), testutil.WithForks(0)),
+	}
+	s := testutil.NewStore(t)
+	require.NoError(t, s.UpsertRepositories("acme", repos))
+	executor := testutil.NewMockExecutor()
+	m := NewModelWithStore(repos, "acme", github.NewClient(executor), s, false, "", nil)
+	m.marked["acme/popular"] = true
+	m.marked["acme/quiet"] = true
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("P")})
+	m = updated.(Model)
+	require.Equal(t, ModalConfirm, m.activeModal)
+	assert.True(t, m.makePrivate)
+	modal := m.renderConfirmModal()
+	assert.Contains(t, modal, "make 2 repos private")
+	assert.Contains(t, modal, "acme/popular (12 stars, 0 forks)")
+	assert.NotContains(t, modal, "acme/quiet (")
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	m = updated.(Model)
+	require.NotNil(t, cmd)
+	require.NotNil(t, m.archiveState.changer)
+
+	state := m.archiveState
+	msg := visibilityNextRepo(state.changer, state.repos, 0, true, state)()
+	updated, _ = m.Update(msg)
+	m = updated.(Model)
+	for _, repo := range m.repos {
+		assert.Equal(t, repo.Name == "popular", repo.IsPrivate, repo.Name)
+	}
+	assert.Equal(t, []string{"gh", "repo", "edit", "acme/popular", "--visibility", "private", "--accept-visibility-change-consequences"}, executor.GetCall(0))
+
+	changes, err := s.GetChangesByAction("acme", "visibility_changed", 10)
+	require.NoError(t, err)
+	assert.Len(t, changes, 1)
+}
+
+func TestVisibilityChange_Mixed(t *testing.T) {
+	repos := []github.Repository{
+		testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("open")),
+		testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("secret"), testutil.WithPrivate(true)),
+	}
+	m := NewModel(repos, "acme", nil, false, "", nil)
+	m.marked["acme/open"] = true
+	m.marked["acme/secret"] = true
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("P")})
+	m = updated.(Model)
+	assert.Equal(t, ModalNone, m.activeModal)
+	assert.Equal(t, "Cannot mix private and public repos", m.statusMessage)
+
+	delete(m.marked, "acme/open")
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("P")})
+	m = updated.(Model)
+	assert.Equal(t, ModalConfirm, m.activeModal)
+	assert.False(t, m.makePrivate)
+	assert.Contains(t, m.renderConfirmModal(), "make 1 repo public")
+}
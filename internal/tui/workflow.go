@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/workflow"
+)
+
+// maxWorkflowRows is the number of repos whose step statuses the progress modal shows.
+const maxWorkflowRows = 6
+
+// WorkflowStepMsg is sent when one archive workflow step finishes for a repo.
+type WorkflowStepMsg struct {
+	Index  int // the repo's queue position
+	Step   int // the step's position in the workflow
+	Repo   github.Repository
+	Result workflow.Result
+}
+
+// SetArchiveWorkflow makes archive batches run steps for each repo instead
+// of only archiving it.
+func (m *Model) SetArchiveWorkflow(steps []workflow.Step) {
+	m.workflow = steps
+}
+
+// usesWorkflow reports whether the current batch runs the archive workflow.
+func (m Model) usesWorkflow() bool {
+	return m.archiveMode == batchArchive && len(m.workflow) > 0
+}
+
+// runWorkflowStep returns a command that runs one workflow step for repo.
+func runWorkflowStep(runner *workflow.Runner, repo github.Repository, index, step int) tea.Cmd {
+	return func() tea.Msg {
+		slog.Debug("running workflow step",
+			"component", "tui",
+			"repo", repo.FullName(),
+			"step", runner.Steps()[step].String(),
+		)
+		return WorkflowStepMsg{Index: index, Step: step, Repo: repo, Result: runner.Run(repo, step)}
+	}
+}
+
+// handleWorkflowStep records a step's outcome and runs the repo's next step.
+// After the last step, or a failed one, the repo is reported like any other
+// batch operation through ArchiveProgressMsg.
+func (m *Model) handleWorkflowStep(msg WorkflowStepMsg) tea.Cmd {
+	state := m.archiveState
+	if state == nil || state.runner == nil {
+		return nil
+	}
+	name := msg.Repo.FullName()
+	state.stepResults[name] = append(state.stepResults[name], msg.Result)
+
+	err := msg.Result.Err
+	if err == nil && msg.Step+1 < len(state.runner.Steps()) {
+		return runWorkflowStep(state.runner, msg.Repo, msg.Index, msg.Step+1)
+	}
+	if err == nil {
+		if ferr := state.runner.Finish(msg.Repo); ferr != nil {
+			slog.Warn("failed to clear workflow progress", "component", "tui", "repo", name, "error", ferr)
+		}
+	}
+	state.recordResult(msg.Repo, err)
+
+	progress := ArchiveProgressMsg{
+		Current:  msg.Index + 1,
+		Total:    len(state.repos),
+		RepoName: name,
+		Err:      err,
+	}
+	return func() tea.Msg { return progress }
+}
+
+// renderWorkflowSteps renders the step statuses of the most recently
+// dispatched repos for the progress modal.
+func (m Model) renderWorkflowSteps() []string {
+	state := m.archiveState
+	if state == nil || state.runner == nil {
+		return nil
+	}
+	steps := state.runner.Steps()
+
+	lines := []string{"Steps:      " + strings.Join(stepNames(steps), " → ")}
+	start := max(0, state.next-maxWorkflowRows)
+	for _, repo := range state.repos[start:state.next] {
+		results := state.stepResults[repo.FullName()]
+		parts := make([]string, 0, len(steps))
+		for i, step := range steps {
+			parts = append(parts, m.stepSymbol(results, i)+" "+step.Kind)
+		}
+		lines = append(lines, fmt.Sprintf("  %-24s %s", truncateString(repo.Name, 24), strings.Join(parts, " ")))
+	}
+	lines = append(lines, m.styles.HelpDesc.Render("  ✓ done  ○ nothing to do  ✖ failed  ▸ running"))
+	return lines
+}
+
+// stepSymbol returns the status symbol of step i given a repo's results so far.
+func (m Model) stepSymbol(results []workflow.Result, i int) string {
+	if i < len(results) {
+		switch results[i].Status {
+		case workflow.StatusDone:
+			return m.styles.Success.Render("✓")
+		case workflow.StatusSkipped:
+			return "○"
+		default:
+			return m.styles.Error.Render("✖")
+		}
+	}
+	if i == len(results) && (i == 0 || results[i-1].Err == nil) {
+		return "▸"
+	}
+	return "·"
+}
+
+// stepNames returns the configured form of each step.
+func stepNames(steps []workflow.Step) []string {
+	names := make([]string, len(steps))
+	for i, step := range steps {
+		names[i] = step.String()
+	}
+	return names
+}
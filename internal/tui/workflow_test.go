@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tui
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/testutil"
+	"github.com/llbbl/repjan/internal/workflow"
+)
+
+// workflowClient returns a client for an unarchived repo without topics
+// whose topic edits fail when failTopic is set.
+func workflowClient(failTopic bool) *github.Client {
+	mockExec := testutil.NewMockExecutor()
+	mockExec.ExecuteFunc = func(name string, args ...string) ([]byte, error) {
+		switch {
+		case args[0] == "repo" && args[1] == "view":
+			return []byte(`{"description":"A widget","isArchived":false,"topics":[]}`), nil
+		case args[0] == "repo" && args[1] == "edit" && failTopic:
+			return nil, errors.New("HTTP 403: Forbidden")
+		}
+		return nil, nil
+	}
+	return github.NewClient(mockExec)
+}
+
+func TestWorkflow_RunsStepsThenReportsProgress(t *testing.T) {
+	repo := testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("widget"))
+	m := NewModel([]github.Repository{repo}, "acme", workflowClient(false), false, "", nil)
+	m.SetArchiveWorkflow([]workflow.Step{{Kind: workflow.KindTopic}, {Kind: workflow.KindArchive}})
+	m.archiveMode = batchArchive
+
+	cmd := m.startBatch([]github.Repository{repo})
+	require.NotNil(t, m.archiveState.runner)
+
+	msg := cmd()
+	step, ok := msg.(WorkflowStepMsg)
+	require.True(t, ok, "expected WorkflowStepMsg, got %T", msg)
+	assert.Equal(t, 0, step.Step)
+	assert.Equal(t, workflow.StatusDone, step.Result.Status)
+
+	newModel, cmd := m.Update(step)
+	m = newModel.(Model)
+	assert.Contains(t, m.renderProgressModal(), "▸ archive")
+
+	msg = cmd()
+	step, ok = msg.(WorkflowStepMsg)
+	require.True(t, ok, "expected WorkflowStepMsg, got %T", msg)
+	assert.Equal(t, 1, step.Step)
+
+	_, cmd = m.Update(step)
+	progress, ok := cmd().(ArchiveProgressMsg)
+	require.True(t, ok, "expected ArchiveProgressMsg")
+	assert.Equal(t, "acme/widget", progress.RepoName)
+	assert.NoError(t, progress.Err)
+
+	succeeded, failed := m.archiveState.counts()
+	assert.Equal(t, 1, succeeded)
+	assert.Equal(t, 0, failed)
+	assert.Len(t, m.archiveState.stepResults["acme/widget"], 2)
+}
+
+func TestWorkflow_FailedStepStopsRepo(t *testing.T) {
+	repo := testutil.NewTestRepo(testutil.WithOwner("acme"), testutil.WithName("widget"))
+	m := NewModel([]github.Repository{repo}, "acme", workflowClient(true), false, "", nil)
+	m.SetArchiveWorkflow([]workflow.Step{{Kind: workflow.KindTopic}, {Kind: workflow.KindArchive}})
+	m.archiveMode = batchArchive
+
+	cmd := m.startBatch([]github.Repository{repo})
+	newModel, cmd := m.Update(cmd())
+	m = newModel.(Model)
+	assert.Contains(t, m.renderProgressModal(), "· archive")
+
+	progress, ok := cmd().(ArchiveProgressMsg)
+	require.True(t, ok, "expected ArchiveProgressMsg")
+	assert.ErrorContains(t, progress.Err, "topic")
+
+	_, failed := m.archiveState.counts()
+	assert.Equal(t, 1, failed)
+}
+
+func TestWorkflow_NotUsedForUnarchive(t *testing.T) {
+	m := NewModel(nil, "acme", workflowClient(false), false, "", nil)
+	m.SetArchiveWorkflow([]workflow.Step{{Kind: workflow.KindTopic}, {Kind: workflow.KindArchive}})
+
+	m.archiveMode = batchUnarchive
+	assert.False(t, m.usesWorkflow())
+	m.archiveMode = batchArchive
+	assert.True(t, m.usesWorkflow())
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package visibility makes repositories private or public and records the
+// change.
+package visibility
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+// ActionVisibilityChanged is the repo_changes action recorded for a change.
+const ActionVisibilityChanged = "visibility_changed"
+
+// Client is the GitHub access changing visibility needs.
+type Client interface {
+	SetVisibility(owner, name string, private bool) error
+}
+
+// Name returns "private" or "public".
+func Name(private bool) string {
+	if private {
+		return "private"
+	}
+	return "public"
+}
+
+// Affected returns the repos that lose something when made private: those
+// with stars, which are removed along with watchers, or forks, which are
+// detached. Nothing is lost by making a repository public.
+func Affected(repos []github.Repository, private bool) []github.Repository {
+	if !private {
+		return nil
+	}
+	var affected []github.Repository
+	for _, repo := range repos {
+		if !repo.IsPrivate && (repo.StargazerCount > 0 || repo.ForkCount > 0) {
+			affected = append(affected, repo)
+		}
+	}
+	return affected
+}
+
+// Impact describes what making repo private costs, e.g. "3 stars, 1 fork".
+func Impact(repo github.Repository) string {
+	return fmt.Sprintf("%d star%s, %d fork%s",
+		repo.StargazerCount, plural(repo.StargazerCount), repo.ForkCount, plural(repo.ForkCount))
+}
+
+// Changer changes the visibility of repositories and keeps the store in step.
+type Changer struct {
+	client Client
+	store  *store.Store
+}
+
+// New creates a changer. The store may be nil.
+func New(client Client, s *store.Store) *Changer {
+	return &Changer{client: client, store: s}
+}
+
+// Set makes repo private or public. It reports false without calling
+// GitHub when the repository already has that visibility.
+func (c *Changer) Set(repo github.Repository, private bool) (bool, error) {
+	if repo.IsPrivate == private {
+		return false, nil
+	}
+	if err := c.client.SetVisibility(repo.Owner, repo.Name, private); err != nil {
+		return false, err
+	}
+	if c.store == nil {
+		return true, nil
+	}
+
+	note := ""
+	if private && (repo.StargazerCount > 0 || repo.ForkCount > 0) {
+		note = "had " + Impact(repo)
+	}
+	prev := map[string]string{"visibility": Name(repo.IsPrivate)}
+	next := map[string]string{"visibility": Name(private)}
+	if err := c.store.RecordRepoChange(repo.Owner, repo.Name, ActionVisibilityChanged, "user", prev, next, note); err != nil {
+		slog.Warn("failed to record visibility change", "component", "visibility", "repo", repo.FullName(), "error", err)
+	}
+	stored, err := c.store.GetRepository(repo.Owner, repo.Name)
+	if err == nil {
+		stored.IsPrivate = private
+		err = c.store.UpdateRepository(*stored)
+	}
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		slog.Warn("failed to update repo visibility", "component", "visibility", "repo", repo.FullName(), "error", err)
+	}
+	return true, nil
+}
+
+// plural returns "s" unless n is 1.
+func plural(n int) string {
+	if n == 1 {
+		return ""
+	}
+	return "s"
+}
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package visibility
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+// fakeClient records visibility changes.
+type fakeClient struct {
+	calls []string
+	err   error
+}
+
+func (c *fakeClient) SetVisibility(owner, name string, private bool) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.calls = append(c.calls, owner+"/"+name+" "+Name(private))
+	return nil
+}
+
+func TestAffected(t *testing.T) {
+	repos := []github.Repository{
+		{Name: "starred", StargazerCount: 3},
+		{Name: "forked", ForkCount: 1},
+		{Name: "quiet"},
+		{Name: "secret", IsPrivate: true, StargazerCount: 2},
+	}
+
+	affected := Affected(repos, true)
+	require.Len(t, affected, 2)
+	assert.Equal(t, "starred", affected[0].Name)
+	assert.Equal(t, "forked", affected[1].Name)
+	assert.Equal(t, "0 stars, 1 fork", Impact(affected[1]))
+	assert.Empty(t, Affected(repos, false))
+}
+
+func TestSet(t *testing.T) {
+	client := &fakeClient{}
+	s := testutil.NewStore(t)
+	repo := github.Repository{Owner: "acme", Name: "widget", StargazerCount: 1}
+	require.NoError(t, s.UpsertRepositories("acme", []github.Repository{repo}))
+
+	changed, err := New(client, s).Set(repo, true)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, []string{"acme/widget private"}, client.calls)
+
+	stored, err := s.GetRepository("acme", "widget")
+	require.NoError(t, err)
+	assert.True(t, stored.IsPrivate)
+
+	changes, err := s.GetChangesByAction("acme", ActionVisibilityChanged, 10)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "had 1 star, 0 forks", changes[0].Notes)
+	var prev, next map[string]string
+	require.NoError(t, json.Unmarshal([]byte(changes[0].PreviousState), &prev))
+	require.NoError(t, json.Unmarshal([]byte(changes[0].NewState), &next))
+	assert.Equal(t, "public", prev["visibility"])
+	assert.Equal(t, "private", next["visibility"])
+
+	// A repo that already has the visibility is left alone
+	changed, err = New(client, s).Set(*stored, true)
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Len(t, client.calls, 1)
+}
+
+func TestSet_Error(t *testing.T) {
+	s := testutil.NewStore(t)
+	repo := github.Repository{Owner: "acme", Name: "widget"}
+	require.NoError(t, s.UpsertRepositories("acme", []github.Repository{repo}))
+
+	_, err := New(&fakeClient{err: errors.New("HTTP 403")}, s).Set(repo, true)
+	assert.Error(t, err)
+	stored, err := s.GetRepository("acme", "widget")
+	require.NoError(t, err)
+	assert.False(t, stored.IsPrivate)
+}
@@ -0,0 +1,350 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package workflow runs the ordered, configurable steps that prepare a
+// repository for archiving and then archive it.
+package workflow
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/llbbl/repjan/internal/backup"
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/store"
+)
+
+// Step kinds.
+const (
+	KindBackup       = "backup"        // back the repository up locally
+	KindTopic        = "topic"         // add a topic
+	KindDescription  = "description"   // prepend a notice to the description
+	KindClosePRs     = "close-prs"     // comment on and close open pull requests
+	KindReadmeBanner = "readme-banner" // commit a banner to the top of the README
+	KindArchive      = "archive"       // archive the repository
+)
+
+// ActionWorkflowStep is the change history action recorded for each step
+// that modified a repository.
+const ActionWorkflowStep = "workflow_step"
+
+// defaultArgs are the arguments of steps configured without one.
+var defaultArgs = map[string]string{
+	KindBackup:       "",
+	KindTopic:        "deprecated",
+	KindDescription:  "[DEPRECATED]",
+	KindClosePRs:     "This repository is being archived, so open pull requests are being closed.",
+	KindReadmeBanner: "> **Deprecated:** this repository is no longer maintained and will be archived.",
+	KindArchive:      "",
+}
+
+// DefaultSteps archives a repository without any preparation.
+var DefaultSteps = []Step{{Kind: KindArchive}}
+
+// Step is one configured workflow step.
+type Step struct {
+	Kind string
+	Arg  string // topic, notice, comment or banner; empty for the default
+}
+
+// String returns the step as configured, e.g. "topic:deprecated".
+func (s Step) String() string {
+	if s.Arg == "" {
+		return s.Kind
+	}
+	return s.Kind + ":" + s.Arg
+}
+
+// arg returns the step's argument or its default.
+func (s Step) arg() string {
+	if s.Arg != "" {
+		return s.Arg
+	}
+	return defaultArgs[s.Kind]
+}
+
+// Parse reads a comma-separated list of steps such as
+// "topic:deprecated,description:[DEPRECATED],close-prs,archive". Each step
+// is a kind optionally followed by ':' and an argument, which cannot contain
+// commas. The workflow must end with archive, since an archived repository
+// is read-only. An empty spec returns DefaultSteps.
+func Parse(spec string) ([]Step, error) {
+	var steps []Step
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		kind, arg, _ := strings.Cut(item, ":")
+		kind = strings.ToLower(strings.TrimSpace(kind))
+		if _, ok := defaultArgs[kind]; !ok {
+			return nil, fmt.Errorf("unknown workflow step %q", kind)
+		}
+		if (kind == KindArchive || kind == KindBackup) && arg != "" {
+			return nil, fmt.Errorf("workflow step %s takes no argument", kind)
+		}
+		steps = append(steps, Step{Kind: kind, Arg: strings.TrimSpace(arg)})
+	}
+
+	if len(steps) == 0 {
+		return DefaultSteps, nil
+	}
+	for i, step := range steps {
+		if step.Kind == KindArchive && i != len(steps)-1 {
+			return nil, fmt.Errorf("workflow step archive must be last")
+		}
+	}
+	if steps[len(steps)-1].Kind != KindArchive {
+		return nil, fmt.Errorf("workflow must end with archive")
+	}
+	return steps, nil
+}
+
+// WithBackup returns steps with a backup step first, unless they already
+// back the repository up.
+func WithBackup(steps []Step) []Step {
+	for _, step := range steps {
+		if step.Kind == KindBackup {
+			return steps
+		}
+	}
+	return append([]Step{{Kind: KindBackup}}, steps...)
+}
+
+// Status is the outcome of running a step.
+type Status int
+
+const (
+	// StatusDone means the step modified the repository.
+	StatusDone Status = iota
+	// StatusSkipped means there was nothing to do, either because the
+	// repository was already in the desired state or an earlier run
+	// completed the step.
+	StatusSkipped
+	// StatusFailed means the step returned an error.
+	StatusFailed
+)
+
+// Result is the outcome of one step for one repository.
+type Result struct {
+	Step   Step
+	Status Status
+	Detail string // what was done or why the step was skipped
+	Err    error
+}
+
+// Client is the GitHub access the workflow steps need.
+type Client interface {
+	FetchRepoSettings(owner, name string) (github.RepoSettings, error)
+	AddTopic(owner, name, topic string) error
+	SetDescription(owner, name, description string) error
+	ListOpenPullRequests(owner, name string) ([]int, error)
+	ClosePullRequest(owner, name string, number int, comment string) error
+	FetchReadmeFile(owner, name string) (*github.ReadmeFile, error)
+	UpdateFile(owner, name, path, sha, content, message string) error
+	ArchiveRepository(owner, name string) error
+}
+
+// Runner runs a workflow's steps against repositories.
+type Runner struct {
+	client Client
+	store  *store.Store
+	steps  []Step
+	backer *backup.Backer
+}
+
+// NewRunner creates a runner for steps. s may be nil, in which case
+// progress is not persisted and steps are not audited; every step still
+// checks the repository first, so rerunning is safe.
+func NewRunner(client Client, s *store.Store, steps []Step) *Runner {
+	return &Runner{client: client, store: s, steps: steps}
+}
+
+// SetBackup sets where the backup step backs repositories up. Without it
+// the backup step fails.
+func (r *Runner) SetBackup(b *backup.Backer) {
+	r.backer = b
+}
+
+// Steps returns the steps of the workflow.
+func (r *Runner) Steps() []Step {
+	return r.steps
+}
+
+// Run runs step index of the workflow for repo. A step completed by an
+// earlier, interrupted run is skipped. Completed steps are persisted, and
+// steps that modified the repository are recorded in the change history.
+func (r *Runner) Run(repo github.Repository, index int) Result {
+	step := r.steps[index]
+	result := Result{Step: step}
+
+	if r.store != nil {
+		completed, err := r.store.GetCompletedWorkflowSteps(repo.Owner, repo.Name)
+		if err != nil {
+			slog.Warn("failed to load workflow progress", "component", "workflow", "repo", repo.FullName(), "error", err)
+		}
+		if completed[step.String()] {
+			result.Status = StatusSkipped
+			result.Detail = "completed in an earlier run"
+			return result
+		}
+	}
+
+	detail, changed, err := r.run(repo, step)
+	if err != nil {
+		slog.Debug("workflow step failed", "component", "workflow", "repo", repo.FullName(), "step", step.String(), "error", err)
+		result.Status = StatusFailed
+		result.Err = fmt.Errorf("%s: %w", step.Kind, err)
+		return result
+	}
+	result.Detail = detail
+	result.Status = StatusSkipped
+	if changed {
+		result.Status = StatusDone
+	}
+
+	if r.store != nil {
+		if err := r.store.CompleteWorkflowStep(repo.Owner, repo.Name, step.String()); err != nil {
+			slog.Warn("failed to save workflow progress", "component", "workflow", "repo", repo.FullName(), "error", err)
+		}
+		if changed {
+			newState := map[string]string{"step": step.String()}
+			if err := r.store.RecordRepoChange(repo.Owner, repo.Name, ActionWorkflowStep, "user", nil, newState, detail); err != nil {
+				slog.Warn("failed to record workflow step", "component", "workflow", "repo", repo.FullName(), "error", err)
+			}
+		}
+	}
+	return result
+}
+
+// Finish forgets the persisted progress of a repository whose workflow
+// completed, so a later workflow starts from the first step.
+func (r *Runner) Finish(repo github.Repository) error {
+	if r.store == nil {
+		return nil
+	}
+	return r.store.ClearWorkflowSteps(repo.Owner, repo.Name)
+}
+
+// run performs step, returning a description of the outcome and whether
+// the repository was modified.
+func (r *Runner) run(repo github.Repository, step Step) (string, bool, error) {
+	switch step.Kind {
+	case KindBackup:
+		return r.backup(repo)
+	case KindTopic:
+		return r.addTopic(repo, step.arg())
+	case KindDescription:
+		return r.prependDescription(repo, step.arg())
+	case KindClosePRs:
+		return r.closePullRequests(repo, step.arg())
+	case KindReadmeBanner:
+		return r.addReadmeBanner(repo, step.arg())
+	case KindArchive:
+		return r.archive(repo)
+	}
+	return "", false, fmt.Errorf("unknown workflow step %q", step.Kind)
+}
+
+func (r *Runner) backup(repo github.Repository) (string, bool, error) {
+	if r.backer == nil {
+		return "", false, fmt.Errorf("no backup directory configured")
+	}
+	meta, err := r.backer.Backup(repo)
+	if err != nil {
+		return "", false, err
+	}
+	if meta.Path == "" {
+		return "saved metadata of empty repository to " + r.backer.Dir(), true, nil
+	}
+	return fmt.Sprintf("backed up %d ref%s to %s", meta.Refs, plural(meta.Refs), meta.Path), true, nil
+}
+
+func (r *Runner) addTopic(repo github.Repository, topic string) (string, bool, error) {
+	settings, err := r.client.FetchRepoSettings(repo.Owner, repo.Name)
+	if err != nil {
+		return "", false, err
+	}
+	if slices.Contains(settings.Topics, topic) {
+		return "already has topic " + topic, false, nil
+	}
+	if err := r.client.AddTopic(repo.Owner, repo.Name, topic); err != nil {
+		return "", false, err
+	}
+	return "added topic " + topic, true, nil
+}
+
+func (r *Runner) prependDescription(repo github.Repository, notice string) (string, bool, error) {
+	settings, err := r.client.FetchRepoSettings(repo.Owner, repo.Name)
+	if err != nil {
+		return "", false, err
+	}
+	if strings.HasPrefix(settings.Description, notice) {
+		return "description already has notice", false, nil
+	}
+	description := strings.TrimSpace(notice + " " + settings.Description)
+	if err := r.client.SetDescription(repo.Owner, repo.Name, description); err != nil {
+		return "", false, err
+	}
+	return fmt.Sprintf("description set to %q", description), true, nil
+}
+
+func (r *Runner) closePullRequests(repo github.Repository, comment string) (string, bool, error) {
+	numbers, err := r.client.ListOpenPullRequests(repo.Owner, repo.Name)
+	if err != nil {
+		return "", false, err
+	}
+	if len(numbers) == 0 {
+		return "no open pull requests", false, nil
+	}
+	// Closed pull requests stay closed if a later one fails; a rerun only
+	// lists the ones still open.
+	for _, n := range numbers {
+		if err := r.client.ClosePullRequest(repo.Owner, repo.Name, n, comment); err != nil {
+			return "", false, err
+		}
+	}
+	return fmt.Sprintf("closed %d pull request%s", len(numbers), plural(len(numbers))), true, nil
+}
+
+func (r *Runner) addReadmeBanner(repo github.Repository, banner string) (string, bool, error) {
+	readme, err := r.client.FetchReadmeFile(repo.Owner, repo.Name)
+	if err != nil {
+		return "", false, err
+	}
+	if readme == nil {
+		return "no README", false, nil
+	}
+	if strings.Contains(readme.Content, banner) {
+		return "README already has banner", false, nil
+	}
+	content := banner + "\n\n" + readme.Content
+	if err := r.client.UpdateFile(repo.Owner, repo.Name, readme.Path, readme.SHA, content, "Add deprecation notice to README"); err != nil {
+		return "", false, err
+	}
+	return "added banner to " + readme.Path, true, nil
+}
+
+func (r *Runner) archive(repo github.Repository) (string, bool, error) {
+	settings, err := r.client.FetchRepoSettings(repo.Owner, repo.Name)
+	if err != nil {
+		return "", false, err
+	}
+	if settings.IsArchived {
+		return "already archived", false, nil
+	}
+	if err := r.client.ArchiveRepository(repo.Owner, repo.Name); err != nil {
+		return "", false, err
+	}
+	return "archived", true, nil
+}
+
+// plural returns "s" unless n is 1.
+func plural(n int) string {
+	if n == 1 {
+		return ""
+	}
+	return "s"
+}
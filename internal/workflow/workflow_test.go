@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: 2026 Logan Lindquist Land
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package workflow
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/llbbl/repjan/internal/backup"
+	"github.com/llbbl/repjan/internal/github"
+	"github.com/llbbl/repjan/internal/testutil"
+)
+
+// fakeClient is an in-memory repository that the workflow steps modify.
+type fakeClient struct {
+	settings github.RepoSettings
+	prs      []int
+	readme   *github.ReadmeFile
+	comments map[int]string
+	calls    int // modifying calls
+	failPR   int // pull request whose close fails, 0 for none
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{
+		settings: github.RepoSettings{Description: "A widget"},
+		prs:      []int{4, 7},
+		readme:   &github.ReadmeFile{Path: "README.md", SHA: "abc", Content: "# Widget\n"},
+		comments: make(map[int]string),
+	}
+}
+
+func (f *fakeClient) FetchRepoSettings(owner, name string) (github.RepoSettings, error) {
+	return f.settings, nil
+}
+
+func (f *fakeClient) AddTopic(owner, name, topic string) error {
+	f.calls++
+	f.settings.Topics = append(f.settings.Topics, topic)
+	return nil
+}
+
+func (f *fakeClient) SetDescription(owner, name, description string) error {
+	f.calls++
+	f.settings.Description = description
+	return nil
+}
+
+func (f *fakeClient) ListOpenPullRequests(owner, name string) ([]int, error) {
+	return slices.Clone(f.prs), nil
+}
+
+func (f *fakeClient) ClosePullRequest(owner, name string, number int, comment string) error {
+	if number == f.failPR {
+		return errors.New("HTTP 502")
+	}
+	f.calls++
+	f.comments[number] = comment
+	f.prs = slices.DeleteFunc(f.prs, func(n int) bool { return n == number })
+	return nil
+}
+
+func (f *fakeClient) FetchReadmeFile(owner, name string) (*github.ReadmeFile, error) {
+	return f.readme, nil
+}
+
+func (f *fakeClient) UpdateFile(owner, name, path, sha, content, message string) error {
+	f.calls++
+	f.readme = &github.ReadmeFile{Path: path, SHA: sha + "'", Content: content}
+	return nil
+}
+
+func (f *fakeClient) ArchiveRepository(owner, name string) error {
+	f.calls++
+	f.settings.IsArchived = true
+	return nil
+}
+
+func (f *fakeClient) ListIssues(owner, name string) ([]github.IssueSummary, error) {
+	return nil, nil
+}
+
+func runAll(r *Runner, repo github.Repository) []Result {
+	var results []Result
+	for i := range r.Steps() {
+		result := r.Run(repo, i)
+		results = append(results, result)
+		if result.Err != nil {
+			break
+		}
+	}
+	return results
+}
+
+func TestParse(t *testing.T) {
+	steps, err := Parse("topic:deprecated, description:[DEPRECATED],close-prs,readme-banner,archive")
+	require.NoError(t, err)
+	assert.Equal(t, []Step{
+		{Kind: KindTopic, Arg: "deprecated"},
+		{Kind: KindDescription, Arg: "[DEPRECATED]"},
+		{Kind: KindClosePRs},
+		{Kind: KindReadmeBanner},
+		{Kind: KindArchive},
+	}, steps)
+	assert.Equal(t, "topic:deprecated", steps[0].String())
+	assert.Equal(t, "close-prs", steps[2].String())
+
+	steps, err = Parse("")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultSteps, steps)
+
+	assert.Equal(t, []Step{{Kind: KindBackup}, {Kind: KindArchive}}, WithBackup(DefaultSteps))
+	steps, err = Parse("topic,backup,archive")
+	require.NoError(t, err)
+	assert.Equal(t, steps, WithBackup(steps))
+
+	for _, spec := range []string{"topic,bogus,archive", "topic", "archive,topic", "archive:now", "backup:bundle,archive"} {
+		_, err := Parse(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestRunner_RunsStepsIdempotently(t *testing.T) {
+	steps, err := Parse("topic,description,close-prs:Closing,readme-banner:> Deprecated,archive")
+	require.NoError(t, err)
+	client := newFakeClient()
+	s := testutil.NewStore(t)
+	repo := github.Repository{Owner: "acme", Name: "widget"}
+
+	results := runAll(NewRunner(client, s, steps), repo)
+	require.Len(t, results, 5)
+	for _, r := range results {
+		assert.Equal(t, StatusDone, r.Status, r.Step.String())
+	}
+	assert.Equal(t, []string{"deprecated"}, client.settings.Topics)
+	assert.Equal(t, "[DEPRECATED] A widget", client.settings.Description)
+	assert.Empty(t, client.prs)
+	assert.Equal(t, "Closing", client.comments[7])
+	assert.True(t, strings.HasPrefix(client.readme.Content, "> Deprecated\n\n# Widget"))
+	assert.True(t, client.settings.IsArchived)
+
+	changes, err := s.GetChangesByAction("acme", ActionWorkflowStep, 10)
+	require.NoError(t, err)
+	assert.Len(t, changes, 5)
+
+	// Without stored progress, every step finds its work already done
+	calls := client.calls
+	results = runAll(NewRunner(client, nil, steps), repo)
+	for _, r := range results {
+		assert.Equal(t, StatusSkipped, r.Status, r.Step.String())
+	}
+	assert.Equal(t, calls, client.calls)
+}
+
+func TestRunner_ResumesAfterFailure(t *testing.T) {
+	steps, err := Parse("topic,close-prs,archive")
+	require.NoError(t, err)
+	client := newFakeClient()
+	client.failPR = 7
+	s := testutil.NewStore(t)
+	repo := github.Repository{Owner: "acme", Name: "widget"}
+	runner := NewRunner(client, s, steps)
+
+	results := runAll(runner, repo)
+	require.Len(t, results, 2)
+	assert.Equal(t, StatusDone, results[0].Status)
+	assert.Equal(t, StatusFailed, results[1].Status)
+	assert.ErrorContains(t, results[1].Err, "close-prs")
+	assert.Equal(t, []int{7}, client.prs)
+	assert.False(t, client.settings.IsArchived)
+
+	client.failPR = 0
+	results = runAll(runner, repo)
+	require.Len(t, results, 3)
+	assert.Equal(t, StatusSkipped, results[0].Status)
+	assert.Equal(t, "completed in an earlier run", results[0].Detail)
+	assert.Equal(t, StatusDone, results[1].Status)
+	assert.Equal(t, "closed 1 pull request", results[1].Detail)
+	assert.Equal(t, StatusDone, results[2].Status)
+
+	require.NoError(t, runner.Finish(repo))
+	completed, err := s.GetCompletedWorkflowSteps("acme", "widget")
+	require.NoError(t, err)
+	assert.Empty(t, completed)
+}
+
+func TestRunner_Backup(t *testing.T) {
+	remote := testutil.NewGitRemote(t)
+	client := newFakeClient()
+	repo := github.Repository{Owner: "acme", Name: "widget"}
+	steps := WithBackup(DefaultSteps)
+
+	// Without a backup directory the backup step fails before archiving
+	results := runAll(NewRunner(client, nil, steps), repo)
+	require.Len(t, results, 1)
+	assert.ErrorContains(t, results[0].Err, "backup")
+	assert.False(t, client.settings.IsArchived)
+
+	backer := backup.NewBacker(client, t.TempDir(), "")
+	backer.SetRemoteURL(func(owner, name string) string { return remote })
+	runner := NewRunner(client, nil, steps)
+	runner.SetBackup(backer)
+
+	results = runAll(runner, repo)
+	require.Len(t, results, 2)
+	assert.Equal(t, StatusDone, results[0].Status)
+	assert.Contains(t, results[0].Detail, "backed up 2 refs")
+	assert.True(t, client.settings.IsArchived)
+
+	meta, err := backup.Load(backer.Dir(), "acme", "widget")
+	require.NoError(t, err)
+	assert.NoError(t, backup.Verify(meta))
+}
+
+func TestRunner_NoReadme(t *testing.T) {
+	client := newFakeClient()
+	client.readme = nil
+	runner := NewRunner(client, nil, []Step{{Kind: KindReadmeBanner}, {Kind: KindArchive}})
+
+	result := runner.Run(github.Repository{Owner: "acme", Name: "widget"}, 0)
+	assert.Equal(t, StatusSkipped, result.Status)
+	assert.Equal(t, "no README", result.Detail)
+}